@@ -49,6 +49,7 @@ var e *executor.Executor
 func exitFuncExecutor() {
 	e.DeleteAllAlerts()
 	time.Sleep(time.Second)
+	executor.StopMetricSource()
 }
 
 func mainFuncExecutor() {
@@ -1672,6 +1672,165 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/rule_override": {
+      "post": {
+        "summary": "create rule override",
+        "operationId": "CreateRuleOverride",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateRuleOverrideResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateRuleOverrideRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify rule override",
+        "operationId": "ModifyRuleOverride",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifyRuleOverrideResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifyRuleOverrideRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/rule_overrides": {
+      "get": {
+        "summary": "describe rule overrides",
+        "operationId": "DescribeRuleOverrides",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDescribeRuleOverridesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "override_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "rule_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "resource_name",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete rule overrides",
+        "operationId": "DeleteRuleOverrides",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDeleteRuleOverridesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertDeleteRuleOverridesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
     "/v1/rules": {
       "get": {
         "summary": "describe rules",
@@ -1851,6 +2010,47 @@ var Files = map[string]string{
               "type": "string"
             },
             "collectionFormat": "multimulti"
+          },
+          {
+            "name": "aggregation",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "no_data_behavior",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "recovery_thresholds",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "consecutive_recovery_count",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "multimulti"
           }
         ],
         "tags": [
@@ -1883,6 +2083,165 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/slo": {
+      "post": {
+        "summary": "create slo",
+        "operationId": "CreateSlo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateSloResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateSloRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify slo",
+        "operationId": "ModifySlo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifySloResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifySloRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/slos": {
+      "get": {
+        "summary": "describe slos",
+        "operationId": "DescribeSlos",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDescribeSlosResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "slo_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "slo_name",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "policy_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete slos",
+        "operationId": "DeleteSlos",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDeleteSlosResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertDeleteSlosRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
     "/v1/alert_details": {
       "get": {
         "summary": "describe alert details",
@@ -2766,6 +3125,31 @@ var Files = map[string]string{
         }
       }
     },
+    "alertCreateRuleOverrideRequest": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
+          "type": "string"
+        },
+        "resource_name": {
+          "type": "string"
+        },
+        "thresholds": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        }
+      }
+    },
+    "alertCreateRuleOverrideResponse": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertCreateRuleRequest": {
       "type": "object",
       "properties": {
@@ -2783,38 +3167,117 @@ var Files = map[string]string{
         "severity": {
           "type": "string"
         },
-        "metrics_type": {
+        "metrics_type": {
+          "type": "string"
+        },
+        "condition_type": {
+          "type": "string"
+        },
+        "thresholds": {
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "consecutive_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "inhibit": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "policy_id": {
+          "type": "string"
+        },
+        "metric_id": {
+          "type": "string"
+        },
+        "aggregation": {
+          "type": "string"
+        },
+        "no_data_behavior": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_recovery_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
+        },
+        "forecast_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "evaluation_interval": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "group_condition": {
+          "type": "string"
+        },
+        "metric_expression": {
+          "type": "string"
+        },
+        "threshold_schedule": {
+          "type": "string"
+        },
+        "offset_window": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "script": {
+          "type": "string"
+        }
+      }
+    },
+    "alertCreateRuleResponse": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertCreateSloRequest": {
+      "type": "object",
+      "properties": {
+        "slo_name": {
+          "type": "string"
+        },
+        "target": {
           "type": "string"
         },
-        "condition_type": {
-          "type": "string"
+        "window_days": {
+          "type": "integer",
+          "format": "int64"
         },
-        "thresholds": {
+        "good_metric_id": {
           "type": "string"
         },
-        "unit": {
+        "total_metric_id": {
           "type": "string"
         },
-        "consecutive_count": {
-          "type": "integer",
-          "format": "int64"
+        "burn_windows": {
+          "type": "string"
         },
-        "inhibit": {
+        "disabled": {
           "type": "boolean",
           "format": "boolean"
         },
         "policy_id": {
           "type": "string"
-        },
-        "metric_id": {
-          "type": "string"
         }
       }
     },
-    "alertCreateRuleResponse": {
+    "alertCreateSloResponse": {
       "type": "object",
       "properties": {
-        "rule_id": {
+        "slo_id": {
           "type": "string"
         }
       }
@@ -3017,6 +3480,28 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDeleteRuleOverridesRequest": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteRuleOverridesResponse": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "alertDeleteRulesRequest": {
       "type": "object",
       "properties": {
@@ -3039,6 +3524,28 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDeleteSlosRequest": {
+      "type": "object",
+      "properties": {
+        "slo_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteSlosResponse": {
+      "type": "object",
+      "properties": {
+        "slo_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "alertDescribeActionsResponse": {
       "type": "object",
       "properties": {
@@ -3174,6 +3681,21 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDescribeRuleOverridesResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rule_override_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRuleOverride"
+          }
+        }
+      }
+    },
     "alertDescribeRulesResponse": {
       "type": "object",
       "properties": {
@@ -3189,6 +3711,21 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDescribeSlosResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "slo_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertSlo"
+          }
+        }
+      }
+    },
     "alertExecutor": {
       "type": "object",
       "properties": {
@@ -3541,6 +4078,28 @@ var Files = map[string]string{
         }
       }
     },
+    "alertModifyRuleOverrideRequest": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "string"
+        },
+        "thresholds": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyRuleOverrideResponse": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertModifyRuleRequest": {
       "type": "object",
       "properties": {
@@ -3580,6 +4139,46 @@ var Files = map[string]string{
         "inhibit": {
           "type": "boolean",
           "format": "boolean"
+        },
+        "aggregation": {
+          "type": "string"
+        },
+        "no_data_behavior": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_recovery_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
+        },
+        "forecast_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "evaluation_interval": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "group_condition": {
+          "type": "string"
+        },
+        "metric_expression": {
+          "type": "string"
+        },
+        "threshold_schedule": {
+          "type": "string"
+        },
+        "offset_window": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "script": {
+          "type": "string"
         }
       }
     },
@@ -3591,6 +4190,45 @@ var Files = map[string]string{
         }
       }
     },
+    "alertModifySloRequest": {
+      "type": "object",
+      "properties": {
+        "slo_id": {
+          "type": "string"
+        },
+        "slo_name": {
+          "type": "string"
+        },
+        "target": {
+          "type": "string"
+        },
+        "window_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "good_metric_id": {
+          "type": "string"
+        },
+        "total_metric_id": {
+          "type": "string"
+        },
+        "burn_windows": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "alertModifySloResponse": {
+      "type": "object",
+      "properties": {
+        "slo_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertPolicy": {
       "type": "object",
       "properties": {
@@ -3737,10 +4375,122 @@ var Files = map[string]string{
         },
         "metric_id": {
           "type": "string"
+        },
+        "aggregation": {
+          "type": "string"
+        },
+        "no_data_behavior": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_recovery_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
+        },
+        "forecast_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "evaluation_interval": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "group_condition": {
+          "type": "string"
+        },
+        "metric_expression": {
+          "type": "string"
+        },
+        "threshold_schedule": {
+          "type": "string"
+        },
+        "offset_window": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "script": {
+          "type": "string"
         }
       },
       "title": "5.Rule\n********************************************************************************************************"
     },
+    "alertRuleOverride": {
+      "type": "object",
+      "properties": {
+        "override_id": {
+          "type": "string"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "resource_name": {
+          "type": "string"
+        },
+        "thresholds": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "10.RuleOverride\n********************************************************************************************************"
+    },
+    "alertSlo": {
+      "type": "object",
+      "properties": {
+        "slo_id": {
+          "type": "string"
+        },
+        "slo_name": {
+          "type": "string"
+        },
+        "target": {
+          "type": "string"
+        },
+        "window_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "good_metric_id": {
+          "type": "string"
+        },
+        "total_metric_id": {
+          "type": "string"
+        },
+        "burn_windows": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "policy_id": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "11.Slo\n********************************************************************************************************"
+    },
     "alertAlertDetail": {
       "type": "object",
       "properties": {
@@ -4004,7 +4754,7 @@ var Files = map[string]string{
           "format": "date-time"
         }
       },
-      "title": "1.History\r\n********************************************************************************************************"
+      "title": "1.History\n********************************************************************************************************"
     },
     "alertResourceStatus": {
       "type": "object",
@@ -4032,6 +4782,22 @@ var Files = map[string]string{
         },
         "aggregated_alerts": {
           "type": "string"
+        },
+        "no_data": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "negative_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flapping": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "inhibited": {
+          "type": "boolean",
+          "format": "boolean"
         }
       }
     }
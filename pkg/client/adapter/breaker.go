@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+//Breaker stops calls to an endpoint after consecutive failures, after the open duration one call is let through
+//as a probe, which closes the breaker if it succeeds or opens it again if it fails
+type Breaker struct {
+	FailureThreshold int
+	OpenDuration     time.Duration
+
+	mutex    sync.Mutex
+	state    string
+	failures int
+	openTime time.Time
+}
+
+//NewBreaker returns a closed breaker, a breaker without failure threshold never opens
+func NewBreaker(failureThreshold int, openDuration time.Duration) *Breaker {
+	return &Breaker{
+		FailureThreshold: failureThreshold,
+		OpenDuration:     openDuration,
+		state:            BreakerClosed,
+	}
+}
+
+//Allow returns true if a call may be made now
+func (b *Breaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openTime) < b.OpenDuration {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		return true
+	case BreakerHalfOpen:
+		//Only the probe is let through
+		return false
+	default:
+		return true
+	}
+}
+
+//Record the result of a call allowed by the breaker
+func (b *Breaker) Record(success bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if success {
+		b.state = BreakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures = b.failures + 1
+	if b.state == BreakerHalfOpen || (b.FailureThreshold > 0 && b.failures >= b.FailureThreshold) {
+		b.state = BreakerOpen
+		b.openTime = time.Now()
+	}
+}
+
+//Cancel a call allowed by the breaker which was canceled by the caller, a canceled probe lets the next call through
+func (b *Breaker) Cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.state == BreakerHalfOpen {
+		b.state = BreakerOpen
+		b.openTime = time.Now().Add(-b.OpenDuration)
+	}
+}
+
+func (b *Breaker) State() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state
+}
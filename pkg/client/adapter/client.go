@@ -1,17 +1,21 @@
 package adapter
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
 
 	"kubesphere.io/alert/pkg/config"
 	"kubesphere.io/alert/pkg/logger"
 )
 
-var client = &http.Client{
+var httpClient = &http.Client{
 	Transport: &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -30,68 +34,185 @@ const (
 	DefaultScheme = "http"
 )
 
-func SendMetricRequest(metricParam string) string {
-	cfg := config.GetInstance()
-	url := fmt.Sprintf("http://127.0.0.1:%s/api/v1/metric", cfg.App.AdapterPort)
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		logger.Error(nil, "SendMetricRequest NewRequest error:", err)
-		return ""
+const (
+	EndpointMetric = "/api/v1/metric"
+	EndpointEmail  = "/api/v1/email"
+)
+
+//Client calls the endpoints of the sidecar adapter, failed calls are retried with jittered backoff
+//and each endpoint has its own breaker
+type Client struct {
+	BaseUrl       string
+	HttpClient    *http.Client
+	Retries       int
+	RetryInterval time.Duration
+
+	breakerFailures     int
+	breakerOpenDuration time.Duration
+	mutex               sync.Mutex
+	breakers            map[string]*Breaker
+}
+
+func NewClient(baseUrl string, retries int, retryInterval time.Duration, breakerFailures int, breakerOpenDuration time.Duration) *Client {
+	return &Client{
+		BaseUrl:             baseUrl,
+		HttpClient:          httpClient,
+		Retries:             retries,
+		RetryInterval:       retryInterval,
+		breakerFailures:     breakerFailures,
+		breakerOpenDuration: breakerOpenDuration,
+		breakers:            make(map[string]*Breaker),
 	}
+}
 
-	params := request.URL.Query()
-	params.Add("metric_param", metricParam)
-	request.URL.RawQuery = params.Encode()
+var instance *Client
+
+var once sync.Once
+
+//Get the client of the local adapter of the config
+func GetClient() *Client {
+	once.Do(func() {
+		cfg := config.GetInstance()
+		instance = NewClient(
+			fmt.Sprintf("%s://127.0.0.1:%s", DefaultScheme, cfg.App.AdapterPort),
+			cfg.App.AdapterRetries,
+			time.Duration(cfg.App.AdapterRetryIntervalMilliseconds)*time.Millisecond,
+			cfg.App.AdapterBreakerFailures,
+			time.Duration(cfg.App.AdapterBreakerOpenSeconds)*time.Second,
+		)
+	})
+	return instance
+}
+
+func (c *Client) getBreaker(endpoint string) *Breaker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	breaker, ok := c.breakers[endpoint]
+	if !ok {
+		breaker = NewBreaker(c.breakerFailures, c.breakerOpenDuration)
+		c.breakers[endpoint] = breaker
+	}
+	return breaker
+}
 
-	logger.Debug(nil, "SendMetricRequest %s", request.URL.RawQuery)
+//Backoff before the retry, doubled on each attempt with half of it jittered
+func (c *Client) getBackoff(attempt int) time.Duration {
+	backoff := c.RetryInterval << uint(attempt)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
 
-	response, err := client.Do(request)
+func (c *Client) doRequest(ctx context.Context, endpoint string, params url.Values) (string, error) {
+	request, err := http.NewRequest("GET", c.BaseUrl+endpoint, nil)
 	if err != nil {
-		logger.Error(nil, "SendMetricRequest get error:", err)
-	} else {
-		defer response.Body.Close()
+		return "", &RequestError{Endpoint: endpoint, Err: err}
+	}
+	request.URL.RawQuery = params.Encode()
+	request = request.WithContext(ctx)
 
-		contents, err := ioutil.ReadAll(response.Body)
+	response, err := c.HttpClient.Do(request)
+	if err != nil {
+		//Report the context error rather than the error of the transport
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return "", &RequestError{Endpoint: endpoint, Err: err}
+	}
+	defer response.Body.Close()
 
-		if err != nil {
-			logger.Error(nil, "SendMetricRequest read error:", err.Error())
+	contents, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
 		}
+		return "", &RequestError{Endpoint: endpoint, Err: err}
+	}
 
-		return string(contents)
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return "", &RequestError{Endpoint: endpoint, StatusCode: response.StatusCode, Err: fmt.Errorf("%s", contents)}
 	}
-	return ""
+
+	return string(contents), nil
 }
 
-func SendEmailRequest(notificationParam string, resume string, language string) string {
-	cfg := config.GetInstance()
-	url := fmt.Sprintf("http://127.0.0.1:%s/api/v1/email", cfg.App.AdapterPort)
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		logger.Error(nil, "SendEmailRequest NewRequest error:", err)
-		return ""
+//Call the endpoint until it succeeds, fails permanently or the retries or the context run out
+func (c *Client) call(ctx context.Context, endpoint string, params url.Values) (string, error) {
+	breaker := c.getBreaker(endpoint)
+
+	var err error
+	for attempt := 0; attempt <= c.Retries; attempt++ {
+		if attempt > 0 {
+			//Retries not fitting in the deadline fail with the last error rather than the deadline
+			backoff := c.getBackoff(attempt - 1)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+				return "", err
+			}
+			select {
+			case <-ctx.Done():
+				return "", &RequestError{Endpoint: endpoint, Err: ctx.Err()}
+			case <-time.After(backoff):
+			}
+		}
+
+		if !breaker.Allow() {
+			return "", &RequestError{Endpoint: endpoint, Err: ErrCircuitOpen}
+		}
+
+		var contents string
+		contents, err = c.doRequest(ctx, endpoint, params)
+		if err == nil {
+			breaker.Record(true)
+			return contents, nil
+		}
+		requestError := err.(*RequestError)
+		switch {
+		case requestError.Err == context.Canceled:
+			breaker.Cancel()
+		case requestError.breakerFailure():
+			breaker.Record(false)
+		default:
+			//The adapter answered
+			breaker.Record(true)
+		}
+
+		logger.Debug(nil, "Adapter call %s attempt %d error: %v", endpoint, attempt, err)
+		if !requestError.Temporary() {
+			return "", err
+		}
 	}
 
-	params := request.URL.Query()
+	return "", err
+}
+
+//SendMetricRequest asks the adapter for the metrics of the metric param in json
+func (c *Client) SendMetricRequest(ctx context.Context, metricParam string) (string, error) {
+	params := url.Values{}
+	params.Add("metric_param", metricParam)
+
+	logger.Debug(nil, "SendMetricRequest %s", params.Encode())
+
+	return c.call(ctx, EndpointMetric, params)
+}
+
+//SendEmailRequest asks the adapter to render the notification param in json into an email
+func (c *Client) SendEmailRequest(ctx context.Context, notificationParam string, resume string, language string) (string, error) {
+	params := url.Values{}
 	params.Add("notification_param", notificationParam)
 	params.Add("resume", resume)
 	params.Add("language", language)
-	request.URL.RawQuery = params.Encode()
 
-	logger.Debug(nil, "SendEmailRequest %s", request.URL.RawQuery)
+	logger.Debug(nil, "SendEmailRequest %s", params.Encode())
 
-	response, err := client.Do(request)
-	if err != nil {
-		logger.Error(nil, "SendEmailRequest get error:", err)
-	} else {
-		defer response.Body.Close()
-
-		contents, err := ioutil.ReadAll(response.Body)
+	return c.call(ctx, EndpointEmail, params)
+}
 
-		if err != nil {
-			logger.Error(nil, "SendEmailRequest read error:", err.Error())
-		}
+func SendMetricRequest(ctx context.Context, metricParam string) (string, error) {
+	return GetClient().SendMetricRequest(ctx, metricParam)
+}
 
-		return string(contents)
-	}
-	return ""
+func SendEmailRequest(ctx context.Context, notificationParam string, resume string, language string) (string, error) {
+	return GetClient().SendEmailRequest(ctx, notificationParam, resume, language)
 }
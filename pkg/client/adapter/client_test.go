@@ -0,0 +1,131 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestServer(statusCodes []int, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(calls, 1)) - 1
+		statusCode := statusCodes[len(statusCodes)-1]
+		if call < len(statusCodes) {
+			statusCode = statusCodes[call]
+		}
+		w.WriteHeader(statusCode)
+		w.Write([]byte(r.URL.Query().Get("metric_param")))
+	}))
+}
+
+func TestSendMetricRequestRetry(t *testing.T) {
+	var calls int32
+	server := newTestServer([]int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}, &calls)
+	defer server.Close()
+
+	client := NewClient(server.URL, 2, time.Millisecond, 0, 0)
+	contents, err := client.SendMetricRequest(context.Background(), "param")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contents != "param" || calls != 3 {
+		t.Errorf("SendMetricRequest got %s after %d calls, expect param after 3 calls", contents, calls)
+	}
+}
+
+func TestSendMetricRequestNoRetry(t *testing.T) {
+	var calls int32
+	server := newTestServer([]int{http.StatusBadRequest}, &calls)
+	defer server.Close()
+
+	client := NewClient(server.URL, 2, time.Millisecond, 0, 0)
+	_, err := client.SendMetricRequest(context.Background(), "param")
+	requestError, ok := err.(*RequestError)
+	if !ok || requestError.StatusCode != http.StatusBadRequest || requestError.Temporary() {
+		t.Errorf("SendMetricRequest got error %v, expect permanent status error", err)
+	}
+	if calls != 1 {
+		t.Errorf("SendMetricRequest got %d calls, expect 1", calls)
+	}
+}
+
+func TestSendMetricRequestBreaker(t *testing.T) {
+	var calls int32
+	server := newTestServer([]int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}, &calls)
+	defer server.Close()
+
+	client := NewClient(server.URL, 0, 0, 2, 50*time.Millisecond)
+	for i := 0; i < 2; i++ {
+		if _, err := client.SendMetricRequest(context.Background(), "param"); err == nil {
+			t.Errorf("SendMetricRequest %d expect error", i)
+		}
+	}
+
+	//Calls are rejected while the circuit is open, other endpoints are not affected
+	if _, err := client.SendMetricRequest(context.Background(), "param"); !IsCircuitOpen(err) {
+		t.Errorf("SendMetricRequest got error %v, expect circuit open", err)
+	}
+	if calls != 2 {
+		t.Errorf("SendMetricRequest got %d calls, expect 2", calls)
+	}
+	if state := client.getBreaker(EndpointEmail).State(); state != BreakerClosed {
+		t.Errorf("email breaker got %s, expect closed", state)
+	}
+
+	//The probe after the open duration closes the circuit
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.SendMetricRequest(context.Background(), "param"); err != nil {
+		t.Errorf("SendMetricRequest probe got error %v", err)
+	}
+	if state := client.getBreaker(EndpointMetric).State(); state != BreakerClosed {
+		t.Errorf("metric breaker got %s, expect closed", state)
+	}
+}
+
+func TestSendMetricRequestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, 3, 10*time.Millisecond, 0, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.SendMetricRequest(ctx, "param")
+	requestError, ok := err.(*RequestError)
+	if !ok || requestError.Err != context.DeadlineExceeded {
+		t.Errorf("SendMetricRequest got error %v, expect deadline exceeded", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("SendMetricRequest took %v after the deadline", time.Since(start))
+	}
+}
+
+func TestSendMetricRequestRetryBudget(t *testing.T) {
+	var calls int32
+	server := newTestServer([]int{http.StatusBadGateway}, &calls)
+	defer server.Close()
+
+	client := NewClient(server.URL, 3, 200*time.Millisecond, 0, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	//Retries after the deadline are not waited for, the error of the adapter is returned
+	start := time.Now()
+	_, err := client.SendMetricRequest(ctx, "param")
+	requestError, ok := err.(*RequestError)
+	if !ok || requestError.StatusCode != http.StatusBadGateway {
+		t.Errorf("SendMetricRequest got error %v, expect bad gateway", err)
+	}
+	if calls != 1 || time.Since(start) > 40*time.Millisecond {
+		t.Errorf("SendMetricRequest got %d calls in %v, expect 1 call without waiting", calls, time.Since(start))
+	}
+}
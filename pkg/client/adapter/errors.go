@@ -0,0 +1,52 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var ErrCircuitOpen = errors.New("circuit open")
+
+//RequestError is the error of a call to an endpoint of the adapter,
+//status code is 0 if the adapter returned no response
+type RequestError struct {
+	Endpoint   string
+	StatusCode int
+	Err        error
+}
+
+func (e *RequestError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("adapter %s status %d: %v", e.Endpoint, e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("adapter %s: %v", e.Endpoint, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+//Temporary returns true if the call may succeed when retried,
+//calls without response or with server errors are retried, canceled calls and open circuits are not
+func (e *RequestError) Temporary() bool {
+	if e.StatusCode != 0 {
+		return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+	}
+	return e.Err != ErrCircuitOpen && e.Err != context.Canceled && e.Err != context.DeadlineExceeded
+}
+
+//Failures of the adapter count against the breaker, calls rejected by the adapter do not
+func (e *RequestError) breakerFailure() bool {
+	if e.StatusCode != 0 {
+		return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+//IsCircuitOpen returns true if the call was not made since the circuit of the endpoint is open
+func IsCircuitOpen(err error) bool {
+	requestError, ok := err.(*RequestError)
+	return ok && requestError.Err == ErrCircuitOpen
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2018 The KubeSphere Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+)
+
+//Object state metrics are read from the informer caches instead of a metric backend,
+//states of objects are 1 when the object is in the state and 0 otherwise
+const (
+	StateMetricPrefix = "object_state_"
+
+	StatePodCrashLoopBackOff           = "object_state_pod_crashloopbackoff"
+	StateNodeNotReady                  = "object_state_node_not_ready"
+	StateDeploymentUnavailableReplicas = "object_state_deployment_unavailable_replicas"
+	StatePvcPending                    = "object_state_pvc_pending"
+	StateJobFailed                     = "object_state_job_failed"
+)
+
+//IsStateMetric returns true if the metric is an object state metric
+func IsStateMetric(metricName string) bool {
+	return strings.HasPrefix(metricName, StateMetricPrefix)
+}
+
+//objectState is the value of a state metric of one object with the values of the object matched by the resource filter
+type objectState struct {
+	ResourceName string
+	Filters      map[string]string
+	Value        float64
+}
+
+//StateSource serves object state metrics from the caches of shared informers of the clientset
+type StateSource struct {
+	factory          informers.SharedInformerFactory
+	podLister        corelisters.PodLister
+	nodeLister       corelisters.NodeLister
+	pvcLister        corelisters.PersistentVolumeClaimLister
+	deploymentLister appslisters.DeploymentLister
+	jobLister        batchlisters.JobLister
+	synced           []cache.InformerSynced
+	stopCh           chan struct{}
+}
+
+func NewStateSource(clientset kubernetes.Interface, resync time.Duration) *StateSource {
+	factory := informers.NewSharedInformerFactory(clientset, resync)
+
+	podInformer := factory.Core().V1().Pods()
+	nodeInformer := factory.Core().V1().Nodes()
+	pvcInformer := factory.Core().V1().PersistentVolumeClaims()
+	deploymentInformer := factory.Apps().V1().Deployments()
+	jobInformer := factory.Batch().V1().Jobs()
+
+	return &StateSource{
+		factory:          factory,
+		podLister:        podInformer.Lister(),
+		nodeLister:       nodeInformer.Lister(),
+		pvcLister:        pvcInformer.Lister(),
+		deploymentLister: deploymentInformer.Lister(),
+		jobLister:        jobInformer.Lister(),
+		synced: []cache.InformerSynced{
+			podInformer.Informer().HasSynced,
+			nodeInformer.Informer().HasSynced,
+			pvcInformer.Informer().HasSynced,
+			deploymentInformer.Informer().HasSynced,
+			jobInformer.Informer().HasSynced,
+		},
+		stopCh: make(chan struct{}),
+	}
+}
+
+//Start runs the informers until Stop
+func (s *StateSource) Start() {
+	s.factory.Start(s.stopCh)
+}
+
+func (s *StateSource) Stop() {
+	close(s.stopCh)
+}
+
+//WaitForCacheSync waits until the caches of all informers are synced, return false if stopped before
+func (s *StateSource) WaitForCacheSync(stopCh <-chan struct{}) bool {
+	return cache.WaitForCacheSync(stopCh, s.synced...)
+}
+
+func (s *StateSource) hasSynced() bool {
+	for _, synced := range s.synced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func namespacedName(namespace string, name string) string {
+	return namespace + ":" + name
+}
+
+func isCrashLoopBackOff(pod *corev1.Pod) bool {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+			return true
+		}
+	}
+	return false
+}
+
+//Nodes without ready condition are not ready either
+func isNodeNotReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
+func isJobFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *StateSource) getPodStates() ([]objectState, error) {
+	pods, err := s.podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	states := []objectState{}
+	for _, pod := range pods {
+		filters := map[string]string{metric.FilterNamespace: pod.Namespace, metric.FilterPod: pod.Name}
+		//Pods not scheduled yet are not checked against the node filter
+		if pod.Spec.NodeName != "" {
+			filters[metric.FilterNode] = pod.Spec.NodeName
+		}
+		states = append(states, objectState{
+			ResourceName: namespacedName(pod.Namespace, pod.Name),
+			Filters:      filters,
+			Value:        boolValue(isCrashLoopBackOff(pod)),
+		})
+	}
+	return states, nil
+}
+
+func (s *StateSource) getNodeStates() ([]objectState, error) {
+	nodes, err := s.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	states := []objectState{}
+	for _, node := range nodes {
+		states = append(states, objectState{
+			ResourceName: node.Name,
+			Filters:      map[string]string{metric.FilterNode: node.Name},
+			Value:        boolValue(isNodeNotReady(node)),
+		})
+	}
+	return states, nil
+}
+
+func (s *StateSource) getPvcStates() ([]objectState, error) {
+	pvcs, err := s.pvcLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	states := []objectState{}
+	for _, pvc := range pvcs {
+		states = append(states, objectState{
+			ResourceName: namespacedName(pvc.Namespace, pvc.Name),
+			Filters:      map[string]string{metric.FilterNamespace: pvc.Namespace},
+			Value:        boolValue(pvc.Status.Phase == corev1.ClaimPending),
+		})
+	}
+	return states, nil
+}
+
+//Unavailable replicas of the deployment, which are not a state but compared with thresholds as they are
+func (s *StateSource) getDeploymentStates() ([]objectState, error) {
+	deployments, err := s.deploymentLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	states := []objectState{}
+	for _, deployment := range deployments {
+		states = append(states, objectState{
+			ResourceName: namespacedName(deployment.Namespace, deployment.Name),
+			Filters:      map[string]string{metric.FilterNamespace: deployment.Namespace, metric.FilterWorkload: deployment.Name},
+			Value:        float64(deployment.Status.UnavailableReplicas),
+		})
+	}
+	return states, nil
+}
+
+func (s *StateSource) getJobStates() ([]objectState, error) {
+	jobs, err := s.jobLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	states := []objectState{}
+	for _, job := range jobs {
+		states = append(states, objectState{
+			ResourceName: namespacedName(job.Namespace, job.Name),
+			Filters:      map[string]string{metric.FilterNamespace: job.Namespace, metric.FilterWorkload: job.Name},
+			Value:        boolValue(isJobFailed(job)),
+		})
+	}
+	return states, nil
+}
+
+func (s *StateSource) getStates(metricName string) ([]objectState, error) {
+	switch metricName {
+	case StatePodCrashLoopBackOff:
+		return s.getPodStates()
+	case StateNodeNotReady:
+		return s.getNodeStates()
+	case StatePvcPending:
+		return s.getPvcStates()
+	case StateDeploymentUnavailableReplicas:
+		return s.getDeploymentStates()
+	case StateJobFailed:
+		return s.getJobStates()
+	}
+	return nil, fmt.Errorf("unknown object state metric [%s]", metricName)
+}
+
+//GetMetrics returns the current state of the objects selected by the resource filter as one sample of now,
+//the resource type of the param is not used since each state metric belongs to one kind of object
+func (s *StateSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	if !s.hasSynced() {
+		return nil, fmt.Errorf("object state caches are not synced")
+	}
+
+	filters, err := metric.ParseFilterParam(metricParam.RsFilterParam)
+	if err != nil {
+		return nil, fmt.Errorf("illegal resource filter param [%s]: %v", metricParam.RsFilterParam, err)
+	}
+
+	now := time.Now().Unix()
+	resourceMetricsList := []metric.ResourceMetrics{}
+	returned := make(map[string]bool)
+	for _, metricName := range metricParam.Metrics {
+		if returned[metricName] {
+			continue
+		}
+		returned[metricName] = true
+
+		states, err := s.getStates(metricName)
+		if err != nil {
+			logger.Error(nil, "StateSource get states error: %v", err)
+			continue
+		}
+
+		resourceMetric := make(map[string][]metric.TV)
+		for _, state := range states {
+			if !metric.MatchFilters(state.Filters, filters) {
+				continue
+			}
+			resourceMetric[state.ResourceName] = []metric.TV{{T: now, V: strconv.FormatFloat(state.Value, 'f', -1, 64)}}
+		}
+
+		for _, ruleId := range metricParam.MetricToRule[metricName] {
+			resourceMetricsList = append(resourceMetricsList, metric.ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: resourceMetric,
+			})
+		}
+	}
+
+	return resourceMetricsList, nil
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+func newPod(namespace string, name string, nodeName string, waitingReason string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+	}
+	if waitingReason != "" {
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  "app",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason}},
+		}}
+	}
+	return pod
+}
+
+func newNode(name string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+//StateSource with listers on indexers holding the objects, the informers are not run
+func newIndexedSource(objects ...runtime.Object) (*StateSource, map[string]cache.Indexer) {
+	indexers := map[string]cache.Indexer{}
+	for _, kind := range []string{"pod", "node", "pvc", "deployment", "job"} {
+		indexers[kind] = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	}
+	for _, object := range objects {
+		switch object.(type) {
+		case *corev1.Pod:
+			indexers["pod"].Add(object)
+		case *corev1.Node:
+			indexers["node"].Add(object)
+		case *corev1.PersistentVolumeClaim:
+			indexers["pvc"].Add(object)
+		case *appsv1.Deployment:
+			indexers["deployment"].Add(object)
+		case *batchv1.Job:
+			indexers["job"].Add(object)
+		}
+	}
+
+	synced := func() bool { return true }
+	return &StateSource{
+		podLister:        corelisters.NewPodLister(indexers["pod"]),
+		nodeLister:       corelisters.NewNodeLister(indexers["node"]),
+		pvcLister:        corelisters.NewPersistentVolumeClaimLister(indexers["pvc"]),
+		deploymentLister: appslisters.NewDeploymentLister(indexers["deployment"]),
+		jobLister:        batchlisters.NewJobLister(indexers["job"]),
+		synced:           []cache.InformerSynced{synced},
+	}, indexers
+}
+
+//Values of the only sample of each resource of the metric
+func getStateValues(t *testing.T, source *StateSource, metricName string, rsFilterParam string) map[string]string {
+	resourceMetricsList, err := source.GetMetrics(context.Background(), metric.MetricParam{
+		RsFilterParam: rsFilterParam,
+		Metrics:       []string{metricName},
+		MetricToRule:  map[string][]string{metricName: {"rl-1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resourceMetricsList) != 1 {
+		t.Fatalf("GetMetrics %s got %v, expect one result", metricName, resourceMetricsList)
+	}
+
+	values := make(map[string]string)
+	for resourceName, tvs := range resourceMetricsList[0].ResourceMetric {
+		values[resourceName] = tvs[0].V
+	}
+	return values
+}
+
+func TestStateSourceGetMetrics(t *testing.T) {
+	source, indexers := newIndexedSource(
+		newPod("ns1", "crash", "node1", "CrashLoopBackOff"),
+		newPod("ns1", "ok", "node2", ""),
+		newPod("ns2", "pull", "node1", "ImagePullBackOff"),
+		newNode("node1", corev1.ConditionTrue),
+		newNode("node2", corev1.ConditionUnknown),
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "web"},
+			Status:     appsv1.DeploymentStatus{UnavailableReplicas: 2},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "data"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "backup"},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+			},
+		},
+	)
+
+	tests := []struct {
+		metricName    string
+		rsFilterParam string
+		expect        map[string]string
+	}{
+		{StatePodCrashLoopBackOff, "", map[string]string{"ns1:crash": "1", "ns1:ok": "0", "ns2:pull": "0"}},
+		{StatePodCrashLoopBackOff, `{"ns_name":"ns1","node_id":"node1"}`, map[string]string{"ns1:crash": "1"}},
+		{StateNodeNotReady, "", map[string]string{"node1": "0", "node2": "1"}},
+		{StateDeploymentUnavailableReplicas, `{"ns_name":"ns1","workload_name":"web"}`, map[string]string{"ns1:web": "2"}},
+		{StatePvcPending, `{"ns_name":"ns2"}`, map[string]string{}},
+		{StatePvcPending, `{"ns_name":"ns1"}`, map[string]string{"ns1:data": "1"}},
+		{StateJobFailed, "", map[string]string{"ns1:backup": "1"}},
+	}
+	for _, test := range tests {
+		if got := getStateValues(t, source, test.metricName, test.rsFilterParam); !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("GetMetrics %s %s got %v, expect %v", test.metricName, test.rsFilterParam, got, test.expect)
+		}
+	}
+
+	//Changes of the objects are seen by the next request
+	indexers["pod"].Update(newPod("ns1", "crash", "node1", ""))
+	if got := getStateValues(t, source, StatePodCrashLoopBackOff, `{"pod_name":"crash"}`); got["ns1:crash"] != "0" {
+		t.Errorf("GetMetrics after pod update got %v, expect ns1:crash 0", got)
+	}
+}
+
+func TestStateSourceNotSynced(t *testing.T) {
+	source, _ := newIndexedSource()
+	source.synced = []cache.InformerSynced{func() bool { return false }}
+	_, err := source.GetMetrics(context.Background(), metric.MetricParam{
+		Metrics:      []string{StateNodeNotReady},
+		MetricToRule: map[string][]string{StateNodeNotReady: {"rl-1"}},
+	})
+	if err == nil {
+		t.Errorf("GetMetrics before the informers start expect error")
+	}
+}
@@ -0,0 +1,119 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+//Client queries the Prometheus HTTP API
+type Client struct {
+	Url        string
+	HttpClient *http.Client
+}
+
+//Series is one time series of a range query result
+type Series struct {
+	Metric map[string]string
+	Values []metric.TV
+}
+
+type queryRangeResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+}
+
+func NewClient(prometheusUrl string) *Client {
+	return &Client{
+		Url: strings.TrimSuffix(prometheusUrl, "/"),
+		HttpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: (&net.Dialer{
+					Timeout:   30 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).DialContext,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/1e9, 'f', -1, 64)
+}
+
+//QueryRange evaluates the query over the range with the step by /api/v1/query_range
+func (c *Client) QueryRange(ctx context.Context, query string, start time.Time, end time.Time, step time.Duration) ([]Series, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", formatTime(start))
+	params.Set("end", formatTime(end))
+	params.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+
+	request, err := http.NewRequest("GET", c.Url+"/api/v1/query_range?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.HttpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	contents, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := queryRangeResponse{}
+	err = json.Unmarshal(contents, &result)
+	if err != nil {
+		return nil, fmt.Errorf("query_range status %d: %v", response.StatusCode, err)
+	}
+	if result.Status != "success" {
+		return nil, fmt.Errorf("query_range %s: %s", result.ErrorType, result.Error)
+	}
+	if result.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("query_range unexpected result type [%s]", result.Data.ResultType)
+	}
+
+	series := []Series{}
+	for _, r := range result.Data.Result {
+		s := Series{Metric: r.Metric, Values: []metric.TV{}}
+		for _, value := range r.Values {
+			if len(value) != 2 {
+				continue
+			}
+			t, ok := value[0].(float64)
+			v, isString := value[1].(string)
+			if !ok || !isString {
+				continue
+			}
+			s.Values = append(s.Values, metric.TV{T: int64(t), V: v})
+		}
+		series = append(series, s)
+	}
+
+	return series, nil
+}
@@ -0,0 +1,258 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+//Label holding the resource name of each resource type, cluster metrics have a single resource
+var resourceLabels = map[string]string{
+	"cluster":   "",
+	"node":      "node",
+	"workspace": "workspace",
+	"namespace": "namespace",
+	"workload":  "workload",
+	"pod":       "pod",
+	"container": "container",
+}
+
+//Resource types whose names are only unique in their namespace
+var namespacedTypes = map[string]bool{
+	"workload":  true,
+	"pod":       true,
+	"container": true,
+}
+
+//Label matched by each key of the resource filter param
+var filterLabels = map[string]string{
+	metric.FilterNode:      "node",
+	metric.FilterWorkspace: "workspace",
+	metric.FilterNamespace: "namespace",
+	metric.FilterWorkload:  "workload",
+	metric.FilterPod:       "pod",
+	metric.FilterContainer: "container",
+}
+
+var metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+//Source translates the metric param into range queries of Prometheus, metric names are mapped to PromQL by Queries,
+//metric names which are PromQL expressions themselves are queried as they are
+type Source struct {
+	Client  *Client
+	Step    time.Duration
+	Range   time.Duration
+	Queries map[string]string
+}
+
+func NewSource(prometheusUrl string, step time.Duration, rangeDuration time.Duration, queries map[string]string) *Source {
+	return &Source{
+		Client:  NewClient(prometheusUrl),
+		Step:    step,
+		Range:   rangeDuration,
+		Queries: queries,
+	}
+}
+
+//LoadQueries loads the json object of metric names and their PromQL queries
+func LoadQueries(file string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make(map[string]string)
+	err = json.Unmarshal(data, &queries)
+	if err != nil {
+		return nil, fmt.Errorf("parse query file [%s] error: %v", file, err)
+	}
+	for metricName, query := range queries {
+		if strings.TrimSpace(query) == "" {
+			return nil, fmt.Errorf("query of metric [%s] in query file [%s] is empty", metricName, file)
+		}
+	}
+
+	return queries, nil
+}
+
+//Metric names are names of the metric API rather than Prometheus, so plain names without a query are rejected
+//instead of being queried for series which never exist
+func (s *Source) getQuery(metricName string) (string, error) {
+	if query, ok := s.Queries[metricName]; ok {
+		return query, nil
+	}
+	if metricNameRegexp.MatchString(metricName) {
+		return "", fmt.Errorf("metric [%s] has no PromQL query", metricName)
+	}
+	return metricName, nil
+}
+
+//Parse the resource filter param into the allowed values of each label
+func parseFilterMatchers(rsFilterParam string) (map[string]map[string]bool, error) {
+	filters, err := metric.ParseFilterParam(rsFilterParam)
+	if err != nil {
+		return nil, err
+	}
+
+	matchers := make(map[string]map[string]bool)
+	for key, values := range filters {
+		if label, ok := filterLabels[key]; ok {
+			matchers[label] = values
+		}
+	}
+
+	return matchers, nil
+}
+
+//Add matchers of the resource label and namespace to plain Prometheus metric names, expressions are queried as they are,
+//parent labels such as workspace are left to matchSeries since metrics of a resource may not carry them
+func buildQuery(metricName string, rsTypeName string, matchers map[string]map[string]bool) string {
+	if !metricNameRegexp.MatchString(metricName) {
+		return metricName
+	}
+
+	labels := []string{}
+	for label := range matchers {
+		if label == resourceLabels[rsTypeName] || (label == "namespace" && namespacedTypes[rsTypeName]) {
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) == 0 {
+		return metricName
+	}
+	sort.Strings(labels)
+
+	selectors := []string{}
+	for _, label := range labels {
+		values := []string{}
+		for v := range matchers[label] {
+			values = append(values, regexp.QuoteMeta(v))
+		}
+		sort.Strings(values)
+		selectors = append(selectors, fmt.Sprintf("%s=~%s", label, strconv.Quote(strings.Join(values, "|"))))
+	}
+
+	return fmt.Sprintf("%s{%s}", metricName, strings.Join(selectors, ","))
+}
+
+//Check the series against the filter, labels missing from the series are not checked
+func matchSeries(labels map[string]string, matchers map[string]map[string]bool) bool {
+	return metric.MatchFilters(labels, matchers)
+}
+
+func getResourceName(rsTypeName string, labels map[string]string) string {
+	label, ok := resourceLabels[rsTypeName]
+	if !ok || label == "" {
+		return rsTypeName
+	}
+	if namespacedTypes[rsTypeName] && labels["namespace"] != "" {
+		return labels["namespace"] + ":" + labels[label]
+	}
+	return labels[label]
+}
+
+//Get the end and the length of the window from the offset and range of the extra query params
+func (s *Source) getWindow(extraQueryParams string, now time.Time) (time.Time, time.Duration, error) {
+	params, err := url.ParseQuery(extraQueryParams)
+	if err != nil {
+		return now, 0, err
+	}
+
+	end, rangeDuration := now, s.Range
+	if offset := params.Get(metric.QueryParamOffset); offset != "" {
+		seconds, err := strconv.ParseUint(offset, 10, 32)
+		if err != nil {
+			return now, 0, err
+		}
+		end = now.Add(-time.Duration(seconds) * time.Second)
+	}
+	if rangeSeconds := params.Get(metric.QueryParamRange); rangeSeconds != "" {
+		seconds, err := strconv.ParseUint(rangeSeconds, 10, 32)
+		if err != nil {
+			return now, 0, err
+		}
+		rangeDuration = time.Duration(seconds) * time.Second
+	}
+
+	return end, rangeDuration, nil
+}
+
+//Steps of long ranges are raised to keep the points of one series under the limit of Prometheus
+func (s *Source) getStep(rangeDuration time.Duration) time.Duration {
+	const maxPoints = 10000
+	step := s.Step
+	if minStep := rangeDuration / maxPoints; step < minStep {
+		step = minStep.Truncate(time.Second) + time.Second
+	}
+	return step
+}
+
+//GetMetrics queries each metric once and returns its series for every rule asking for it
+func (s *Source) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	end, rangeDuration, err := s.getWindow(metricParam.ExtraQueryParams, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("illegal extra query params [%s]: %v", metricParam.ExtraQueryParams, err)
+	}
+
+	matchers, err := parseFilterMatchers(metricParam.RsFilterParam)
+	if err != nil {
+		return nil, fmt.Errorf("illegal resource filter param [%s]: %v", metricParam.RsFilterParam, err)
+	}
+
+	//Check all metrics before querying any of them
+	metricNames := []string{}
+	queries := make(map[string]string)
+	for _, metricName := range metricParam.Metrics {
+		if _, ok := queries[metricName]; ok {
+			continue
+		}
+		query, err := s.getQuery(metricName)
+		if err != nil {
+			return nil, err
+		}
+		queries[metricName] = query
+		metricNames = append(metricNames, metricName)
+	}
+
+	resourceMetricsList := []metric.ResourceMetrics{}
+	for _, metricName := range metricNames {
+		series, err := s.Client.QueryRange(ctx, buildQuery(queries[metricName], metricParam.RsTypeName, matchers), end.Add(-rangeDuration), end, s.getStep(rangeDuration))
+		if err != nil {
+			return nil, err
+		}
+
+		//Series of one resource can not be merged into one series, so the query has to aggregate by the resource labels
+		resourceMetric := make(map[string][]metric.TV)
+		for _, ser := range series {
+			if !matchSeries(ser.Metric, matchers) {
+				continue
+			}
+			resourceName := getResourceName(metricParam.RsTypeName, ser.Metric)
+			if _, ok := resourceMetric[resourceName]; ok {
+				return nil, fmt.Errorf("metric [%s] has more than one series of resource [%s], its query needs to aggregate by the labels of the resource", metricName, resourceName)
+			}
+			values := append([]metric.TV{}, ser.Values...)
+			sort.Slice(values, func(i, j int) bool { return values[i].T < values[j].T })
+			resourceMetric[resourceName] = values
+		}
+
+		for _, ruleId := range metricParam.MetricToRule[metricName] {
+			resourceMetricsList = append(resourceMetricsList, metric.ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: resourceMetric,
+			})
+		}
+	}
+
+	return resourceMetricsList, nil
+}
@@ -0,0 +1,205 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+func newTestServer(t *testing.T, queries *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		query := r.URL.Query().Get("query")
+		*queries = append(*queries, query)
+
+		if query == "bad(" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[`+
+			`{"metric":{"namespace":"ns1","pod":"a"},"values":[[1000,"1"],[1060,"2"]]},`+
+			`{"metric":{"namespace":"ns1","pod":"b"},"values":[[1000,"3"]]},`+
+			`{"metric":{"namespace":"ns2","pod":"c"},"values":[[1000,"4"]]}]}}`)
+	}))
+}
+
+func TestGetMetrics(t *testing.T) {
+	queries := []string{}
+	server := newTestServer(t, &queries)
+	defer server.Close()
+
+	source := NewSource(server.URL, time.Minute, 5*time.Minute, map[string]string{"pod_cpu_usage": "container_cpu_usage"})
+	resourceMetricsList, err := source.GetMetrics(context.Background(), metric.MetricParam{
+		RsTypeName:    "pod",
+		RsFilterParam: `{"ns_name":"ns1","pod_name":"a|b"}`,
+		Metrics:       []string{"pod_cpu_usage", "pod_cpu_usage"},
+		MetricToRule:  map[string][]string{"pod_cpu_usage": {"rl-1", "rl-2"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//Metrics asked by several rules are queried once
+	if len(queries) != 1 || queries[0] != `container_cpu_usage{namespace=~"ns1",pod=~"a|b"}` {
+		t.Errorf("GetMetrics queries %v", queries)
+	}
+	if len(resourceMetricsList) != 2 || resourceMetricsList[0].RuleId != "rl-1" || resourceMetricsList[1].RuleId != "rl-2" {
+		t.Fatalf("GetMetrics got %v, expect results of rl-1 and rl-2", resourceMetricsList)
+	}
+
+	resourceMetric := resourceMetricsList[0].ResourceMetric
+	if len(resourceMetric) != 2 || len(resourceMetric["ns1:a"]) != 2 || resourceMetric["ns1:b"][0].V != "3" {
+		t.Errorf("GetMetrics resources got %v, expect ns1:a and ns1:b", resourceMetric)
+	}
+}
+
+func TestGetMetricsError(t *testing.T) {
+	queries := []string{}
+	server := newTestServer(t, &queries)
+	defer server.Close()
+
+	source := NewSource(server.URL, time.Minute, 5*time.Minute, nil)
+	_, err := source.GetMetrics(context.Background(), metric.MetricParam{
+		RsTypeName:   "node",
+		Metrics:      []string{"bad("},
+		MetricToRule: map[string][]string{"bad(": {"rl-1"}},
+	})
+	if err == nil {
+		t.Errorf("GetMetrics of a bad query expect error")
+	}
+}
+
+func TestGetMetricsSeries(t *testing.T) {
+	body := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	source := NewSource(server.URL, time.Minute, 5*time.Minute, map[string]string{"node_cpu_utilisation": "node_cpu"})
+	metricParam := metric.MetricParam{
+		RsTypeName:   "node",
+		Metrics:      []string{"node_cpu_utilisation"},
+		MetricToRule: map[string][]string{"node_cpu_utilisation": {"rl-1"}},
+	}
+
+	//Samples of each resource are sorted by time
+	body = `{"status":"success","data":{"resultType":"matrix","result":[` +
+		`{"metric":{"node":"node1"},"values":[[1060,"2"],[1000,"1"]]}]}}`
+	resourceMetricsList, err := source.GetMetrics(context.Background(), metricParam)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tvs := resourceMetricsList[0].ResourceMetric["node1"]; len(tvs) != 2 || tvs[0].T != 1000 || tvs[1].T != 1060 {
+		t.Errorf("GetMetrics got samples %v, expect sorted by time", tvs)
+	}
+
+	//Series differing in labels other than the resource labels are not merged
+	body = `{"status":"success","data":{"resultType":"matrix","result":[` +
+		`{"metric":{"node":"node1","cpu":"0"},"values":[[1000,"1"]]},` +
+		`{"metric":{"node":"node1","cpu":"1"},"values":[[1000,"3"]]}]}}`
+	if _, err := source.GetMetrics(context.Background(), metricParam); err == nil {
+		t.Errorf("GetMetrics of several series of one resource expect error")
+	}
+}
+
+func TestGetMetricsQueries(t *testing.T) {
+	queries := []string{}
+	server := newTestServer(t, &queries)
+	defer server.Close()
+
+	source := NewSource(server.URL, time.Minute, 5*time.Minute, map[string]string{
+		"pod_cpu_usage":    `sum by (namespace, pod) (rate(container_cpu_usage_seconds_total[5m]))`,
+		"pod_memory_usage": "container_memory_usage_bytes",
+	})
+	_, err := source.GetMetrics(context.Background(), metric.MetricParam{
+		RsTypeName:    "pod",
+		RsFilterParam: `{"ns_name":"ns1"}`,
+		Metrics:       []string{"pod_cpu_usage", "sum(up)"},
+		MetricToRule:  map[string][]string{"pod_cpu_usage": {"rl-1"}, "sum(up)": {"rl-2"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//Mapped expressions and PromQL expressions are queried as they are
+	expectQueries := []string{`sum by (namespace, pod) (rate(container_cpu_usage_seconds_total[5m]))`, "sum(up)"}
+	if !reflect.DeepEqual(queries, expectQueries) {
+		t.Errorf("GetMetrics queries %v, expect %v", queries, expectQueries)
+	}
+
+	//Unmapped metric names fail before anything is queried
+	queries = queries[:0]
+	_, err = source.GetMetrics(context.Background(), metric.MetricParam{
+		RsTypeName:   "pod",
+		Metrics:      []string{"pod_memory_usage", "pod_net_bytes_received"},
+		MetricToRule: map[string][]string{"pod_memory_usage": {"rl-1"}, "pod_net_bytes_received": {"rl-2"}},
+	})
+	if err == nil || len(queries) != 0 {
+		t.Errorf("GetMetrics of unmapped metric got error %v queries %v, expect error without queries", err, queries)
+	}
+}
+
+func TestLoadQueries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name      string
+		content   string
+		expect    map[string]string
+		expectErr bool
+	}{
+		{"queries", `{"node_cpu_utilisation": "node:node_cpu_utilisation:avg1m"}`, map[string]string{"node_cpu_utilisation": "node:node_cpu_utilisation:avg1m"}, false},
+		{"empty query", `{"node_cpu_utilisation": " "}`, nil, true},
+		{"not json", `node_cpu_utilisation`, nil, true},
+	}
+
+	for i, test := range tests {
+		file := filepath.Join(dir, fmt.Sprintf("queries%d.json", i))
+		if err := ioutil.WriteFile(file, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		queries, err := LoadQueries(file)
+		if (err != nil) != test.expectErr || !reflect.DeepEqual(queries, test.expect) {
+			t.Errorf("%s: LoadQueries got %v %v, expect %v error %v", test.name, queries, err, test.expect, test.expectErr)
+		}
+	}
+
+	if _, err := LoadQueries(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadQueries of missing file expect error")
+	}
+}
+
+func TestGetWindow(t *testing.T) {
+	source := NewSource("", time.Minute, 5*time.Minute, nil)
+	now := time.Unix(100000, 0)
+
+	end, rangeDuration, err := source.getWindow(metric.OffsetQueryParams(3600), now)
+	if err != nil || !end.Equal(now.Add(-time.Hour)) || rangeDuration != 5*time.Minute {
+		t.Errorf("getWindow of offset got %v %v %v", end, rangeDuration, err)
+	}
+
+	end, rangeDuration, err = source.getWindow(metric.RangeQueryParams(30*86400), now)
+	if err != nil || !end.Equal(now) || rangeDuration != 30*24*time.Hour {
+		t.Errorf("getWindow of range got %v %v %v", end, rangeDuration, err)
+	}
+	//30 days at 1 minute step exceed the points limit
+	if step := source.getStep(rangeDuration); rangeDuration/step > 10000 {
+		t.Errorf("getStep got %v, too many points", step)
+	}
+}
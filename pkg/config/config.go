@@ -39,6 +39,25 @@ type Config struct {
 		Addr string `default:"redis://redis.kubesphere-system.svc:6379"`
 	}
 
+	Prometheus struct {
+		Url          string `default:"http://prometheus-k8s.kubesphere-monitoring-system.svc:9090"`
+		StepSeconds  int    `default:"60"`
+		RangeSeconds int    `default:"300"`
+		QueryFile    string `default:""` // json object of metric names and their PromQL queries
+	}
+
+	Replay struct {
+		File            string `default:""`
+		IntervalSeconds int    `default:"60"` // 0 to advance only by Advance
+		Loop            bool   `default:"true"`
+	}
+
+	Kubernetes struct {
+		ObjectState        bool `default:"false"` // serve object state metrics from informers of the in-cluster clientset
+		ResyncSeconds      int  `default:"300"`
+		SyncTimeoutSeconds int  `default:"60"` // wait for the caches of the informers at startup
+	}
+
 	App struct {
 		Host string `default:"localhost"`
 		Port string `default:"9201"`
@@ -51,6 +70,27 @@ type Config struct {
 		RunMode string `default:"none"`
 
 		AdapterPort string `default:"8080"`
+
+		AdapterRetries                   int `default:"2"`
+		AdapterRetryIntervalMilliseconds int `default:"100"`
+		AdapterBreakerFailures           int `default:"5"` // 0 to disable the breaker
+		AdapterBreakerOpenSeconds        int `default:"30"`
+		AdapterEmailTimeoutSeconds       int `default:"10"`
+
+		MetricSource string `default:"adapter"` // adapter, prometheus, replay
+
+		MetricCoalesce       bool `default:"true"`
+		MetricCacheSeconds   int  `default:"5"`
+		MetricTimeoutSeconds int  `default:"10"` // timeout of each metric request, retries of the adapter included
+
+		InhibitChildResources bool `default:"false"`
+		InhibitRefreshSeconds int  `default:"10"` // reload the persisted status of inhibit rules of all executors
+
+		ResourceGoneMinutes int  `default:"60"`
+		ResourceGoneNotify  bool `default:"false"`
+
+		ScriptMaxSteps            int `default:"100000"`
+		ScriptTimeoutMilliseconds int `default:"100"`
 	}
 }
 
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN metric_expression varchar(255) NOT NULL DEFAULT '' COMMENT 'arithmetic expression of metric names evaluated per resource, empty means the metric of metric_id';
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN threshold_schedule varchar(2048) NOT NULL DEFAULT '' COMMENT 'threshold schedule: {"timezone":"tz","windows":[{"name":"n","weekdays":[1],"start":"09:00","end":"18:00","thresholds":"v"}...]}';
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN offset_window int DEFAULT 0 NOT NULL COMMENT 'seconds the compared window of ratio and diff conditions lies in the past';
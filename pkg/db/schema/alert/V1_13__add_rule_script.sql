@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN script varchar(4096) NOT NULL DEFAULT '' COMMENT 'starlark script of script rules defining check(samples, status, thresholds)';
@@ -0,0 +1,17 @@
+CREATE TABLE slo
+(
+	slo_id varchar(50) NOT NULL,
+	slo_name varchar(255) NOT NULL,
+	target varchar(20) NOT NULL COMMENT 'percentage of good events, eg. 99.9',
+	window_days int DEFAULT 30 NOT NULL COMMENT 'unit：day',
+	good_metric_id varchar(50) NOT NULL,
+	total_metric_id varchar(50) NOT NULL,
+	burn_windows varchar(1024) NOT NULL DEFAULT '' COMMENT 'json burn windows, empty means 1h/5m and 6h/30m',
+	disabled boolean DEFAULT false NOT NULL,
+	policy_id varchar(50) NOT NULL,
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (slo_id)
+);
+
+CREATE INDEX index_slo_policy_id ON slo(policy_id(50));
@@ -0,0 +1 @@
+INSERT INTO `metric` VALUES ('mt-os4pQ1cLkZbE','object_state_pod_crashloopbackoff','1.0','active',NOW(),NOW(),'rst-7ENxQzjLKrpl'),('mt-os7Nw2fRmJqA','object_state_node_not_ready','1.0','active',NOW(),NOW(),'rst-3m8ZmxVylG90'),('mt-os9Kd5hTnXvC','object_state_deployment_unavailable_replicas','1.0','active',NOW(),NOW(),'rst-rBYPE5KLKrpl'),('mt-osB3jx8YpLwD','object_state_pvc_pending','1.0','active',NOW(),NOW(),'rst-pX1mLzBoJ3mA'),('mt-osE6mV0zQsGF','object_state_job_failed','1.0','active',NOW(),NOW(),'rst-rBYPE5KLKrpl');
@@ -0,0 +1 @@
+ALTER TABLE rule MODIFY thresholds varchar(255) NOT NULL COMMENT 'thresholds: v1|v2... or condition expression';
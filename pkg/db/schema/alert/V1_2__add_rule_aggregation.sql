@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN aggregation varchar(50) NOT NULL DEFAULT '' COMMENT 'window aggregation: last|avg|min|max|sum|rate|delta|pNN|count_over(v)';
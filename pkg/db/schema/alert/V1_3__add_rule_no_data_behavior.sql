@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN no_data_behavior varchar(20) NOT NULL DEFAULT 'keep' COMMENT 'no data behavior: keep|alert|resolve';
@@ -0,0 +1,2 @@
+ALTER TABLE rule ADD COLUMN recovery_thresholds varchar(255) NOT NULL DEFAULT '' COMMENT 'recovery thresholds, empty means same as thresholds';
+ALTER TABLE rule ADD COLUMN consecutive_recovery_count int DEFAULT 1 NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN levels varchar(1024) NOT NULL DEFAULT '' COMMENT 'levels: [{"thresholds":"v","severity":"s"}...] from low to high severity';
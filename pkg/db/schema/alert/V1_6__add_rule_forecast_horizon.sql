@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN forecast_horizon int DEFAULT 1 NOT NULL COMMENT 'hours predicted ahead by forecast conditions';
@@ -0,0 +1,12 @@
+CREATE TABLE rule_override
+(
+	override_id varchar(50) NOT NULL,
+	rule_id varchar(50) NOT NULL,
+	resource_name varchar(255) NOT NULL,
+	thresholds varchar(255) NOT NULL,
+	recovery_thresholds varchar(255) NOT NULL DEFAULT '' COMMENT 'recovery thresholds, empty means same as thresholds',
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (override_id),
+	UNIQUE KEY unique_rule_override_resource (rule_id, resource_name)
+);
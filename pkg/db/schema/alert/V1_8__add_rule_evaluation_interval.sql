@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN evaluation_interval int DEFAULT 0 NOT NULL COMMENT 'seconds between evaluations, 0 means monitor_periods minutes';
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN group_condition varchar(50) NOT NULL DEFAULT '' COMMENT 'condition on violating resources of the batch: >3 for count, >=20% for ratio';
@@ -48,6 +48,16 @@ var (
 		en:   "illegal Time format [%s]",
 		zhCN: "非法的时间格式[%s]",
 	}
+	ErrorIllegalExpression = ErrorMessage{
+		Name: "illegal_expression",
+		en:   "illegal expression [%s]",
+		zhCN: "非法的表达式[%s]",
+	}
+	ErrorIllegalScript = ErrorMessage{
+		Name: "illegal_script",
+		en:   "illegal script [%s]",
+		zhCN: "非法的脚本[%s]",
+	}
 	ErrorDescribeResourcesFailed = ErrorMessage{
 		Name: "describe_resources_failed",
 		en:   "describe resources failed",
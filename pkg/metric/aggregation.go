@@ -0,0 +1,219 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	AggregationLast      = "last"
+	AggregationAvg       = "avg"
+	AggregationMin       = "min"
+	AggregationMax       = "max"
+	AggregationSum       = "sum"
+	AggregationRate      = "rate"
+	AggregationDelta     = "delta"
+	AggregationCountOver = "count_over"
+	AggregationPercent   = "p"
+)
+
+//Aggregation describes how the time series of one resource is reduced to a single value.
+//Supported forms: last, avg, min, max, sum, rate, delta, pNN (e.g. p95), count_over(threshold).
+type Aggregation struct {
+	Kind  string
+	Param float64
+}
+
+func ParseAggregation(aggregation string) (Aggregation, error) {
+	aggregation = strings.TrimSpace(aggregation)
+
+	switch aggregation {
+	case "":
+		return Aggregation{Kind: AggregationLast}, nil
+	case AggregationLast, AggregationAvg, AggregationMin, AggregationMax, AggregationSum, AggregationRate, AggregationDelta:
+		return Aggregation{Kind: aggregation}, nil
+	}
+
+	if strings.HasPrefix(aggregation, AggregationCountOver+"(") && strings.HasSuffix(aggregation, ")") {
+		param := strings.TrimSuffix(strings.TrimPrefix(aggregation, AggregationCountOver+"("), ")")
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(param), 64)
+		if err != nil {
+			return Aggregation{}, fmt.Errorf("illegal count_over threshold [%s]", param)
+		}
+		return Aggregation{Kind: AggregationCountOver, Param: threshold}, nil
+	}
+
+	if strings.HasPrefix(aggregation, AggregationPercent) {
+		percentile, err := strconv.ParseFloat(strings.TrimPrefix(aggregation, AggregationPercent), 64)
+		if err == nil && percentile > 0 && percentile <= 100 {
+			return Aggregation{Kind: AggregationPercent, Param: percentile}, nil
+		}
+	}
+
+	return Aggregation{}, fmt.Errorf("unsupported aggregation [%s]", aggregation)
+}
+
+//Parse values of the time series, unparsable samples are skipped
+func ParseValues(tvs []TV, scale float64) ([]float64, []int64) {
+	values := []float64{}
+	times := []int64{}
+	for _, tv := range tvs {
+		v, err := strconv.ParseFloat(tv.V, 64)
+		if err != nil || math.IsNaN(v) {
+			continue
+		}
+		values = append(values, v*scale)
+		times = append(times, tv.T)
+	}
+	return values, times
+}
+
+func percentile(values []float64, p float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+//Aggregate reduces the scaled values of the time series to one value
+func (a Aggregation) Aggregate(tvs []TV, scale float64) (float64, error) {
+	values, times := ParseValues(tvs, scale)
+	if len(values) == 0 {
+		return 0, errors.New("no valid sample")
+	}
+
+	last := len(values) - 1
+
+	switch a.Kind {
+	case AggregationLast:
+		return values[last], nil
+	case AggregationAvg, AggregationSum:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		if a.Kind == AggregationAvg {
+			return sum / float64(len(values)), nil
+		}
+		return sum, nil
+	case AggregationMin:
+		min := values[0]
+		for _, v := range values {
+			min = math.Min(min, v)
+		}
+		return min, nil
+	case AggregationMax:
+		max := values[0]
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+		return max, nil
+	case AggregationDelta:
+		return values[last] - values[0], nil
+	case AggregationRate:
+		if len(values) < 2 || times[last] == times[0] {
+			return 0, errors.New("rate needs at least two samples")
+		}
+		return (values[last] - values[0]) / float64(times[last]-times[0]), nil
+	case AggregationCountOver:
+		count := 0
+		for _, v := range values {
+			if v > a.Param {
+				count++
+			}
+		}
+		return float64(count), nil
+	case AggregationPercent:
+		return percentile(values, a.Param), nil
+	}
+
+	return 0, fmt.Errorf("unsupported aggregation [%s]", a.Kind)
+}
+
+//Change of the scaled values between the first and last sample of the time series,
+//in percent of the first value if percent is set
+func Change(tvs []TV, scale float64, percent bool) (float64, error) {
+	values, _ := ParseValues(tvs, scale)
+	if len(values) < 2 {
+		return 0, errors.New("change needs at least two samples")
+	}
+
+	first := values[0]
+	delta := values[len(values)-1] - first
+	if !percent {
+		return delta, nil
+	}
+	if first == 0 {
+		return 0, errors.New("percent change from zero")
+	}
+	return delta / math.Abs(first) * 100, nil
+}
+
+//LinearFit fits the scaled values of the time series to value = slope*t + intercept by least squares
+func LinearFit(tvs []TV, scale float64) (float64, float64, error) {
+	values, times := ParseValues(tvs, scale)
+	if len(values) < 2 {
+		return 0, 0, errors.New("linear fit needs at least two samples")
+	}
+
+	//Center times on the first sample to keep precision
+	n := float64(len(values))
+	sumT, sumV, sumTT, sumTV := 0.0, 0.0, 0.0, 0.0
+	for i, v := range values {
+		t := float64(times[i] - times[0])
+		sumT += t
+		sumV += v
+		sumTT += t * t
+		sumTV += t * v
+	}
+
+	denominator := n*sumTT - sumT*sumT
+	if denominator == 0 {
+		return 0, 0, errors.New("linear fit needs samples at different times")
+	}
+
+	slope := (n*sumTV - sumT*sumV) / denominator
+	intercept := (sumV - slope*sumT) / n
+	return slope, intercept - slope*float64(times[0]), nil
+}
+
+//MeanStddev returns the mean and the population standard deviation of the values
+func MeanStddev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean = mean / float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+//MedianMAD returns the median and the median absolute deviation of the values
+func MedianMAD(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	median := percentile(values, 50)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - median)
+	}
+	return median, percentile(deviations, 50)
+}
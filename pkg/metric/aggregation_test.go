@@ -0,0 +1,110 @@
+package metric
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	tvs := []TV{{0, "1"}, {60, "4"}, {120, "bad"}, {180, "2"}, {240, "3"}}
+
+	testCases := []struct {
+		aggregation string
+		expect      float64
+	}{
+		{"", 3},
+		{"last", 3},
+		{"avg", 2.5},
+		{"min", 1},
+		{"max", 4},
+		{"sum", 10},
+		{"delta", 2},
+		{"rate", 2.0 / 240},
+		{"p50", 2.5},
+		{"p100", 4},
+		{"count_over(2)", 2},
+	}
+
+	for _, tc := range testCases {
+		a, err := ParseAggregation(tc.aggregation)
+		if err != nil {
+			t.Fatalf("ParseAggregation [%s] failed: %v", tc.aggregation, err)
+		}
+		v, err := a.Aggregate(tvs, 1)
+		if err != nil {
+			t.Fatalf("Aggregate [%s] failed: %v", tc.aggregation, err)
+		}
+		if v != tc.expect {
+			t.Fatalf("Aggregate [%s] expect %v, got %v", tc.aggregation, tc.expect, v)
+		}
+	}
+}
+
+func TestParseAggregationError(t *testing.T) {
+	testCases := []string{"median", "p0", "p101", "count_over()", "count_over(x)"}
+
+	for _, tc := range testCases {
+		if _, err := ParseAggregation(tc); err == nil {
+			t.Fatalf("ParseAggregation [%s] should fail", tc)
+		}
+	}
+}
+
+func TestChange(t *testing.T) {
+	testCases := []struct {
+		tvs     []TV
+		percent bool
+		expect  float64
+	}{
+		{[]TV{{0, "50"}, {60, "bad"}, {120, "40"}}, false, -10},
+		{[]TV{{0, "50"}, {60, "bad"}, {120, "40"}}, true, -20},
+		{[]TV{{0, "-4"}, {60, "-2"}}, true, 50},
+	}
+
+	for _, tc := range testCases {
+		v, err := Change(tc.tvs, 1, tc.percent)
+		if err != nil {
+			t.Fatalf("Change %v failed: %v", tc.tvs, err)
+		}
+		if v != tc.expect {
+			t.Fatalf("Change %v expect %v, got %v", tc.tvs, tc.expect, v)
+		}
+	}
+
+	if _, err := Change([]TV{{0, "1"}}, 1, false); err == nil {
+		t.Fatalf("Change of one sample should fail")
+	}
+	if _, err := Change([]TV{{0, "0"}, {60, "1"}}, 1, true); err == nil {
+		t.Fatalf("Percent change from zero should fail")
+	}
+}
+
+func TestLinearFit(t *testing.T) {
+	tvs := []TV{{1000, "10"}, {1060, "bad"}, {1120, "22"}, {1180, "28"}, {1240, "34"}}
+
+	slope, intercept, err := LinearFit(tvs, 1)
+	if err != nil {
+		t.Fatalf("LinearFit failed: %v", err)
+	}
+	if math.Abs(slope-0.1) > 1e-9 || math.Abs(intercept+90) > 1e-6 {
+		t.Fatalf("LinearFit expect 0.1 -90, got %v %v", slope, intercept)
+	}
+
+	if _, _, err := LinearFit([]TV{{0, "1"}, {0, "2"}}, 1); err == nil {
+		t.Fatalf("LinearFit of samples at same time should fail")
+	}
+}
+
+func TestBaselineStatistics(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	mean, stddev := MeanStddev(values)
+	if mean != 5 || stddev != 2 {
+		t.Fatalf("MeanStddev expect 5 2, got %v %v", mean, stddev)
+	}
+
+	median, mad := MedianMAD([]float64{1, 1, 2, 2, 4, 6, 9})
+	if median != 2 || mad != 1 {
+		t.Fatalf("MedianMAD expect 2 1, got %v %v", median, mad)
+	}
+}
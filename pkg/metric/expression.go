@@ -0,0 +1,84 @@
+package metric
+
+import (
+	"math"
+	"strconv"
+
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+//Evaluate the arithmetic expression over the time series of several metrics of one resource,
+//variables are metric names, only times with a valid sample of every metric are kept
+func EvalExpression(expr *exprutil.Expr, metricTVs map[string][]TV) []TV {
+	names := expr.Variables()
+	if len(names) == 0 {
+		return []TV{}
+	}
+
+	valuesAt := make(map[string]map[int64]float64)
+	for _, name := range names {
+		values, times := ParseValues(metricTVs[name], 1)
+		valuesAt[name] = make(map[int64]float64)
+		for i, t := range times {
+			valuesAt[name][t] = values[i]
+		}
+	}
+
+	tvs := []TV{}
+	for _, tv := range metricTVs[names[0]] {
+		vars := make(map[string]float64)
+		for _, name := range names {
+			v, ok := valuesAt[name][tv.T]
+			if !ok {
+				break
+			}
+			vars[name] = v
+		}
+		if len(vars) != len(names) {
+			continue
+		}
+
+		v, err := expr.Eval(vars)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		tvs = append(tvs, TV{tv.T, strconv.FormatFloat(v, 'f', -1, 64)})
+	}
+
+	return tvs
+}
+
+//Combine the metrics of one rule into the metric of the expression,
+//resources missing from any metric are left out
+func CombineResourceMetrics(ruleId string, expr *exprutil.Expr, resourceMetricsList []ResourceMetrics) ResourceMetrics {
+	combined := ResourceMetrics{
+		RuleId:         ruleId,
+		MetricName:     expr.String(),
+		ResourceMetric: make(map[string][]TV),
+	}
+
+	resourceTVs := make(map[string]map[string][]TV)
+	for _, resourceMetrics := range resourceMetricsList {
+		for resourceName, tvs := range resourceMetrics.ResourceMetric {
+			if _, ok := resourceTVs[resourceName]; !ok {
+				resourceTVs[resourceName] = make(map[string][]TV)
+			}
+			resourceTVs[resourceName][resourceMetrics.MetricName] = tvs
+		}
+	}
+
+	for resourceName, metricTVs := range resourceTVs {
+		complete := true
+		for _, name := range expr.Variables() {
+			if _, ok := metricTVs[name]; !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			combined.ResourceMetric[resourceName] = EvalExpression(expr, metricTVs)
+		}
+	}
+
+	return combined
+}
@@ -0,0 +1,45 @@
+package metric
+
+import (
+	"testing"
+
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+func TestEvalExpression(t *testing.T) {
+	expr, err := exprutil.Parse("usage / limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metricTVs := map[string][]TV{
+		"usage": {{0, "1"}, {60, "2"}, {120, "bad"}, {180, "3"}},
+		"limit": {{0, "4"}, {60, "0"}, {120, "4"}, {240, "4"}},
+	}
+
+	tvs := EvalExpression(expr, metricTVs)
+	if len(tvs) != 1 || tvs[0].T != 0 || tvs[0].V != "0.25" {
+		t.Errorf("EvalExpression got %v, expect [{0 0.25}]", tvs)
+	}
+}
+
+func TestCombineResourceMetrics(t *testing.T) {
+	expr, err := exprutil.Parse("tx - rx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceMetricsList := []ResourceMetrics{
+		{RuleId: "rl-1", MetricName: "tx", ResourceMetric: map[string][]TV{"a": {{0, "5"}}, "b": {{0, "1"}}}},
+		{RuleId: "rl-1", MetricName: "rx", ResourceMetric: map[string][]TV{"a": {{0, "2"}}}},
+	}
+
+	combined := CombineResourceMetrics("rl-1", expr, resourceMetricsList)
+	if combined.RuleId != "rl-1" || len(combined.ResourceMetric) != 1 {
+		t.Fatalf("CombineResourceMetrics got %v, expect only resource a", combined)
+	}
+	tvs := combined.ResourceMetric["a"]
+	if len(tvs) != 1 || tvs[0].V != "3" {
+		t.Errorf("CombineResourceMetrics resource a got %v, expect [{0 3}]", tvs)
+	}
+}
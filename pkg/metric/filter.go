@@ -0,0 +1,54 @@
+package metric
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+//Keys of the resource filter param, values of a key are separated by |
+const (
+	FilterNode      = "node_id"
+	FilterWorkspace = "ws_name"
+	FilterNamespace = "ns_name"
+	FilterWorkload  = "workload_name"
+	FilterPod       = "pod_name"
+	FilterContainer = "container_name"
+)
+
+//ParseFilterParam parses the resource filter param into the allowed values of each key, keys without values are left out
+func ParseFilterParam(rsFilterParam string) (map[string]map[string]bool, error) {
+	filters := make(map[string]map[string]bool)
+	if rsFilterParam == "" {
+		return filters, nil
+	}
+
+	params := map[string]string{}
+	err := json.Unmarshal([]byte(rsFilterParam), &params)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range params {
+		if value == "" {
+			continue
+		}
+		filters[key] = make(map[string]bool)
+		for _, v := range strings.Split(value, "|") {
+			if v != "" {
+				filters[key][v] = true
+			}
+		}
+	}
+
+	return filters, nil
+}
+
+//MatchFilters checks the values of the resource against the filters, keys the resource has no value of are not checked
+func MatchFilters(values map[string]string, filters map[string]map[string]bool) bool {
+	for key, allowed := range filters {
+		if v, ok := values[key]; ok && !allowed[v] {
+			return false
+		}
+	}
+	return true
+}
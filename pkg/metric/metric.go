@@ -1,6 +1,9 @@
 package metric
 
-import ()
+import (
+	"net/url"
+	"strconv"
+)
 
 type MetricParam struct {
 	RsTypeName       string              `json:"rs_type_name"`
@@ -21,4 +24,32 @@ type ResourceMetrics struct {
 	RuleId         string
 	MetricName     string
 	ResourceMetric map[string][]TV
+	//Seconds the window of the result lies in the past, set for results of offset requests
+	OffsetSeconds uint32 `json:"-"`
+	//Time values of the offset window for each resource, attached before the rule is checked
+	OffsetMetric map[string][]TV `json:"-"`
+	//Seconds of the window of the result, set for results of range requests
+	RangeSeconds uint32 `json:"-"`
+}
+
+//Query param asking the adapter for the window offset seconds in the past
+const (
+	QueryParamOffset = "offset"
+)
+
+func OffsetQueryParams(offsetSeconds uint32) string {
+	params := url.Values{}
+	params.Set(QueryParamOffset, strconv.FormatUint(uint64(offsetSeconds), 10))
+	return params.Encode()
+}
+
+//Query param asking the adapter for the samples of the last range seconds instead of the monitor periods
+const (
+	QueryParamRange = "range"
+)
+
+func RangeQueryParams(rangeSeconds uint32) string {
+	params := url.Values{}
+	params.Set(QueryParamRange, strconv.FormatUint(uint64(rangeSeconds), 10))
+	return params.Encode()
 }
@@ -0,0 +1,17 @@
+package metric
+
+import (
+	"testing"
+)
+
+func TestOffsetQueryParams(t *testing.T) {
+	if params := OffsetQueryParams(86400); params != "offset=86400" {
+		t.Errorf("OffsetQueryParams got %s, expect offset=86400", params)
+	}
+}
+
+func TestRangeQueryParams(t *testing.T) {
+	if params := RangeQueryParams(3600); params != "range=3600" {
+		t.Errorf("RangeQueryParams got %s, expect range=3600", params)
+	}
+}
@@ -0,0 +1,257 @@
+package metric
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Columns of a csv fixture, the rule column is optional
+const (
+	ReplayColTick     = "tick"
+	ReplayColRule     = "rule"
+	ReplayColMetric   = "metric"
+	ReplayColResource = "resource"
+	ReplayColTime     = "time"
+	ReplayColValue    = "value"
+)
+
+//ReplaySource plays back recorded metrics tick by tick, each tick holds the results returned for one evaluation.
+//Results of a tick without rule id are returned for every rule asking for the metric, results with rule id only for the rule.
+//Ticks advance by Advance, or every interval since the first request if the interval is set,
+//ticks past the end of the fixture start over if loop is set or keep the last tick otherwise.
+type ReplaySource struct {
+	Ticks    [][]ResourceMetrics
+	Interval time.Duration
+	Loop     bool
+
+	mutex sync.Mutex
+	tick  int
+	start time.Time
+}
+
+func NewReplaySource(ticks [][]ResourceMetrics, interval time.Duration, loop bool) *ReplaySource {
+	return &ReplaySource{
+		Ticks:    ticks,
+		Interval: interval,
+		Loop:     loop,
+	}
+}
+
+//LoadReplaySource loads the fixture by the extension of the file, .csv for csv and json otherwise
+func LoadReplaySource(file string, interval time.Duration, loop bool) (*ReplaySource, error) {
+	var ticks [][]ResourceMetrics
+
+	if strings.ToLower(filepath.Ext(file)) == ".csv" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		ticks, err = ParseReplayCSV(f)
+		if err != nil {
+			return nil, fmt.Errorf("parse replay file [%s] error: %v", file, err)
+		}
+	} else {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		ticks, err = ParseReplayJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse replay file [%s] error: %v", file, err)
+		}
+	}
+
+	return NewReplaySource(ticks, interval, loop), nil
+}
+
+//ParseReplayJSON parses an array of ticks, each tick is an array of results in the shape returned by the adapter
+func ParseReplayJSON(data []byte) ([][]ResourceMetrics, error) {
+	ticks := [][]ResourceMetrics{}
+	err := json.Unmarshal(data, &ticks)
+	if err != nil {
+		return nil, err
+	}
+	if len(ticks) == 0 {
+		return nil, fmt.Errorf("replay has no ticks")
+	}
+
+	for i, tick := range ticks {
+		for _, resourceMetrics := range tick {
+			if resourceMetrics.MetricName == "" {
+				return nil, fmt.Errorf("tick %d has result without metric name", i)
+			}
+		}
+	}
+
+	return ticks, nil
+}
+
+//ParseReplayCSV parses one sample per row with a header naming the columns, ticks are numbered from 0
+func ParseReplayCSV(r io.Reader) ([][]ResourceMetrics, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{ReplayColTick, ReplayColMetric, ReplayColResource, ReplayColTime, ReplayColValue} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("replay header has no column [%s]", name)
+		}
+	}
+
+	ticks := [][]ResourceMetrics{}
+	//tick -> rule and metric -> index of the result in the tick
+	indexes := []map[string]int{}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tick, err := strconv.Atoi(record[columns[ReplayColTick]])
+		if err != nil || tick < 0 {
+			return nil, fmt.Errorf("line %d has invalid tick [%s]", line, record[columns[ReplayColTick]])
+		}
+		t, err := strconv.ParseInt(record[columns[ReplayColTime]], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d has invalid time [%s]", line, record[columns[ReplayColTime]])
+		}
+		ruleId := ""
+		if i, ok := columns[ReplayColRule]; ok {
+			ruleId = record[i]
+		}
+		metricName := record[columns[ReplayColMetric]]
+		if metricName == "" {
+			return nil, fmt.Errorf("line %d has no metric name", line)
+		}
+		resourceName := record[columns[ReplayColResource]]
+
+		for len(ticks) <= tick {
+			ticks = append(ticks, []ResourceMetrics{})
+			indexes = append(indexes, make(map[string]int))
+		}
+
+		key := ruleId + " " + metricName
+		index, ok := indexes[tick][key]
+		if !ok {
+			index = len(ticks[tick])
+			indexes[tick][key] = index
+			ticks[tick] = append(ticks[tick], ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: make(map[string][]TV),
+			})
+		}
+		resourceMetric := ticks[tick][index].ResourceMetric
+		resourceMetric[resourceName] = append(resourceMetric[resourceName], TV{T: t, V: record[columns[ReplayColValue]]})
+	}
+
+	if len(ticks) == 0 {
+		return nil, fmt.Errorf("replay has no ticks")
+	}
+
+	return ticks, nil
+}
+
+//Advance moves to the next tick
+func (rs *ReplaySource) Advance() {
+	rs.mutex.Lock()
+	rs.tick++
+	rs.mutex.Unlock()
+}
+
+//CurrentTick returns the index of the tick played now
+func (rs *ReplaySource) CurrentTick() int {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+	return rs.currentTick(time.Now())
+}
+
+func (rs *ReplaySource) currentTick(now time.Time) int {
+	tick := rs.tick
+	if rs.Interval > 0 {
+		if rs.start.IsZero() {
+			rs.start = now
+		}
+		tick += int(now.Sub(rs.start) / rs.Interval)
+	}
+
+	if tick >= len(rs.Ticks) {
+		if rs.Loop {
+			return tick % len(rs.Ticks)
+		}
+		return len(rs.Ticks) - 1
+	}
+	return tick
+}
+
+//GetMetrics returns the results of the current tick for the metrics of the param, offset and range params are ignored
+func (rs *ReplaySource) GetMetrics(ctx context.Context, metricParam MetricParam) ([]ResourceMetrics, error) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if len(rs.Ticks) == 0 {
+		return nil, fmt.Errorf("replay has no ticks")
+	}
+	tick := rs.Ticks[rs.currentTick(time.Now())]
+
+	resourceMetricsList := []ResourceMetrics{}
+	returned := make(map[string]bool)
+	for _, metricName := range metricParam.Metrics {
+		if returned[metricName] {
+			continue
+		}
+		returned[metricName] = true
+
+		for _, ruleId := range metricParam.MetricToRule[metricName] {
+			//Results recorded for the rule take precedence over results of the metric
+			var resourceMetric map[string][]TV
+			for _, resourceMetrics := range tick {
+				if resourceMetrics.MetricName != metricName {
+					continue
+				}
+				if resourceMetrics.RuleId == ruleId {
+					resourceMetric = resourceMetrics.ResourceMetric
+					break
+				}
+				if resourceMetrics.RuleId == "" && resourceMetric == nil {
+					resourceMetric = resourceMetrics.ResourceMetric
+				}
+			}
+			if resourceMetric == nil {
+				continue
+			}
+
+			resourceMetricsList = append(resourceMetricsList, ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: resourceMetric,
+			})
+		}
+	}
+
+	return resourceMetricsList, nil
+}
@@ -0,0 +1,88 @@
+package metric
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReplayCSV(t *testing.T) {
+	data := `tick,metric,resource,time,value
+0,cpu,node1,60,0.5
+0,cpu,node1,120,0.6
+0,cpu,node2,120,0.1
+1,cpu,node1,180,0.9
+`
+	ticks, err := ParseReplayCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ticks) != 2 {
+		t.Fatalf("ParseReplayCSV got %d ticks, expect 2", len(ticks))
+	}
+	expect := map[string][]TV{
+		"node1": {{60, "0.5"}, {120, "0.6"}},
+		"node2": {{120, "0.1"}},
+	}
+	if len(ticks[0]) != 1 || !reflect.DeepEqual(ticks[0][0].ResourceMetric, expect) {
+		t.Errorf("ParseReplayCSV tick 0 got %v, expect %v", ticks[0], expect)
+	}
+
+	if _, err := ParseReplayCSV(strings.NewReader("tick,metric,time,value\n")); err == nil {
+		t.Errorf("ParseReplayCSV without resource column expect error")
+	}
+	if _, err := ParseReplayCSV(strings.NewReader("tick,metric,resource,time,value\nx,cpu,node1,60,1\n")); err == nil {
+		t.Errorf("ParseReplayCSV with invalid tick expect error")
+	}
+}
+
+func TestReplaySourceGetMetrics(t *testing.T) {
+	data := `[
+	[{"MetricName": "cpu", "ResourceMetric": {"node1": [{"time": 60, "value": "0.5"}]}},
+	 {"RuleId": "rl-2", "MetricName": "cpu", "ResourceMetric": {"node1": [{"time": 60, "value": "0.9"}]}}],
+	[{"MetricName": "cpu", "ResourceMetric": {"node1": [{"time": 120, "value": "0.7"}]}}]
+]`
+	ticks, err := ParseReplayJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := NewReplaySource(ticks, 0, false)
+	metricParam := MetricParam{
+		Metrics:      []string{"cpu", "cpu"},
+		MetricToRule: map[string][]string{"cpu": {"rl-1", "rl-2"}},
+	}
+
+	values := func() map[string]string {
+		resourceMetricsList, err := source.GetMetrics(context.Background(), metricParam)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := make(map[string]string)
+		for _, resourceMetrics := range resourceMetricsList {
+			result[resourceMetrics.RuleId] = resourceMetrics.ResourceMetric["node1"][0].V
+		}
+		return result
+	}
+
+	//Results recorded for a rule are only returned for the rule
+	if got, expect := values(), map[string]string{"rl-1": "0.5", "rl-2": "0.9"}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("GetMetrics tick 0 got %v, expect %v", got, expect)
+	}
+
+	source.Advance()
+	if got, expect := values(), map[string]string{"rl-1": "0.7", "rl-2": "0.7"}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("GetMetrics tick 1 got %v, expect %v", got, expect)
+	}
+
+	//Without loop the last tick is kept
+	source.Advance()
+	if source.CurrentTick() != 1 {
+		t.Errorf("CurrentTick got %d, expect 1", source.CurrentTick())
+	}
+	source.Loop = true
+	if source.CurrentTick() != 0 {
+		t.Errorf("CurrentTick with loop got %d, expect 0", source.CurrentTick())
+	}
+}
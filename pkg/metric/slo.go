@@ -0,0 +1,41 @@
+package metric
+
+import (
+	"fmt"
+)
+
+//Ratio of bad events in the window, the good and total series are summed over the sample times
+//where both have a valid sample, so both should be counts or rates per sample step of the same step
+func ErrorRatio(goodTVs []TV, totalTVs []TV) (float64, error) {
+	goodValues, goodTimes := ParseValues(goodTVs, 1)
+	totalValues, totalTimes := ParseValues(totalTVs, 1)
+
+	goodAt := make(map[int64]float64)
+	for i, t := range goodTimes {
+		goodAt[t] = goodValues[i]
+	}
+
+	good, total := 0.0, 0.0
+	paired := 0
+	for i, t := range totalTimes {
+		v, ok := goodAt[t]
+		if !ok {
+			continue
+		}
+		good += v
+		total += totalValues[i]
+		paired++
+	}
+	if paired == 0 {
+		return 0, fmt.Errorf("no data")
+	}
+	if total <= 0 {
+		return 0, fmt.Errorf("no events")
+	}
+
+	ratio := 1 - good/total
+	if ratio < 0 {
+		ratio = 0
+	}
+	return ratio, nil
+}
@@ -0,0 +1,36 @@
+package metric
+
+import (
+	"math"
+	"testing"
+)
+
+func TestErrorRatio(t *testing.T) {
+	good := []TV{{0, "95"}, {60, "bad"}, {120, "97"}}
+	total := []TV{{0, "100"}, {60, "100"}, {120, "100"}}
+
+	ratio, err := ErrorRatio(good, total)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//Times with an invalid sample in either series are left out of both sums
+	if math.Abs(ratio-(1-192.0/200)) > 1e-9 {
+		t.Errorf("ErrorRatio got %v, expect %v", ratio, 1-192.0/200)
+	}
+
+	//Samples are paired by time, not by position
+	ratio, err = ErrorRatio([]TV{{60, "90"}, {120, "98"}}, []TV{{0, "100"}, {60, "100"}, {120, "100"}})
+	if err != nil || math.Abs(ratio-(1-188.0/200)) > 1e-9 {
+		t.Errorf("ErrorRatio of good samples missing at some times got %v %v, expect %v", ratio, err, 1-188.0/200)
+	}
+	if _, err := ErrorRatio([]TV{{0, "95"}}, []TV{{60, "100"}}); err == nil {
+		t.Errorf("ErrorRatio of samples at different times expect error")
+	}
+
+	if _, err := ErrorRatio(good, []TV{{0, "0"}}); err == nil {
+		t.Errorf("ErrorRatio of zero total expect error")
+	}
+	if _, err := ErrorRatio(nil, total); err == nil {
+		t.Errorf("ErrorRatio of no good samples expect error")
+	}
+}
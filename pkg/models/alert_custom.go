@@ -78,6 +78,10 @@ type ResourceStatus struct {
 	NextResendInterval uint32 `json:"next_resend_interval"`
 	NextSendableTime   string `json:"next_sendable_time"`
 	AggregatedAlerts   string `json:"aggregated_alerts"`
+	NoData             bool   `json:"no_data"`
+	NegativeCount      uint32 `json:"negative_count"`
+	Flapping           bool   `json:"flapping"`
+	Inhibited          bool   `json:"inhibited"`
 }
 
 type AlertStatus struct {
@@ -122,6 +126,10 @@ func AlertStatusToPb(alertStatus AlertStatus) *pb.AlertStatus {
 		pbResource.NextResendInterval = resource.NextResendInterval
 		pbResource.NextSendableTime = resource.NextSendableTime
 		pbResource.AggregatedAlerts = resource.AggregatedAlerts
+		pbResource.NoData = resource.NoData
+		pbResource.NegativeCount = resource.NegativeCount
+		pbResource.Flapping = resource.Flapping
+		pbResource.Inhibited = resource.Inhibited
 
 		pbAlertStatus.Resources = append(pbAlertStatus.Resources, &pbResource)
 	}
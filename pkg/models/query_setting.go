@@ -30,7 +30,7 @@ var SearchColumns = map[string][]string{
 		PlColId, PlColName, PlColDescription, PlColCreator, PlColTypeId,
 	},
 	TableRule: {
-		RlColId, RlColName, RlColDisabled, RlColMonitorPeriods, RlColSeverity, RlColMetricsType, RlColConditionType, RlColThresholds, RlColUnit, RlColConsecutiveCount, RlColInhibit, RlColPolicyId, RlColMetricId,
+		RlColId, RlColName, RlColDisabled, RlColMonitorPeriods, RlColSeverity, RlColMetricsType, RlColConditionType, RlColThresholds, RlColUnit, RlColConsecutiveCount, RlColInhibit, RlColAggregation, RlColNoDataBehavior, RlColRecoveryThresholds, RlColConsecutiveRecoveryCount, RlColPolicyId, RlColMetricId,
 	},
 	TableAlert: {
 		AlColId, AlColName, AlColDisabled, AlColRunningStatus, AlColPolicyId, AlColRsFilterId, AlColExecutorId,
@@ -44,6 +44,12 @@ var SearchColumns = map[string][]string{
 	TableAction: {
 		AcColId, AcColName, AcColTriggerStatus, AcColTriggerAction, AcColPolicyId, AcColNfAddressListId,
 	},
+	TableRuleOverride: {
+		RoColId, RoColRuleId, RoColResourceName,
+	},
+	TableSlo: {
+		SlColId, SlColName, SlColPolicyId,
+	},
 }
 
 // columns that can be search through sql '=' operator
@@ -61,7 +67,7 @@ var IndexedColumns = map[string][]string{
 		PlColId, PlColName, PlColDescription, PlColCreator, PlColTypeId,
 	},
 	TableRule: {
-		RlColId, RlColName, RlColDisabled, RlColMonitorPeriods, RlColSeverity, RlColMetricsType, RlColConditionType, RlColThresholds, RlColUnit, RlColConsecutiveCount, RlColInhibit, RlColPolicyId, RlColMetricId,
+		RlColId, RlColName, RlColDisabled, RlColMonitorPeriods, RlColSeverity, RlColMetricsType, RlColConditionType, RlColThresholds, RlColUnit, RlColConsecutiveCount, RlColInhibit, RlColAggregation, RlColNoDataBehavior, RlColRecoveryThresholds, RlColConsecutiveRecoveryCount, RlColPolicyId, RlColMetricId,
 	},
 	TableAlert: {
 		AlColId, AlColName, AlColDisabled, AlColRunningStatus, AlColPolicyId, AlColRsFilterId, AlColExecutorId,
@@ -75,4 +81,10 @@ var IndexedColumns = map[string][]string{
 	TableAction: {
 		AcColId, AcColName, AcColTriggerStatus, AcColTriggerAction, AcColPolicyId, AcColNfAddressListId,
 	},
+	TableRuleOverride: {
+		RoColId, RoColRuleId, RoColResourceName,
+	},
+	TableSlo: {
+		SlColId, SlColName, SlColPolicyId,
+	},
 }
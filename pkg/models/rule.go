@@ -1,6 +1,10 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"kubesphere.io/alert/pkg/pb"
@@ -9,21 +13,33 @@ import (
 )
 
 type Rule struct {
-	RuleId           string    `gorm:"column:rule_id" json:"rule_id"`
-	RuleName         string    `gorm:"column:rule_name" json:"rule_name"`
-	Disabled         bool      `gorm:"column:disabled" json:"disabled"`
-	MonitorPeriods   uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
-	Severity         string    `gorm:"column:severity" json:"severity"`
-	MetricsType      string    `gorm:"column:metrics_type" json:"metrics_type"`
-	ConditionType    string    `gorm:"column:condition_type" json:"condition_type"`
-	Thresholds       string    `gorm:"column:thresholds" json:"thresholds"`
-	Unit             string    `gorm:"column:unit" json:"unit"`
-	ConsecutiveCount uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
-	Inhibit          bool      `gorm:"column:inhibit" json:"inhibit"`
-	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
-	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
-	PolicyId         string    `gorm:"column:policy_id" json:"policy_id"`
-	MetricId         string    `gorm:"column:metric_id" json:"metric_id"`
+	RuleId                   string    `gorm:"column:rule_id" json:"rule_id"`
+	RuleName                 string    `gorm:"column:rule_name" json:"rule_name"`
+	Disabled                 bool      `gorm:"column:disabled" json:"disabled"`
+	MonitorPeriods           uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
+	Severity                 string    `gorm:"column:severity" json:"severity"`
+	MetricsType              string    `gorm:"column:metrics_type" json:"metrics_type"`
+	ConditionType            string    `gorm:"column:condition_type" json:"condition_type"`
+	Thresholds               string    `gorm:"column:thresholds" json:"thresholds"`
+	Unit                     string    `gorm:"column:unit" json:"unit"`
+	ConsecutiveCount         uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
+	Inhibit                  bool      `gorm:"column:inhibit" json:"inhibit"`
+	Aggregation              string    `gorm:"column:aggregation" json:"aggregation"`
+	NoDataBehavior           string    `gorm:"column:no_data_behavior" json:"no_data_behavior"`
+	RecoveryThresholds       string    `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount uint32    `gorm:"column:consecutive_recovery_count" json:"consecutive_recovery_count"`
+	Levels                   string    `gorm:"column:levels" json:"levels"`
+	ForecastHorizon          uint32    `gorm:"column:forecast_horizon" json:"forecast_horizon"`
+	EvaluationInterval       uint32    `gorm:"column:evaluation_interval" json:"evaluation_interval"`
+	GroupCondition           string    `gorm:"column:group_condition" json:"group_condition"`
+	MetricExpression         string    `gorm:"column:metric_expression" json:"metric_expression"`
+	ThresholdSchedule        string    `gorm:"column:threshold_schedule" json:"threshold_schedule"`
+	OffsetWindow             uint32    `gorm:"column:offset_window" json:"offset_window"`
+	Script                   string    `gorm:"column:script" json:"script"`
+	CreateTime               time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime               time.Time `gorm:"column:update_time" json:"update_time"`
+	PolicyId                 string    `gorm:"column:policy_id" json:"policy_id"`
+	MetricId                 string    `gorm:"column:metric_id" json:"metric_id"`
 }
 
 //table name
@@ -35,47 +51,283 @@ const (
 	RuleIdPrefix = "rl-"
 )
 
+//condition type
+const (
+	ConditionTypeGreaterEqual = ">="
+	ConditionTypeGreater      = ">"
+	ConditionTypeLessEqual    = "<="
+	ConditionTypeLess         = "<"
+	ConditionTypeExpression   = "expr"
+	ConditionTypeAnd          = "and"
+	ConditionTypeOr           = "or"
+	ConditionTypeScript       = "script"
+)
+
+//separator of member rule ids in thresholds of composite rules
+const (
+	CompositeRuleSeparator = "|"
+)
+
+//change of the window compared by change condition types, e.g. delta> or pct<=,
+//forecast conditions compare the value predicted forecast horizon hours ahead,
+//ratio and diff conditions compare the value with the value of the window offset window seconds ago
+const (
+	ConditionChangeDelta    = "delta"
+	ConditionChangePercent  = "pct"
+	ConditionChangeForecast = "forecast"
+	ConditionChangeRatio    = "ratio"
+	ConditionChangeDiff     = "diff"
+)
+
+//SplitChangeCondition splits a change condition type into the change and the comparison,
+//other condition types are returned unchanged with an empty change
+func SplitChangeCondition(conditionType string) (string, string) {
+	for _, change := range []string{ConditionChangeDelta, ConditionChangePercent, ConditionChangeForecast, ConditionChangeRatio, ConditionChangeDiff} {
+		if !strings.HasPrefix(conditionType, change) {
+			continue
+		}
+		comparison := strings.TrimPrefix(conditionType, change)
+		switch comparison {
+		case ConditionTypeGreaterEqual, ConditionTypeGreater, ConditionTypeLessEqual, ConditionTypeLess:
+			return change, comparison
+		}
+	}
+	return "", conditionType
+}
+
+//IsOffsetChange reports whether the change compares the value with an offset window
+func IsOffsetChange(change string) bool {
+	return change == ConditionChangeRatio || change == ConditionChangeDiff
+}
+
+//baseline condition types, thresholds are the number k of deviations from the rolling baseline,
+//zscore uses mean and standard deviation, mad uses median and median absolute deviation
+const (
+	ConditionTypeZScore = "zscore"
+	ConditionTypeMAD    = "mad"
+)
+
+//IsBaselineCondition reports whether rules of the condition type compare values with a rolling baseline
+func IsBaselineCondition(conditionType string) bool {
+	return conditionType == ConditionTypeZScore || conditionType == ConditionTypeMAD
+}
+
+//IsCompositeCondition reports whether rules of the condition type combine other rules instead of a metric
+func IsCompositeCondition(conditionType string) bool {
+	return conditionType == ConditionTypeAnd || conditionType == ConditionTypeOr
+}
+
+const MinEvaluationIntervalSecond = 10
+
+//GetEvaluationInterval gets the seconds between evaluations of a rule, monitor periods minutes if not set
+func GetEvaluationInterval(evaluationInterval uint32, monitorPeriods uint32) uint32 {
+	interval := evaluationInterval
+	if interval == 0 {
+		interval = monitorPeriods * 60
+	}
+	if interval < MinEvaluationIntervalSecond {
+		interval = MinEvaluationIntervalSecond
+	}
+	return interval
+}
+
+//GroupCondition is the condition of group rules on the violating resources of one metric batch,
+//e.g. >3 compares the count of violating resources, >=20% their ratio to all resources with data
+type GroupCondition struct {
+	Comparison string
+	Threshold  float64
+	Ratio      bool
+}
+
+//ParseGroupCondition parses the group condition of a rule, empty group condition means the rule is not a group rule
+func ParseGroupCondition(groupCondition string) (*GroupCondition, error) {
+	condition := strings.TrimSpace(groupCondition)
+	if condition == "" {
+		return nil, nil
+	}
+
+	for _, comparison := range []string{ConditionTypeGreaterEqual, ConditionTypeLessEqual, ConditionTypeGreater, ConditionTypeLess} {
+		if !strings.HasPrefix(condition, comparison) {
+			continue
+		}
+
+		threshold := strings.TrimSpace(strings.TrimPrefix(condition, comparison))
+		ratio := strings.HasSuffix(threshold, "%")
+		v, err := strconv.ParseFloat(strings.TrimSuffix(threshold, "%"), 64)
+		if err != nil || v < 0 || (ratio && v > 100) {
+			return nil, fmt.Errorf("invalid group condition threshold [%s]", threshold)
+		}
+		return &GroupCondition{comparison, v, ratio}, nil
+	}
+
+	return nil, fmt.Errorf("invalid group condition [%s]", groupCondition)
+}
+
+//no data behavior
+const (
+	NoDataBehaviorKeep    = "keep"
+	NoDataBehaviorAlert   = "alert"
+	NoDataBehaviorResolve = "resolve"
+)
+
+//Severities ranked from low to high, rules of other severities rank 0
+const (
+	SeverityMinor    = "minor"
+	SeverityMajor    = "major"
+	SeverityCritical = "critical"
+)
+
+var SeverityRank = map[string]int{
+	SeverityMinor:    1,
+	SeverityMajor:    2,
+	SeverityCritical: 3,
+}
+
+//RuleLevel is one level of a multi-level rule, levels are ordered from low to high severity
+type RuleLevel struct {
+	Thresholds string `json:"thresholds"`
+	Severity   string `json:"severity"`
+}
+
+//ThresholdSchedule replaces thresholds of a rule in windows of the week,
+//the first window containing the evaluation time applies, rule thresholds apply outside all windows
+type ThresholdSchedule struct {
+	Timezone string            `json:"timezone"`
+	Windows  []ThresholdWindow `json:"windows"`
+}
+
+//ThresholdWindow is a daily time range on some weekdays, 0 is Sunday and no weekdays means every day,
+//start and end are HH:MM in the timezone of the schedule, end not after start means the window spans midnight
+type ThresholdWindow struct {
+	Name               string `json:"name"`
+	Weekdays           []int  `json:"weekdays"`
+	Start              string `json:"start"`
+	End                string `json:"end"`
+	Thresholds         string `json:"thresholds"`
+	RecoveryThresholds string `json:"recovery_thresholds"`
+}
+
+//ParseThresholdSchedule parses the threshold schedule of a rule and its timezone, empty schedule means no schedule
+func ParseThresholdSchedule(thresholdSchedule string) (*ThresholdSchedule, *time.Location, error) {
+	if thresholdSchedule == "" {
+		return nil, nil, nil
+	}
+
+	schedule := &ThresholdSchedule{}
+	err := json.Unmarshal([]byte(thresholdSchedule), schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(schedule.Windows) == 0 {
+		return nil, nil, fmt.Errorf("threshold schedule has no windows")
+	}
+
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, window := range schedule.Windows {
+		for _, weekday := range window.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return nil, nil, fmt.Errorf("invalid weekday [%d] of window [%s]", weekday, window.Name)
+			}
+		}
+		_, err = ParseClockMinute(window.Start)
+		if err == nil {
+			_, err = ParseClockMinute(window.End)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return schedule, location, nil
+}
+
+//ParseClockMinute parses HH:MM into minutes of the day, 24:00 is the end of the day
+func ParseClockMinute(clock string) (int, error) {
+	hourMinute := strings.Split(clock, ":")
+	if len(hourMinute) == 2 {
+		hour, errHour := strconv.Atoi(hourMinute[0])
+		minute, errMinute := strconv.Atoi(hourMinute[1])
+		if errHour == nil && errMinute == nil && hour >= 0 && minute >= 0 && minute < 60 && hour*60+minute <= 24*60 {
+			return hour*60 + minute, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid clock [%s]", clock)
+}
+
+//variable holding the scaled metric value in condition expressions
+const (
+	RuleExpressionValue = "value"
+)
+
 //field name
 //Rl is short for rule.
 const (
-	RlColId               = "rule_id"
-	RlColName             = "rule_name"
-	RlColDisabled         = "disabled"
-	RlColMonitorPeriods   = "monitor_periods"
-	RlColSeverity         = "severity"
-	RlColMetricsType      = "metrics_type"
-	RlColConditionType    = "condition_type"
-	RlColThresholds       = "thresholds"
-	RlColUnit             = "unit"
-	RlColConsecutiveCount = "consecutive_count"
-	RlColInhibit          = "inhibit"
-	RlColCreateTime       = "create_time"
-	RlColUpdateTime       = "update_time"
-	RlColPolicyId         = "policy_id"
-	RlColMetricId         = "metric_id"
+	RlColId                       = "rule_id"
+	RlColName                     = "rule_name"
+	RlColDisabled                 = "disabled"
+	RlColMonitorPeriods           = "monitor_periods"
+	RlColSeverity                 = "severity"
+	RlColMetricsType              = "metrics_type"
+	RlColConditionType            = "condition_type"
+	RlColThresholds               = "thresholds"
+	RlColUnit                     = "unit"
+	RlColConsecutiveCount         = "consecutive_count"
+	RlColInhibit                  = "inhibit"
+	RlColAggregation              = "aggregation"
+	RlColNoDataBehavior           = "no_data_behavior"
+	RlColRecoveryThresholds       = "recovery_thresholds"
+	RlColConsecutiveRecoveryCount = "consecutive_recovery_count"
+	RlColLevels                   = "levels"
+	RlColForecastHorizon          = "forecast_horizon"
+	RlColEvaluationInterval       = "evaluation_interval"
+	RlColGroupCondition           = "group_condition"
+	RlColMetricExpression         = "metric_expression"
+	RlColThresholdSchedule        = "threshold_schedule"
+	RlColOffsetWindow             = "offset_window"
+	RlColScript                   = "script"
+	RlColCreateTime               = "create_time"
+	RlColUpdateTime               = "update_time"
+	RlColPolicyId                 = "policy_id"
+	RlColMetricId                 = "metric_id"
 )
 
 func NewRuleId() string {
 	return idutil.GetUuid(RuleIdPrefix)
 }
 
-func NewRule(ruleName string, disabled bool, monitorPeriods uint32, severity string, metricsType string, conditionType string, thresholds string, unit string, consecutiveCount uint32, inhibit bool, policyId string, metricId string) *Rule {
+func NewRule(ruleName string, disabled bool, monitorPeriods uint32, severity string, metricsType string, conditionType string, thresholds string, unit string, consecutiveCount uint32, inhibit bool, aggregation string, noDataBehavior string, recoveryThresholds string, consecutiveRecoveryCount uint32, levels string, forecastHorizon uint32, evaluationInterval uint32, groupCondition string, metricExpression string, thresholdSchedule string, offsetWindow uint32, script string, policyId string, metricId string) *Rule {
 	rule := &Rule{
-		RuleId:           NewRuleId(),
-		RuleName:         ruleName,
-		Disabled:         disabled,
-		MonitorPeriods:   monitorPeriods,
-		Severity:         severity,
-		MetricsType:      metricsType,
-		ConditionType:    conditionType,
-		Thresholds:       thresholds,
-		Unit:             unit,
-		ConsecutiveCount: consecutiveCount,
-		Inhibit:          inhibit,
-		CreateTime:       time.Now(),
-		UpdateTime:       time.Now(),
-		PolicyId:         policyId,
-		MetricId:         metricId,
+		RuleId:                   NewRuleId(),
+		RuleName:                 ruleName,
+		Disabled:                 disabled,
+		MonitorPeriods:           monitorPeriods,
+		Severity:                 severity,
+		MetricsType:              metricsType,
+		ConditionType:            conditionType,
+		Thresholds:               thresholds,
+		Unit:                     unit,
+		ConsecutiveCount:         consecutiveCount,
+		Inhibit:                  inhibit,
+		Aggregation:              aggregation,
+		NoDataBehavior:           noDataBehavior,
+		RecoveryThresholds:       recoveryThresholds,
+		ConsecutiveRecoveryCount: consecutiveRecoveryCount,
+		Levels:                   levels,
+		ForecastHorizon:          forecastHorizon,
+		EvaluationInterval:       evaluationInterval,
+		GroupCondition:           groupCondition,
+		MetricExpression:         metricExpression,
+		ThresholdSchedule:        thresholdSchedule,
+		OffsetWindow:             offsetWindow,
+		Script:                   script,
+		CreateTime:               time.Now(),
+		UpdateTime:               time.Now(),
+		PolicyId:                 policyId,
+		MetricId:                 metricId,
 	}
 	return rule
 }
@@ -93,6 +345,18 @@ func RuleToPb(rule *Rule) *pb.Rule {
 	pbRule.Unit = rule.Unit
 	pbRule.ConsecutiveCount = rule.ConsecutiveCount
 	pbRule.Inhibit = rule.Inhibit
+	pbRule.Aggregation = rule.Aggregation
+	pbRule.NoDataBehavior = rule.NoDataBehavior
+	pbRule.RecoveryThresholds = rule.RecoveryThresholds
+	pbRule.ConsecutiveRecoveryCount = rule.ConsecutiveRecoveryCount
+	pbRule.Levels = rule.Levels
+	pbRule.ForecastHorizon = rule.ForecastHorizon
+	pbRule.EvaluationInterval = rule.EvaluationInterval
+	pbRule.GroupCondition = rule.GroupCondition
+	pbRule.MetricExpression = rule.MetricExpression
+	pbRule.ThresholdSchedule = rule.ThresholdSchedule
+	pbRule.OffsetWindow = rule.OffsetWindow
+	pbRule.Script = rule.Script
 	pbRule.CreateTime = pbutil.ToProtoTimestamp(rule.CreateTime)
 	pbRule.UpdateTime = pbutil.ToProtoTimestamp(rule.UpdateTime)
 	pbRule.PolicyId = rule.PolicyId
@@ -110,20 +374,32 @@ func ParseRlSet2PbSet(inRls []*Rule) []*pb.Rule {
 }
 
 type RuleDetail struct {
-	RuleId           string    `gorm:"column:rule_id" json:"rule_id"`
-	RuleName         string    `gorm:"column:rule_name" json:"rule_name"`
-	Disabled         bool      `gorm:"column:disabled" json:"disabled"`
-	MonitorPeriods   uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
-	Severity         string    `gorm:"column:severity" json:"severity"`
-	MetricsType      string    `gorm:"column:metrics_type" json:"metrics_type"`
-	ConditionType    string    `gorm:"column:condition_type" json:"condition_type"`
-	Thresholds       string    `gorm:"column:thresholds" json:"thresholds"`
-	MetricParam      string    `gorm:"column:metric_param" json:"metric_param"`
-	Unit             string    `gorm:"column:unit" json:"unit"`
-	ConsecutiveCount uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
-	Inhibit          bool      `gorm:"column:inhibit" json:"inhibit"`
-	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
-	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
-	PolicyId         string    `gorm:"column:policy_id" json:"policy_id"`
-	MetricId         string    `gorm:"column:metric_id" json:"metric_id"`
+	RuleId                   string    `gorm:"column:rule_id" json:"rule_id"`
+	RuleName                 string    `gorm:"column:rule_name" json:"rule_name"`
+	Disabled                 bool      `gorm:"column:disabled" json:"disabled"`
+	MonitorPeriods           uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
+	Severity                 string    `gorm:"column:severity" json:"severity"`
+	MetricsType              string    `gorm:"column:metrics_type" json:"metrics_type"`
+	ConditionType            string    `gorm:"column:condition_type" json:"condition_type"`
+	Thresholds               string    `gorm:"column:thresholds" json:"thresholds"`
+	MetricParam              string    `gorm:"column:metric_param" json:"metric_param"`
+	Unit                     string    `gorm:"column:unit" json:"unit"`
+	ConsecutiveCount         uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
+	Inhibit                  bool      `gorm:"column:inhibit" json:"inhibit"`
+	Aggregation              string    `gorm:"column:aggregation" json:"aggregation"`
+	NoDataBehavior           string    `gorm:"column:no_data_behavior" json:"no_data_behavior"`
+	RecoveryThresholds       string    `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount uint32    `gorm:"column:consecutive_recovery_count" json:"consecutive_recovery_count"`
+	Levels                   string    `gorm:"column:levels" json:"levels"`
+	ForecastHorizon          uint32    `gorm:"column:forecast_horizon" json:"forecast_horizon"`
+	EvaluationInterval       uint32    `gorm:"column:evaluation_interval" json:"evaluation_interval"`
+	GroupCondition           string    `gorm:"column:group_condition" json:"group_condition"`
+	MetricExpression         string    `gorm:"column:metric_expression" json:"metric_expression"`
+	ThresholdSchedule        string    `gorm:"column:threshold_schedule" json:"threshold_schedule"`
+	OffsetWindow             uint32    `gorm:"column:offset_window" json:"offset_window"`
+	Script                   string    `gorm:"column:script" json:"script"`
+	CreateTime               time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime               time.Time `gorm:"column:update_time" json:"update_time"`
+	PolicyId                 string    `gorm:"column:policy_id" json:"policy_id"`
+	MetricId                 string    `gorm:"column:metric_id" json:"metric_id"`
 }
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//RuleOverride replaces thresholds of a rule for one resource, keyed by the resource name the executor sees
+type RuleOverride struct {
+	OverrideId         string    `gorm:"column:override_id" json:"override_id"`
+	RuleId             string    `gorm:"column:rule_id" json:"rule_id"`
+	ResourceName       string    `gorm:"column:resource_name" json:"resource_name"`
+	Thresholds         string    `gorm:"column:thresholds" json:"thresholds"`
+	RecoveryThresholds string    `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	CreateTime         time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime         time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableRuleOverride = "rule_override"
+)
+
+const (
+	RuleOverrideIdPrefix = "ro-"
+)
+
+//field name
+//Ro is short for rule override.
+const (
+	RoColId                 = "override_id"
+	RoColRuleId             = "rule_id"
+	RoColResourceName       = "resource_name"
+	RoColThresholds         = "thresholds"
+	RoColRecoveryThresholds = "recovery_thresholds"
+	RoColCreateTime         = "create_time"
+	RoColUpdateTime         = "update_time"
+)
+
+func NewRuleOverrideId() string {
+	return idutil.GetUuid(RuleOverrideIdPrefix)
+}
+
+func NewRuleOverride(ruleId string, resourceName string, thresholds string, recoveryThresholds string) *RuleOverride {
+	ruleOverride := &RuleOverride{
+		OverrideId:         NewRuleOverrideId(),
+		RuleId:             ruleId,
+		ResourceName:       resourceName,
+		Thresholds:         thresholds,
+		RecoveryThresholds: recoveryThresholds,
+		CreateTime:         time.Now(),
+		UpdateTime:         time.Now(),
+	}
+	return ruleOverride
+}
+
+func RuleOverrideToPb(ruleOverride *RuleOverride) *pb.RuleOverride {
+	pbRuleOverride := pb.RuleOverride{}
+	pbRuleOverride.OverrideId = ruleOverride.OverrideId
+	pbRuleOverride.RuleId = ruleOverride.RuleId
+	pbRuleOverride.ResourceName = ruleOverride.ResourceName
+	pbRuleOverride.Thresholds = ruleOverride.Thresholds
+	pbRuleOverride.RecoveryThresholds = ruleOverride.RecoveryThresholds
+	pbRuleOverride.CreateTime = pbutil.ToProtoTimestamp(ruleOverride.CreateTime)
+	pbRuleOverride.UpdateTime = pbutil.ToProtoTimestamp(ruleOverride.UpdateTime)
+	return &pbRuleOverride
+}
+
+func ParseRoSet2PbSet(inRos []*RuleOverride) []*pb.RuleOverride {
+	var pbRos []*pb.RuleOverride
+	for _, inRo := range inRos {
+		pbRo := RuleOverrideToPb(inRo)
+		pbRos = append(pbRos, pbRo)
+	}
+	return pbRos
+}
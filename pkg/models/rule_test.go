@@ -0,0 +1,66 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGroupCondition(t *testing.T) {
+	tests := []struct {
+		groupCondition string
+		expect         *GroupCondition
+		expectErr      bool
+	}{
+		{"", nil, false},
+		{"  ", nil, false},
+		{">3", &GroupCondition{ConditionTypeGreater, 3, false}, false},
+		{">=20%", &GroupCondition{ConditionTypeGreaterEqual, 20, true}, false},
+		{" <= 2.5 ", &GroupCondition{ConditionTypeLessEqual, 2.5, false}, false},
+		{"<100%", &GroupCondition{ConditionTypeLess, 100, true}, false},
+		{">101%", nil, true},
+		{">-1", nil, true},
+		{">=", nil, true},
+		{">three", nil, true},
+		{"==3", nil, true},
+		{"3", nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseGroupCondition(test.groupCondition)
+		if (err != nil) != test.expectErr || !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("ParseGroupCondition [%s] got %+v %v, expect %+v error %v", test.groupCondition, got, err, test.expect, test.expectErr)
+		}
+	}
+}
+
+func TestParseClockMinute(t *testing.T) {
+	tests := []struct {
+		clock     string
+		expect    int
+		expectErr bool
+	}{
+		{"00:00", 0, false},
+		{"09:30", 570, false},
+		{"9:05", 545, false},
+		{"23:59", 1439, false},
+		{"24:00", 1440, false},
+		{"24:01", 0, true},
+		{"12:60", 0, true},
+		{"-1:00", 0, true},
+		{"12", 0, true},
+		{"12:00:00", 0, true},
+		{"ab:cd", 0, true},
+		{"", 0, true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseClockMinute(test.clock)
+		if (err != nil) != test.expectErr || got != test.expect {
+			t.Errorf("ParseClockMinute [%s] got %d %v, expect %d error %v", test.clock, got, err, test.expect, test.expectErr)
+		}
+	}
+}
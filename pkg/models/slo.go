@@ -0,0 +1,164 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//Slo is an availability objective of the resources of a policy, measured by the ratio of good to total events
+type Slo struct {
+	SloId         string    `gorm:"column:slo_id" json:"slo_id"`
+	SloName       string    `gorm:"column:slo_name" json:"slo_name"`
+	Target        string    `gorm:"column:target" json:"target"`
+	WindowDays    uint32    `gorm:"column:window_days" json:"window_days"`
+	GoodMetricId  string    `gorm:"column:good_metric_id" json:"good_metric_id"`
+	TotalMetricId string    `gorm:"column:total_metric_id" json:"total_metric_id"`
+	BurnWindows   string    `gorm:"column:burn_windows" json:"burn_windows"`
+	Disabled      bool      `gorm:"column:disabled" json:"disabled"`
+	PolicyId      string    `gorm:"column:policy_id" json:"policy_id"`
+	CreateTime    time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime    time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableSlo = "slo"
+)
+
+const (
+	SloIdPrefix = "slo-"
+)
+
+//Days of the error budget window used when the slo has none
+const (
+	DefaultSloWindowDays = 30
+)
+
+//field name
+//Sl is short for slo.
+const (
+	SlColId            = "slo_id"
+	SlColName          = "slo_name"
+	SlColTarget        = "target"
+	SlColWindowDays    = "window_days"
+	SlColGoodMetricId  = "good_metric_id"
+	SlColTotalMetricId = "total_metric_id"
+	SlColBurnWindows   = "burn_windows"
+	SlColDisabled      = "disabled"
+	SlColPolicyId      = "policy_id"
+	SlColCreateTime    = "create_time"
+	SlColUpdateTime    = "update_time"
+)
+
+//BurnWindow fires when the error budget burns faster than the burn rate over both the long and the short window,
+//windows are in seconds
+type BurnWindow struct {
+	LongWindow  uint32  `json:"long_window"`
+	ShortWindow uint32  `json:"short_window"`
+	BurnRate    float64 `json:"burn_rate"`
+	Severity    string  `json:"severity"`
+}
+
+//Burn windows used when the slo has none, a fast burn of 2% budget in 1 hour and a slow burn of 5% budget in 6 hours of a 30 days window
+var DefaultBurnWindows = []BurnWindow{
+	{LongWindow: 3600, ShortWindow: 300, BurnRate: 14.4, Severity: "critical"},
+	{LongWindow: 21600, ShortWindow: 1800, BurnRate: 6, Severity: "major"},
+}
+
+//ParseSloTarget parses the target percentage of good events, which must be in (0, 100)
+func ParseSloTarget(target string) (float64, error) {
+	t, err := strconv.ParseFloat(target, 64)
+	if err != nil {
+		return 0, err
+	}
+	if t <= 0 || t >= 100 {
+		return 0, fmt.Errorf("slo target [%s] is not in (0, 100)", target)
+	}
+	return t, nil
+}
+
+//ParseBurnWindows parses the burn windows of a slo, empty burn windows means the default ones
+func ParseBurnWindows(burnWindows string) ([]BurnWindow, error) {
+	if burnWindows == "" {
+		return DefaultBurnWindows, nil
+	}
+
+	windows := []BurnWindow{}
+	err := json.Unmarshal([]byte(burnWindows), &windows)
+	if err != nil {
+		return nil, err
+	}
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("slo has no burn windows")
+	}
+
+	for _, window := range windows {
+		if window.ShortWindow == 0 || window.LongWindow <= window.ShortWindow {
+			return nil, fmt.Errorf("short window [%d] must be positive and less than long window [%d]", window.ShortWindow, window.LongWindow)
+		}
+		if window.BurnRate <= 0 {
+			return nil, fmt.Errorf("invalid burn rate [%v]", window.BurnRate)
+		}
+		if window.Severity == "" || len(window.Severity) > 20 {
+			return nil, fmt.Errorf("invalid severity [%s]", window.Severity)
+		}
+	}
+
+	return windows, nil
+}
+
+func NewSloId() string {
+	return idutil.GetUuid(SloIdPrefix)
+}
+
+func NewSlo(sloName string, target string, windowDays uint32, goodMetricId string, totalMetricId string, burnWindows string, disabled bool, policyId string) *Slo {
+	if windowDays == 0 {
+		windowDays = DefaultSloWindowDays
+	}
+
+	slo := &Slo{
+		SloId:         NewSloId(),
+		SloName:       sloName,
+		Target:        target,
+		WindowDays:    windowDays,
+		GoodMetricId:  goodMetricId,
+		TotalMetricId: totalMetricId,
+		BurnWindows:   burnWindows,
+		Disabled:      disabled,
+		PolicyId:      policyId,
+		CreateTime:    time.Now(),
+		UpdateTime:    time.Now(),
+	}
+	return slo
+}
+
+func SloToPb(slo *Slo) *pb.Slo {
+	pbSlo := pb.Slo{}
+	pbSlo.SloId = slo.SloId
+	pbSlo.SloName = slo.SloName
+	pbSlo.Target = slo.Target
+	pbSlo.WindowDays = slo.WindowDays
+	pbSlo.GoodMetricId = slo.GoodMetricId
+	pbSlo.TotalMetricId = slo.TotalMetricId
+	pbSlo.BurnWindows = slo.BurnWindows
+	pbSlo.Disabled = slo.Disabled
+	pbSlo.PolicyId = slo.PolicyId
+	pbSlo.CreateTime = pbutil.ToProtoTimestamp(slo.CreateTime)
+	pbSlo.UpdateTime = pbutil.ToProtoTimestamp(slo.UpdateTime)
+	return &pbSlo
+}
+
+func ParseSlSet2PbSet(inSls []*Slo) []*pb.Slo {
+	var pbSls []*pb.Slo
+	for _, inSl := range inSls {
+		pbSl := SloToPb(inSl)
+		pbSls = append(pbSls, pbSl)
+	}
+	return pbSls
+}
@@ -9,6 +9,8 @@ type NotificationParam struct {
 	FirstTime      string `json:"first_time"`
 	LastTime       string `json:"last_time"`
 	LastValue      string `json:"last_value"`
+	ForecastTime   string `json:"forecast_time,omitempty"`
+	ForecastIn     string `json:"forecast_in,omitempty"`
 }
 
 type Email struct {
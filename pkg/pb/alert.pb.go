@@ -10,7 +10,7 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
-	_ "github.com/golang/protobuf/ptypes/wrappers"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -2653,24 +2653,36 @@ func (m *DeletePoliciesResponse) GetPolicyId() []string {
 //5.Rule
 //********************************************************************************************************
 type Rule struct {
-	RuleId               string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
-	RuleName             string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32               `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string               `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string               `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string               `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string               `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string               `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32               `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool                 `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
-	CreateTime           *timestamp.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time"`
-	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
-	PolicyId             string               `protobuf:"bytes,14,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	MetricId             string               `protobuf:"bytes,15,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	RuleId                   string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	RuleName                 string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled                 bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods           uint32               `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity                 string               `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
+	MetricsType              string               `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType            string               `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds               string               `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                     string               `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount         uint32               `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit                  bool                 `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
+	CreateTime               *timestamp.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime               *timestamp.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	PolicyId                 string               `protobuf:"bytes,14,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	MetricId                 string               `protobuf:"bytes,15,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
+	Aggregation              string               `protobuf:"bytes,16,opt,name=aggregation,proto3" json:"aggregation"`
+	NoDataBehavior           string               `protobuf:"bytes,17,opt,name=no_data_behavior,json=noDataBehavior,proto3" json:"no_data_behavior"`
+	RecoveryThresholds       string               `protobuf:"bytes,18,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount uint32               `protobuf:"varint,19,opt,name=consecutive_recovery_count,json=consecutiveRecoveryCount,proto3" json:"consecutive_recovery_count"`
+	Levels                   string               `protobuf:"bytes,20,opt,name=levels,proto3" json:"levels"`
+	ForecastHorizon          uint32               `protobuf:"varint,21,opt,name=forecast_horizon,json=forecastHorizon,proto3" json:"forecast_horizon"`
+	EvaluationInterval       uint32               `protobuf:"varint,22,opt,name=evaluation_interval,json=evaluationInterval,proto3" json:"evaluation_interval"`
+	GroupCondition           string               `protobuf:"bytes,23,opt,name=group_condition,json=groupCondition,proto3" json:"group_condition"`
+	MetricExpression         string               `protobuf:"bytes,24,opt,name=metric_expression,json=metricExpression,proto3" json:"metric_expression"`
+	ThresholdSchedule        string               `protobuf:"bytes,25,opt,name=threshold_schedule,json=thresholdSchedule,proto3" json:"threshold_schedule"`
+	OffsetWindow             uint32               `protobuf:"varint,26,opt,name=offset_window,json=offsetWindow,proto3" json:"offset_window"`
+	Script                   string               `protobuf:"bytes,27,opt,name=script,proto3" json:"script"`
+	XXX_NoUnkeyedLiteral     struct{}             `json:"-"`
+	XXX_unrecognized         []byte               `json:"-"`
+	XXX_sizecache            int32                `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -2803,22 +2815,118 @@ func (m *Rule) GetMetricId() string {
 	return ""
 }
 
+func (m *Rule) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *Rule) GetNoDataBehavior() string {
+	if m != nil {
+		return m.NoDataBehavior
+	}
+	return ""
+}
+
+func (m *Rule) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *Rule) GetConsecutiveRecoveryCount() uint32 {
+	if m != nil {
+		return m.ConsecutiveRecoveryCount
+	}
+	return 0
+}
+
+func (m *Rule) GetLevels() string {
+	if m != nil {
+		return m.Levels
+	}
+	return ""
+}
+
+func (m *Rule) GetForecastHorizon() uint32 {
+	if m != nil {
+		return m.ForecastHorizon
+	}
+	return 0
+}
+
+func (m *Rule) GetEvaluationInterval() uint32 {
+	if m != nil {
+		return m.EvaluationInterval
+	}
+	return 0
+}
+
+func (m *Rule) GetGroupCondition() string {
+	if m != nil {
+		return m.GroupCondition
+	}
+	return ""
+}
+
+func (m *Rule) GetMetricExpression() string {
+	if m != nil {
+		return m.MetricExpression
+	}
+	return ""
+}
+
+func (m *Rule) GetThresholdSchedule() string {
+	if m != nil {
+		return m.ThresholdSchedule
+	}
+	return ""
+}
+
+func (m *Rule) GetOffsetWindow() uint32 {
+	if m != nil {
+		return m.OffsetWindow
+	}
+	return 0
+}
+
+func (m *Rule) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
 type CreateRuleRequest struct {
-	RuleName             string   `protobuf:"bytes,1,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool     `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32   `protobuf:"varint,3,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string   `protobuf:"bytes,5,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string   `protobuf:"bytes,6,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string   `protobuf:"bytes,7,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string   `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32   `protobuf:"varint,9,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool     `protobuf:"varint,10,opt,name=inhibit,proto3" json:"inhibit"`
-	PolicyId             string   `protobuf:"bytes,11,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	MetricId             string   `protobuf:"bytes,12,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RuleName                 string   `protobuf:"bytes,1,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled                 bool     `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods           uint32   `protobuf:"varint,3,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity                 string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity"`
+	MetricsType              string   `protobuf:"bytes,5,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType            string   `protobuf:"bytes,6,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds               string   `protobuf:"bytes,7,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                     string   `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount         uint32   `protobuf:"varint,9,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit                  bool     `protobuf:"varint,10,opt,name=inhibit,proto3" json:"inhibit"`
+	PolicyId                 string   `protobuf:"bytes,11,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	MetricId                 string   `protobuf:"bytes,12,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
+	Aggregation              string   `protobuf:"bytes,13,opt,name=aggregation,proto3" json:"aggregation"`
+	NoDataBehavior           string   `protobuf:"bytes,14,opt,name=no_data_behavior,json=noDataBehavior,proto3" json:"no_data_behavior"`
+	RecoveryThresholds       string   `protobuf:"bytes,15,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount uint32   `protobuf:"varint,16,opt,name=consecutive_recovery_count,json=consecutiveRecoveryCount,proto3" json:"consecutive_recovery_count"`
+	Levels                   string   `protobuf:"bytes,17,opt,name=levels,proto3" json:"levels"`
+	ForecastHorizon          uint32   `protobuf:"varint,18,opt,name=forecast_horizon,json=forecastHorizon,proto3" json:"forecast_horizon"`
+	EvaluationInterval       uint32   `protobuf:"varint,19,opt,name=evaluation_interval,json=evaluationInterval,proto3" json:"evaluation_interval"`
+	GroupCondition           string   `protobuf:"bytes,20,opt,name=group_condition,json=groupCondition,proto3" json:"group_condition"`
+	MetricExpression         string   `protobuf:"bytes,21,opt,name=metric_expression,json=metricExpression,proto3" json:"metric_expression"`
+	ThresholdSchedule        string   `protobuf:"bytes,22,opt,name=threshold_schedule,json=thresholdSchedule,proto3" json:"threshold_schedule"`
+	OffsetWindow             uint32   `protobuf:"varint,23,opt,name=offset_window,json=offsetWindow,proto3" json:"offset_window"`
+	Script                   string   `protobuf:"bytes,24,opt,name=script,proto3" json:"script"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *CreateRuleRequest) Reset()         { *m = CreateRuleRequest{} }
@@ -2930,6 +3038,90 @@ func (m *CreateRuleRequest) GetMetricId() string {
 	return ""
 }
 
+func (m *CreateRuleRequest) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetNoDataBehavior() string {
+	if m != nil {
+		return m.NoDataBehavior
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetConsecutiveRecoveryCount() uint32 {
+	if m != nil {
+		return m.ConsecutiveRecoveryCount
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetLevels() string {
+	if m != nil {
+		return m.Levels
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetForecastHorizon() uint32 {
+	if m != nil {
+		return m.ForecastHorizon
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetEvaluationInterval() uint32 {
+	if m != nil {
+		return m.EvaluationInterval
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetGroupCondition() string {
+	if m != nil {
+		return m.GroupCondition
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetMetricExpression() string {
+	if m != nil {
+		return m.MetricExpression
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetThresholdSchedule() string {
+	if m != nil {
+		return m.ThresholdSchedule
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetOffsetWindow() uint32 {
+	if m != nil {
+		return m.OffsetWindow
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
 type CreateRuleResponse struct {
 	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2970,27 +3162,31 @@ func (m *CreateRuleResponse) GetRuleId() string {
 }
 
 type DescribeRulesRequest struct {
-	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
-	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
-	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
-	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
-	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
-	RuleId               []string `protobuf:"bytes,6,rep,name=rule_id,json=ruleId,proto3" json:"rule_id"`
-	RuleName             []string `protobuf:"bytes,7,rep,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             []bool   `protobuf:"varint,8,rep,packed,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       []uint32 `protobuf:"varint,9,rep,packed,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             []string `protobuf:"bytes,10,rep,name=severity,proto3" json:"severity"`
-	MetricsType          []string `protobuf:"bytes,11,rep,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        []string `protobuf:"bytes,12,rep,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           []string `protobuf:"bytes,13,rep,name=thresholds,proto3" json:"thresholds"`
-	Unit                 []string `protobuf:"bytes,14,rep,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     []uint32 `protobuf:"varint,15,rep,packed,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              []bool   `protobuf:"varint,16,rep,packed,name=inhibit,proto3" json:"inhibit"`
-	PolicyId             []string `protobuf:"bytes,17,rep,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	MetricId             []string `protobuf:"bytes,18,rep,name=metric_id,json=metricId,proto3" json:"metric_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SearchWord               string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey                  string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse                  bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset                   uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                    uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	RuleId                   []string `protobuf:"bytes,6,rep,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	RuleName                 []string `protobuf:"bytes,7,rep,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled                 []bool   `protobuf:"varint,8,rep,packed,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods           []uint32 `protobuf:"varint,9,rep,packed,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity                 []string `protobuf:"bytes,10,rep,name=severity,proto3" json:"severity"`
+	MetricsType              []string `protobuf:"bytes,11,rep,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType            []string `protobuf:"bytes,12,rep,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds               []string `protobuf:"bytes,13,rep,name=thresholds,proto3" json:"thresholds"`
+	Unit                     []string `protobuf:"bytes,14,rep,name=unit,proto3" json:"unit"`
+	ConsecutiveCount         []uint32 `protobuf:"varint,15,rep,packed,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit                  []bool   `protobuf:"varint,16,rep,packed,name=inhibit,proto3" json:"inhibit"`
+	PolicyId                 []string `protobuf:"bytes,17,rep,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	MetricId                 []string `protobuf:"bytes,18,rep,name=metric_id,json=metricId,proto3" json:"metric_id"`
+	Aggregation              []string `protobuf:"bytes,19,rep,name=aggregation,proto3" json:"aggregation"`
+	NoDataBehavior           []string `protobuf:"bytes,20,rep,name=no_data_behavior,json=noDataBehavior,proto3" json:"no_data_behavior"`
+	RecoveryThresholds       []string `protobuf:"bytes,21,rep,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount []uint32 `protobuf:"varint,22,rep,packed,name=consecutive_recovery_count,json=consecutiveRecoveryCount,proto3" json:"consecutive_recovery_count"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *DescribeRulesRequest) Reset()         { *m = DescribeRulesRequest{} }
@@ -3144,6 +3340,34 @@ func (m *DescribeRulesRequest) GetMetricId() []string {
 	return nil
 }
 
+func (m *DescribeRulesRequest) GetAggregation() []string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (m *DescribeRulesRequest) GetNoDataBehavior() []string {
+	if m != nil {
+		return m.NoDataBehavior
+	}
+	return nil
+}
+
+func (m *DescribeRulesRequest) GetRecoveryThresholds() []string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return nil
+}
+
+func (m *DescribeRulesRequest) GetConsecutiveRecoveryCount() []uint32 {
+	if m != nil {
+		return m.ConsecutiveRecoveryCount
+	}
+	return nil
+}
+
 type DescribeRulesResponse struct {
 	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
 	RuleSet              []*Rule  `protobuf:"bytes,2,rep,name=rule_set,json=ruleSet,proto3" json:"rule_set"`
@@ -3192,20 +3416,32 @@ func (m *DescribeRulesResponse) GetRuleSet() []*Rule {
 }
 
 type ModifyRuleRequest struct {
-	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
-	RuleName             string   `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32   `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string   `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string   `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string   `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string   `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string   `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32   `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool     `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RuleId                   string                `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	RuleName                 string                `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled                 bool                  `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods           uint32                `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity                 string                `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
+	MetricsType              string                `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType            string                `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds               string                `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                     string                `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount         uint32                `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit                  bool                  `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
+	Aggregation              string                `protobuf:"bytes,12,opt,name=aggregation,proto3" json:"aggregation"`
+	NoDataBehavior           string                `protobuf:"bytes,13,opt,name=no_data_behavior,json=noDataBehavior,proto3" json:"no_data_behavior"`
+	RecoveryThresholds       *wrappers.StringValue `protobuf:"bytes,14,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount *wrappers.UInt32Value `protobuf:"bytes,15,opt,name=consecutive_recovery_count,json=consecutiveRecoveryCount,proto3" json:"consecutive_recovery_count"`
+	Levels                   *wrappers.StringValue `protobuf:"bytes,16,opt,name=levels,proto3" json:"levels"`
+	ForecastHorizon          uint32                `protobuf:"varint,17,opt,name=forecast_horizon,json=forecastHorizon,proto3" json:"forecast_horizon"`
+	EvaluationInterval       *wrappers.UInt32Value `protobuf:"bytes,18,opt,name=evaluation_interval,json=evaluationInterval,proto3" json:"evaluation_interval"`
+	GroupCondition           *wrappers.StringValue `protobuf:"bytes,19,opt,name=group_condition,json=groupCondition,proto3" json:"group_condition"`
+	MetricExpression         *wrappers.StringValue `protobuf:"bytes,20,opt,name=metric_expression,json=metricExpression,proto3" json:"metric_expression"`
+	ThresholdSchedule        *wrappers.StringValue `protobuf:"bytes,21,opt,name=threshold_schedule,json=thresholdSchedule,proto3" json:"threshold_schedule"`
+	OffsetWindow             uint32                `protobuf:"varint,22,opt,name=offset_window,json=offsetWindow,proto3" json:"offset_window"`
+	Script                   *wrappers.StringValue `protobuf:"bytes,23,opt,name=script,proto3" json:"script"`
+	XXX_NoUnkeyedLiteral     struct{}              `json:"-"`
+	XXX_unrecognized         []byte                `json:"-"`
+	XXX_sizecache            int32                 `json:"-"`
 }
 
 func (m *ModifyRuleRequest) Reset()         { *m = ModifyRuleRequest{} }
@@ -3310,6 +3546,90 @@ func (m *ModifyRuleRequest) GetInhibit() bool {
 	return false
 }
 
+func (m *ModifyRuleRequest) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *ModifyRuleRequest) GetNoDataBehavior() string {
+	if m != nil {
+		return m.NoDataBehavior
+	}
+	return ""
+}
+
+func (m *ModifyRuleRequest) GetRecoveryThresholds() *wrappers.StringValue {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetConsecutiveRecoveryCount() *wrappers.UInt32Value {
+	if m != nil {
+		return m.ConsecutiveRecoveryCount
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetLevels() *wrappers.StringValue {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetForecastHorizon() uint32 {
+	if m != nil {
+		return m.ForecastHorizon
+	}
+	return 0
+}
+
+func (m *ModifyRuleRequest) GetEvaluationInterval() *wrappers.UInt32Value {
+	if m != nil {
+		return m.EvaluationInterval
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetGroupCondition() *wrappers.StringValue {
+	if m != nil {
+		return m.GroupCondition
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetMetricExpression() *wrappers.StringValue {
+	if m != nil {
+		return m.MetricExpression
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetThresholdSchedule() *wrappers.StringValue {
+	if m != nil {
+		return m.ThresholdSchedule
+	}
+	return nil
+}
+
+func (m *ModifyRuleRequest) GetOffsetWindow() uint32 {
+	if m != nil {
+		return m.OffsetWindow
+	}
+	return 0
+}
+
+func (m *ModifyRuleRequest) GetScript() *wrappers.StringValue {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
 type ModifyRuleResponse struct {
 	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5711,40 +6031,1154 @@ func (m *DeleteActionsResponse) GetActionId() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Executor)(nil), "kubesphere.alert.Executor")
-	proto.RegisterType((*CreateExecutorRequest)(nil), "kubesphere.alert.CreateExecutorRequest")
-	proto.RegisterType((*CreateExecutorResponse)(nil), "kubesphere.alert.CreateExecutorResponse")
-	proto.RegisterType((*DescribeExecutorsRequest)(nil), "kubesphere.alert.DescribeExecutorsRequest")
-	proto.RegisterType((*DescribeExecutorsResponse)(nil), "kubesphere.alert.DescribeExecutorsResponse")
-	proto.RegisterType((*ModifyExecutorRequest)(nil), "kubesphere.alert.ModifyExecutorRequest")
-	proto.RegisterType((*ModifyExecutorResponse)(nil), "kubesphere.alert.ModifyExecutorResponse")
-	proto.RegisterType((*DeleteExecutorsRequest)(nil), "kubesphere.alert.DeleteExecutorsRequest")
-	proto.RegisterType((*DeleteExecutorsResponse)(nil), "kubesphere.alert.DeleteExecutorsResponse")
-	proto.RegisterType((*ResourceType)(nil), "kubesphere.alert.ResourceType")
-	proto.RegisterType((*CreateResourceTypeRequest)(nil), "kubesphere.alert.CreateResourceTypeRequest")
-	proto.RegisterType((*CreateResourceTypeResponse)(nil), "kubesphere.alert.CreateResourceTypeResponse")
-	proto.RegisterType((*DescribeResourceTypesRequest)(nil), "kubesphere.alert.DescribeResourceTypesRequest")
-	proto.RegisterType((*DescribeResourceTypesResponse)(nil), "kubesphere.alert.DescribeResourceTypesResponse")
-	proto.RegisterType((*ModifyResourceTypeRequest)(nil), "kubesphere.alert.ModifyResourceTypeRequest")
-	proto.RegisterType((*ModifyResourceTypeResponse)(nil), "kubesphere.alert.ModifyResourceTypeResponse")
-	proto.RegisterType((*DeleteResourceTypesRequest)(nil), "kubesphere.alert.DeleteResourceTypesRequest")
-	proto.RegisterType((*DeleteResourceTypesResponse)(nil), "kubesphere.alert.DeleteResourceTypesResponse")
-	proto.RegisterType((*ResourceFilter)(nil), "kubesphere.alert.ResourceFilter")
-	proto.RegisterType((*CreateResourceFilterRequest)(nil), "kubesphere.alert.CreateResourceFilterRequest")
-	proto.RegisterType((*CreateResourceFilterResponse)(nil), "kubesphere.alert.CreateResourceFilterResponse")
-	proto.RegisterType((*DescribeResourceFiltersRequest)(nil), "kubesphere.alert.DescribeResourceFiltersRequest")
-	proto.RegisterType((*DescribeResourceFiltersResponse)(nil), "kubesphere.alert.DescribeResourceFiltersResponse")
-	proto.RegisterType((*ModifyResourceFilterRequest)(nil), "kubesphere.alert.ModifyResourceFilterRequest")
-	proto.RegisterType((*ModifyResourceFilterResponse)(nil), "kubesphere.alert.ModifyResourceFilterResponse")
-	proto.RegisterType((*DeleteResourceFiltersRequest)(nil), "kubesphere.alert.DeleteResourceFiltersRequest")
-	proto.RegisterType((*DeleteResourceFiltersResponse)(nil), "kubesphere.alert.DeleteResourceFiltersResponse")
-	proto.RegisterType((*Metric)(nil), "kubesphere.alert.Metric")
-	proto.RegisterType((*CreateMetricRequest)(nil), "kubesphere.alert.CreateMetricRequest")
-	proto.RegisterType((*CreateMetricResponse)(nil), "kubesphere.alert.CreateMetricResponse")
-	proto.RegisterType((*DescribeMetricsRequest)(nil), "kubesphere.alert.DescribeMetricsRequest")
-	proto.RegisterType((*DescribeMetricsResponse)(nil), "kubesphere.alert.DescribeMetricsResponse")
-	proto.RegisterType((*ModifyMetricRequest)(nil), "kubesphere.alert.ModifyMetricRequest")
+//10.RuleOverride
+//********************************************************************************************************
+type RuleOverride struct {
+	OverrideId           string               `protobuf:"bytes,1,opt,name=override_id,json=overrideId,proto3" json:"override_id"`
+	RuleId               string               `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName         string               `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	Thresholds           string               `protobuf:"bytes,4,opt,name=thresholds,proto3" json:"thresholds"`
+	RecoveryThresholds   string               `protobuf:"bytes,5,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RuleOverride) Reset()         { *m = RuleOverride{} }
+func (m *RuleOverride) String() string { return proto.CompactTextString(m) }
+func (*RuleOverride) ProtoMessage()    {}
+func (*RuleOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{90}
+}
+
+func (m *RuleOverride) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleOverride.Unmarshal(m, b)
+}
+func (m *RuleOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleOverride.Marshal(b, m, deterministic)
+}
+func (m *RuleOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleOverride.Merge(m, src)
+}
+func (m *RuleOverride) XXX_Size() int {
+	return xxx_messageInfo_RuleOverride.Size(m)
+}
+func (m *RuleOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleOverride proto.InternalMessageInfo
+
+func (m *RuleOverride) GetOverrideId() string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return ""
+}
+
+func (m *RuleOverride) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *RuleOverride) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *RuleOverride) GetThresholds() string {
+	if m != nil {
+		return m.Thresholds
+	}
+	return ""
+}
+
+func (m *RuleOverride) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *RuleOverride) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *RuleOverride) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type CreateRuleOverrideRequest struct {
+	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName         string   `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	Thresholds           string   `protobuf:"bytes,3,opt,name=thresholds,proto3" json:"thresholds"`
+	RecoveryThresholds   string   `protobuf:"bytes,4,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRuleOverrideRequest) Reset()         { *m = CreateRuleOverrideRequest{} }
+func (m *CreateRuleOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRuleOverrideRequest) ProtoMessage()    {}
+func (*CreateRuleOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{91}
+}
+
+func (m *CreateRuleOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRuleOverrideRequest.Unmarshal(m, b)
+}
+func (m *CreateRuleOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRuleOverrideRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRuleOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuleOverrideRequest.Merge(m, src)
+}
+func (m *CreateRuleOverrideRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRuleOverrideRequest.Size(m)
+}
+func (m *CreateRuleOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuleOverrideRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuleOverrideRequest proto.InternalMessageInfo
+
+func (m *CreateRuleOverrideRequest) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *CreateRuleOverrideRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *CreateRuleOverrideRequest) GetThresholds() string {
+	if m != nil {
+		return m.Thresholds
+	}
+	return ""
+}
+
+func (m *CreateRuleOverrideRequest) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+type CreateRuleOverrideResponse struct {
+	OverrideId           string   `protobuf:"bytes,1,opt,name=override_id,json=overrideId,proto3" json:"override_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRuleOverrideResponse) Reset()         { *m = CreateRuleOverrideResponse{} }
+func (m *CreateRuleOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRuleOverrideResponse) ProtoMessage()    {}
+func (*CreateRuleOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{92}
+}
+
+func (m *CreateRuleOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRuleOverrideResponse.Unmarshal(m, b)
+}
+func (m *CreateRuleOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRuleOverrideResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateRuleOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuleOverrideResponse.Merge(m, src)
+}
+func (m *CreateRuleOverrideResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateRuleOverrideResponse.Size(m)
+}
+func (m *CreateRuleOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuleOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuleOverrideResponse proto.InternalMessageInfo
+
+func (m *CreateRuleOverrideResponse) GetOverrideId() string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return ""
+}
+
+type DescribeRuleOverridesRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	OverrideId           []string `protobuf:"bytes,6,rep,name=override_id,json=overrideId,proto3" json:"override_id"`
+	RuleId               []string `protobuf:"bytes,7,rep,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName         []string `protobuf:"bytes,8,rep,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeRuleOverridesRequest) Reset()         { *m = DescribeRuleOverridesRequest{} }
+func (m *DescribeRuleOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuleOverridesRequest) ProtoMessage()    {}
+func (*DescribeRuleOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{93}
+}
+
+func (m *DescribeRuleOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuleOverridesRequest.Unmarshal(m, b)
+}
+func (m *DescribeRuleOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuleOverridesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeRuleOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuleOverridesRequest.Merge(m, src)
+}
+func (m *DescribeRuleOverridesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuleOverridesRequest.Size(m)
+}
+func (m *DescribeRuleOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuleOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuleOverridesRequest proto.InternalMessageInfo
+
+func (m *DescribeRuleOverridesRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeRuleOverridesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeRuleOverridesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeRuleOverridesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeRuleOverridesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeRuleOverridesRequest) GetOverrideId() []string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return nil
+}
+
+func (m *DescribeRuleOverridesRequest) GetRuleId() []string {
+	if m != nil {
+		return m.RuleId
+	}
+	return nil
+}
+
+func (m *DescribeRuleOverridesRequest) GetResourceName() []string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return nil
+}
+
+type DescribeRuleOverridesResponse struct {
+	Total                uint32          `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	RuleOverrideSet      []*RuleOverride `protobuf:"bytes,2,rep,name=rule_override_set,json=ruleOverrideSet,proto3" json:"rule_override_set"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeRuleOverridesResponse) Reset()         { *m = DescribeRuleOverridesResponse{} }
+func (m *DescribeRuleOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeRuleOverridesResponse) ProtoMessage()    {}
+func (*DescribeRuleOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{94}
+}
+
+func (m *DescribeRuleOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeRuleOverridesResponse.Unmarshal(m, b)
+}
+func (m *DescribeRuleOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeRuleOverridesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeRuleOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeRuleOverridesResponse.Merge(m, src)
+}
+func (m *DescribeRuleOverridesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeRuleOverridesResponse.Size(m)
+}
+func (m *DescribeRuleOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeRuleOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeRuleOverridesResponse proto.InternalMessageInfo
+
+func (m *DescribeRuleOverridesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeRuleOverridesResponse) GetRuleOverrideSet() []*RuleOverride {
+	if m != nil {
+		return m.RuleOverrideSet
+	}
+	return nil
+}
+
+type ModifyRuleOverrideRequest struct {
+	OverrideId           string   `protobuf:"bytes,1,opt,name=override_id,json=overrideId,proto3" json:"override_id"`
+	Thresholds           string   `protobuf:"bytes,2,opt,name=thresholds,proto3" json:"thresholds"`
+	RecoveryThresholds   string   `protobuf:"bytes,3,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyRuleOverrideRequest) Reset()         { *m = ModifyRuleOverrideRequest{} }
+func (m *ModifyRuleOverrideRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyRuleOverrideRequest) ProtoMessage()    {}
+func (*ModifyRuleOverrideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{95}
+}
+
+func (m *ModifyRuleOverrideRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyRuleOverrideRequest.Unmarshal(m, b)
+}
+func (m *ModifyRuleOverrideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyRuleOverrideRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyRuleOverrideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRuleOverrideRequest.Merge(m, src)
+}
+func (m *ModifyRuleOverrideRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyRuleOverrideRequest.Size(m)
+}
+func (m *ModifyRuleOverrideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRuleOverrideRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyRuleOverrideRequest proto.InternalMessageInfo
+
+func (m *ModifyRuleOverrideRequest) GetOverrideId() string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return ""
+}
+
+func (m *ModifyRuleOverrideRequest) GetThresholds() string {
+	if m != nil {
+		return m.Thresholds
+	}
+	return ""
+}
+
+func (m *ModifyRuleOverrideRequest) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+type ModifyRuleOverrideResponse struct {
+	OverrideId           string   `protobuf:"bytes,1,opt,name=override_id,json=overrideId,proto3" json:"override_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyRuleOverrideResponse) Reset()         { *m = ModifyRuleOverrideResponse{} }
+func (m *ModifyRuleOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyRuleOverrideResponse) ProtoMessage()    {}
+func (*ModifyRuleOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{96}
+}
+
+func (m *ModifyRuleOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyRuleOverrideResponse.Unmarshal(m, b)
+}
+func (m *ModifyRuleOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyRuleOverrideResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyRuleOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyRuleOverrideResponse.Merge(m, src)
+}
+func (m *ModifyRuleOverrideResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyRuleOverrideResponse.Size(m)
+}
+func (m *ModifyRuleOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyRuleOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyRuleOverrideResponse proto.InternalMessageInfo
+
+func (m *ModifyRuleOverrideResponse) GetOverrideId() string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return ""
+}
+
+type DeleteRuleOverridesRequest struct {
+	OverrideId           []string `protobuf:"bytes,1,rep,name=override_id,json=overrideId,proto3" json:"override_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRuleOverridesRequest) Reset()         { *m = DeleteRuleOverridesRequest{} }
+func (m *DeleteRuleOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRuleOverridesRequest) ProtoMessage()    {}
+func (*DeleteRuleOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{97}
+}
+
+func (m *DeleteRuleOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRuleOverridesRequest.Unmarshal(m, b)
+}
+func (m *DeleteRuleOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRuleOverridesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRuleOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRuleOverridesRequest.Merge(m, src)
+}
+func (m *DeleteRuleOverridesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRuleOverridesRequest.Size(m)
+}
+func (m *DeleteRuleOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRuleOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRuleOverridesRequest proto.InternalMessageInfo
+
+func (m *DeleteRuleOverridesRequest) GetOverrideId() []string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return nil
+}
+
+type DeleteRuleOverridesResponse struct {
+	OverrideId           []string `protobuf:"bytes,1,rep,name=override_id,json=overrideId,proto3" json:"override_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRuleOverridesResponse) Reset()         { *m = DeleteRuleOverridesResponse{} }
+func (m *DeleteRuleOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRuleOverridesResponse) ProtoMessage()    {}
+func (*DeleteRuleOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{98}
+}
+
+func (m *DeleteRuleOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRuleOverridesResponse.Unmarshal(m, b)
+}
+func (m *DeleteRuleOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRuleOverridesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteRuleOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRuleOverridesResponse.Merge(m, src)
+}
+func (m *DeleteRuleOverridesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteRuleOverridesResponse.Size(m)
+}
+func (m *DeleteRuleOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRuleOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRuleOverridesResponse proto.InternalMessageInfo
+
+func (m *DeleteRuleOverridesResponse) GetOverrideId() []string {
+	if m != nil {
+		return m.OverrideId
+	}
+	return nil
+}
+
+//11.Slo
+//********************************************************************************************************
+type Slo struct {
+	SloId                string               `protobuf:"bytes,1,opt,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	SloName              string               `protobuf:"bytes,2,opt,name=slo_name,json=sloName,proto3" json:"slo_name"`
+	Target               string               `protobuf:"bytes,3,opt,name=target,proto3" json:"target"`
+	WindowDays           uint32               `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days"`
+	GoodMetricId         string               `protobuf:"bytes,5,opt,name=good_metric_id,json=goodMetricId,proto3" json:"good_metric_id"`
+	TotalMetricId        string               `protobuf:"bytes,6,opt,name=total_metric_id,json=totalMetricId,proto3" json:"total_metric_id"`
+	BurnWindows          string               `protobuf:"bytes,7,opt,name=burn_windows,json=burnWindows,proto3" json:"burn_windows"`
+	Disabled             bool                 `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled"`
+	PolicyId             string               `protobuf:"bytes,9,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Slo) Reset()         { *m = Slo{} }
+func (m *Slo) String() string { return proto.CompactTextString(m) }
+func (*Slo) ProtoMessage()    {}
+func (*Slo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{99}
+}
+
+func (m *Slo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Slo.Unmarshal(m, b)
+}
+func (m *Slo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Slo.Marshal(b, m, deterministic)
+}
+func (m *Slo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slo.Merge(m, src)
+}
+func (m *Slo) XXX_Size() int {
+	return xxx_messageInfo_Slo.Size(m)
+}
+func (m *Slo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slo proto.InternalMessageInfo
+
+func (m *Slo) GetSloId() string {
+	if m != nil {
+		return m.SloId
+	}
+	return ""
+}
+
+func (m *Slo) GetSloName() string {
+	if m != nil {
+		return m.SloName
+	}
+	return ""
+}
+
+func (m *Slo) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Slo) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
+}
+
+func (m *Slo) GetGoodMetricId() string {
+	if m != nil {
+		return m.GoodMetricId
+	}
+	return ""
+}
+
+func (m *Slo) GetTotalMetricId() string {
+	if m != nil {
+		return m.TotalMetricId
+	}
+	return ""
+}
+
+func (m *Slo) GetBurnWindows() string {
+	if m != nil {
+		return m.BurnWindows
+	}
+	return ""
+}
+
+func (m *Slo) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *Slo) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Slo) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Slo) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type CreateSloRequest struct {
+	SloName              string   `protobuf:"bytes,1,opt,name=slo_name,json=sloName,proto3" json:"slo_name"`
+	Target               string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
+	WindowDays           uint32   `protobuf:"varint,3,opt,name=window_days,json=windowDays,proto3" json:"window_days"`
+	GoodMetricId         string   `protobuf:"bytes,4,opt,name=good_metric_id,json=goodMetricId,proto3" json:"good_metric_id"`
+	TotalMetricId        string   `protobuf:"bytes,5,opt,name=total_metric_id,json=totalMetricId,proto3" json:"total_metric_id"`
+	BurnWindows          string   `protobuf:"bytes,6,opt,name=burn_windows,json=burnWindows,proto3" json:"burn_windows"`
+	Disabled             bool     `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled"`
+	PolicyId             string   `protobuf:"bytes,8,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSloRequest) Reset()         { *m = CreateSloRequest{} }
+func (m *CreateSloRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSloRequest) ProtoMessage()    {}
+func (*CreateSloRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{100}
+}
+
+func (m *CreateSloRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSloRequest.Unmarshal(m, b)
+}
+func (m *CreateSloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSloRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSloRequest.Merge(m, src)
+}
+func (m *CreateSloRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSloRequest.Size(m)
+}
+func (m *CreateSloRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSloRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSloRequest proto.InternalMessageInfo
+
+func (m *CreateSloRequest) GetSloName() string {
+	if m != nil {
+		return m.SloName
+	}
+	return ""
+}
+
+func (m *CreateSloRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CreateSloRequest) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
+}
+
+func (m *CreateSloRequest) GetGoodMetricId() string {
+	if m != nil {
+		return m.GoodMetricId
+	}
+	return ""
+}
+
+func (m *CreateSloRequest) GetTotalMetricId() string {
+	if m != nil {
+		return m.TotalMetricId
+	}
+	return ""
+}
+
+func (m *CreateSloRequest) GetBurnWindows() string {
+	if m != nil {
+		return m.BurnWindows
+	}
+	return ""
+}
+
+func (m *CreateSloRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *CreateSloRequest) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+type CreateSloResponse struct {
+	SloId                string   `protobuf:"bytes,1,opt,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSloResponse) Reset()         { *m = CreateSloResponse{} }
+func (m *CreateSloResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSloResponse) ProtoMessage()    {}
+func (*CreateSloResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{101}
+}
+
+func (m *CreateSloResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSloResponse.Unmarshal(m, b)
+}
+func (m *CreateSloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSloResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateSloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSloResponse.Merge(m, src)
+}
+func (m *CreateSloResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSloResponse.Size(m)
+}
+func (m *CreateSloResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSloResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSloResponse proto.InternalMessageInfo
+
+func (m *CreateSloResponse) GetSloId() string {
+	if m != nil {
+		return m.SloId
+	}
+	return ""
+}
+
+type DescribeSlosRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	SloId                []string `protobuf:"bytes,6,rep,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	SloName              []string `protobuf:"bytes,7,rep,name=slo_name,json=sloName,proto3" json:"slo_name"`
+	PolicyId             []string `protobuf:"bytes,8,rep,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeSlosRequest) Reset()         { *m = DescribeSlosRequest{} }
+func (m *DescribeSlosRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSlosRequest) ProtoMessage()    {}
+func (*DescribeSlosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{102}
+}
+
+func (m *DescribeSlosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSlosRequest.Unmarshal(m, b)
+}
+func (m *DescribeSlosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSlosRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeSlosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSlosRequest.Merge(m, src)
+}
+func (m *DescribeSlosRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSlosRequest.Size(m)
+}
+func (m *DescribeSlosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSlosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSlosRequest proto.InternalMessageInfo
+
+func (m *DescribeSlosRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeSlosRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeSlosRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeSlosRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeSlosRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeSlosRequest) GetSloId() []string {
+	if m != nil {
+		return m.SloId
+	}
+	return nil
+}
+
+func (m *DescribeSlosRequest) GetSloName() []string {
+	if m != nil {
+		return m.SloName
+	}
+	return nil
+}
+
+func (m *DescribeSlosRequest) GetPolicyId() []string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+type DescribeSlosResponse struct {
+	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	SloSet               []*Slo   `protobuf:"bytes,2,rep,name=slo_set,json=sloSet,proto3" json:"slo_set"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeSlosResponse) Reset()         { *m = DescribeSlosResponse{} }
+func (m *DescribeSlosResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSlosResponse) ProtoMessage()    {}
+func (*DescribeSlosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{103}
+}
+
+func (m *DescribeSlosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSlosResponse.Unmarshal(m, b)
+}
+func (m *DescribeSlosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSlosResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeSlosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSlosResponse.Merge(m, src)
+}
+func (m *DescribeSlosResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSlosResponse.Size(m)
+}
+func (m *DescribeSlosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSlosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSlosResponse proto.InternalMessageInfo
+
+func (m *DescribeSlosResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeSlosResponse) GetSloSet() []*Slo {
+	if m != nil {
+		return m.SloSet
+	}
+	return nil
+}
+
+type ModifySloRequest struct {
+	SloId                string   `protobuf:"bytes,1,opt,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	SloName              string   `protobuf:"bytes,2,opt,name=slo_name,json=sloName,proto3" json:"slo_name"`
+	Target               string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target"`
+	WindowDays           uint32   `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days"`
+	GoodMetricId         string   `protobuf:"bytes,5,opt,name=good_metric_id,json=goodMetricId,proto3" json:"good_metric_id"`
+	TotalMetricId        string   `protobuf:"bytes,6,opt,name=total_metric_id,json=totalMetricId,proto3" json:"total_metric_id"`
+	BurnWindows          string   `protobuf:"bytes,7,opt,name=burn_windows,json=burnWindows,proto3" json:"burn_windows"`
+	Disabled             bool     `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifySloRequest) Reset()         { *m = ModifySloRequest{} }
+func (m *ModifySloRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifySloRequest) ProtoMessage()    {}
+func (*ModifySloRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{104}
+}
+
+func (m *ModifySloRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySloRequest.Unmarshal(m, b)
+}
+func (m *ModifySloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySloRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifySloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySloRequest.Merge(m, src)
+}
+func (m *ModifySloRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifySloRequest.Size(m)
+}
+func (m *ModifySloRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySloRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySloRequest proto.InternalMessageInfo
+
+func (m *ModifySloRequest) GetSloId() string {
+	if m != nil {
+		return m.SloId
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetSloName() string {
+	if m != nil {
+		return m.SloName
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
+}
+
+func (m *ModifySloRequest) GetGoodMetricId() string {
+	if m != nil {
+		return m.GoodMetricId
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetTotalMetricId() string {
+	if m != nil {
+		return m.TotalMetricId
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetBurnWindows() string {
+	if m != nil {
+		return m.BurnWindows
+	}
+	return ""
+}
+
+func (m *ModifySloRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type ModifySloResponse struct {
+	SloId                string   `protobuf:"bytes,1,opt,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifySloResponse) Reset()         { *m = ModifySloResponse{} }
+func (m *ModifySloResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifySloResponse) ProtoMessage()    {}
+func (*ModifySloResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{105}
+}
+
+func (m *ModifySloResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySloResponse.Unmarshal(m, b)
+}
+func (m *ModifySloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySloResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifySloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySloResponse.Merge(m, src)
+}
+func (m *ModifySloResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifySloResponse.Size(m)
+}
+func (m *ModifySloResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySloResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySloResponse proto.InternalMessageInfo
+
+func (m *ModifySloResponse) GetSloId() string {
+	if m != nil {
+		return m.SloId
+	}
+	return ""
+}
+
+type DeleteSlosRequest struct {
+	SloId                []string `protobuf:"bytes,1,rep,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSlosRequest) Reset()         { *m = DeleteSlosRequest{} }
+func (m *DeleteSlosRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSlosRequest) ProtoMessage()    {}
+func (*DeleteSlosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{106}
+}
+
+func (m *DeleteSlosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSlosRequest.Unmarshal(m, b)
+}
+func (m *DeleteSlosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSlosRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSlosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSlosRequest.Merge(m, src)
+}
+func (m *DeleteSlosRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSlosRequest.Size(m)
+}
+func (m *DeleteSlosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSlosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSlosRequest proto.InternalMessageInfo
+
+func (m *DeleteSlosRequest) GetSloId() []string {
+	if m != nil {
+		return m.SloId
+	}
+	return nil
+}
+
+type DeleteSlosResponse struct {
+	SloId                []string `protobuf:"bytes,1,rep,name=slo_id,json=sloId,proto3" json:"slo_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSlosResponse) Reset()         { *m = DeleteSlosResponse{} }
+func (m *DeleteSlosResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteSlosResponse) ProtoMessage()    {}
+func (*DeleteSlosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{107}
+}
+
+func (m *DeleteSlosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSlosResponse.Unmarshal(m, b)
+}
+func (m *DeleteSlosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSlosResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteSlosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSlosResponse.Merge(m, src)
+}
+func (m *DeleteSlosResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteSlosResponse.Size(m)
+}
+func (m *DeleteSlosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSlosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSlosResponse proto.InternalMessageInfo
+
+func (m *DeleteSlosResponse) GetSloId() []string {
+	if m != nil {
+		return m.SloId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Executor)(nil), "kubesphere.alert.Executor")
+	proto.RegisterType((*CreateExecutorRequest)(nil), "kubesphere.alert.CreateExecutorRequest")
+	proto.RegisterType((*CreateExecutorResponse)(nil), "kubesphere.alert.CreateExecutorResponse")
+	proto.RegisterType((*DescribeExecutorsRequest)(nil), "kubesphere.alert.DescribeExecutorsRequest")
+	proto.RegisterType((*DescribeExecutorsResponse)(nil), "kubesphere.alert.DescribeExecutorsResponse")
+	proto.RegisterType((*ModifyExecutorRequest)(nil), "kubesphere.alert.ModifyExecutorRequest")
+	proto.RegisterType((*ModifyExecutorResponse)(nil), "kubesphere.alert.ModifyExecutorResponse")
+	proto.RegisterType((*DeleteExecutorsRequest)(nil), "kubesphere.alert.DeleteExecutorsRequest")
+	proto.RegisterType((*DeleteExecutorsResponse)(nil), "kubesphere.alert.DeleteExecutorsResponse")
+	proto.RegisterType((*ResourceType)(nil), "kubesphere.alert.ResourceType")
+	proto.RegisterType((*CreateResourceTypeRequest)(nil), "kubesphere.alert.CreateResourceTypeRequest")
+	proto.RegisterType((*CreateResourceTypeResponse)(nil), "kubesphere.alert.CreateResourceTypeResponse")
+	proto.RegisterType((*DescribeResourceTypesRequest)(nil), "kubesphere.alert.DescribeResourceTypesRequest")
+	proto.RegisterType((*DescribeResourceTypesResponse)(nil), "kubesphere.alert.DescribeResourceTypesResponse")
+	proto.RegisterType((*ModifyResourceTypeRequest)(nil), "kubesphere.alert.ModifyResourceTypeRequest")
+	proto.RegisterType((*ModifyResourceTypeResponse)(nil), "kubesphere.alert.ModifyResourceTypeResponse")
+	proto.RegisterType((*DeleteResourceTypesRequest)(nil), "kubesphere.alert.DeleteResourceTypesRequest")
+	proto.RegisterType((*DeleteResourceTypesResponse)(nil), "kubesphere.alert.DeleteResourceTypesResponse")
+	proto.RegisterType((*ResourceFilter)(nil), "kubesphere.alert.ResourceFilter")
+	proto.RegisterType((*CreateResourceFilterRequest)(nil), "kubesphere.alert.CreateResourceFilterRequest")
+	proto.RegisterType((*CreateResourceFilterResponse)(nil), "kubesphere.alert.CreateResourceFilterResponse")
+	proto.RegisterType((*DescribeResourceFiltersRequest)(nil), "kubesphere.alert.DescribeResourceFiltersRequest")
+	proto.RegisterType((*DescribeResourceFiltersResponse)(nil), "kubesphere.alert.DescribeResourceFiltersResponse")
+	proto.RegisterType((*ModifyResourceFilterRequest)(nil), "kubesphere.alert.ModifyResourceFilterRequest")
+	proto.RegisterType((*ModifyResourceFilterResponse)(nil), "kubesphere.alert.ModifyResourceFilterResponse")
+	proto.RegisterType((*DeleteResourceFiltersRequest)(nil), "kubesphere.alert.DeleteResourceFiltersRequest")
+	proto.RegisterType((*DeleteResourceFiltersResponse)(nil), "kubesphere.alert.DeleteResourceFiltersResponse")
+	proto.RegisterType((*Metric)(nil), "kubesphere.alert.Metric")
+	proto.RegisterType((*CreateMetricRequest)(nil), "kubesphere.alert.CreateMetricRequest")
+	proto.RegisterType((*CreateMetricResponse)(nil), "kubesphere.alert.CreateMetricResponse")
+	proto.RegisterType((*DescribeMetricsRequest)(nil), "kubesphere.alert.DescribeMetricsRequest")
+	proto.RegisterType((*DescribeMetricsResponse)(nil), "kubesphere.alert.DescribeMetricsResponse")
+	proto.RegisterType((*ModifyMetricRequest)(nil), "kubesphere.alert.ModifyMetricRequest")
 	proto.RegisterType((*ModifyMetricResponse)(nil), "kubesphere.alert.ModifyMetricResponse")
 	proto.RegisterType((*DeleteMetricsRequest)(nil), "kubesphere.alert.DeleteMetricsRequest")
 	proto.RegisterType((*DeleteMetricsResponse)(nil), "kubesphere.alert.DeleteMetricsResponse")
@@ -5802,254 +7236,339 @@ func init() {
 	proto.RegisterType((*ModifyActionResponse)(nil), "kubesphere.alert.ModifyActionResponse")
 	proto.RegisterType((*DeleteActionsRequest)(nil), "kubesphere.alert.DeleteActionsRequest")
 	proto.RegisterType((*DeleteActionsResponse)(nil), "kubesphere.alert.DeleteActionsResponse")
+	proto.RegisterType((*RuleOverride)(nil), "kubesphere.alert.RuleOverride")
+	proto.RegisterType((*CreateRuleOverrideRequest)(nil), "kubesphere.alert.CreateRuleOverrideRequest")
+	proto.RegisterType((*CreateRuleOverrideResponse)(nil), "kubesphere.alert.CreateRuleOverrideResponse")
+	proto.RegisterType((*DescribeRuleOverridesRequest)(nil), "kubesphere.alert.DescribeRuleOverridesRequest")
+	proto.RegisterType((*DescribeRuleOverridesResponse)(nil), "kubesphere.alert.DescribeRuleOverridesResponse")
+	proto.RegisterType((*ModifyRuleOverrideRequest)(nil), "kubesphere.alert.ModifyRuleOverrideRequest")
+	proto.RegisterType((*ModifyRuleOverrideResponse)(nil), "kubesphere.alert.ModifyRuleOverrideResponse")
+	proto.RegisterType((*DeleteRuleOverridesRequest)(nil), "kubesphere.alert.DeleteRuleOverridesRequest")
+	proto.RegisterType((*DeleteRuleOverridesResponse)(nil), "kubesphere.alert.DeleteRuleOverridesResponse")
+	proto.RegisterType((*Slo)(nil), "kubesphere.alert.Slo")
+	proto.RegisterType((*CreateSloRequest)(nil), "kubesphere.alert.CreateSloRequest")
+	proto.RegisterType((*CreateSloResponse)(nil), "kubesphere.alert.CreateSloResponse")
+	proto.RegisterType((*DescribeSlosRequest)(nil), "kubesphere.alert.DescribeSlosRequest")
+	proto.RegisterType((*DescribeSlosResponse)(nil), "kubesphere.alert.DescribeSlosResponse")
+	proto.RegisterType((*ModifySloRequest)(nil), "kubesphere.alert.ModifySloRequest")
+	proto.RegisterType((*ModifySloResponse)(nil), "kubesphere.alert.ModifySloResponse")
+	proto.RegisterType((*DeleteSlosRequest)(nil), "kubesphere.alert.DeleteSlosRequest")
+	proto.RegisterType((*DeleteSlosResponse)(nil), "kubesphere.alert.DeleteSlosResponse")
 }
 
 func init() { proto.RegisterFile("alert.proto", fileDescriptor_3b11b2fb4e5b6d61) }
 
 var fileDescriptor_3b11b2fb4e5b6d61 = []byte{
-	// 3858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4f, 0x6c, 0x1c, 0x49,
-	0xd5, 0x57, 0x8f, 0xff, 0xcc, 0x4c, 0xcd, 0x1f, 0xdb, 0x15, 0xc7, 0xb1, 0x27, 0xd9, 0xc4, 0xdb,
-	0xf9, 0xe7, 0x75, 0x62, 0x3b, 0x71, 0xf6, 0xcf, 0xb7, 0xd9, 0xef, 0x93, 0x76, 0xbe, 0xec, 0x7e,
-	0xfa, 0x0c, 0x2c, 0xac, 0x9c, 0x95, 0x90, 0xb8, 0x0c, 0x93, 0x99, 0xb6, 0xdd, 0xda, 0xf1, 0xf4,
-	0xd0, 0xdd, 0x93, 0xc5, 0x12, 0x12, 0x5a, 0x0e, 0x08, 0x01, 0x82, 0xd5, 0xac, 0x40, 0x82, 0x1b,
-	0x1c, 0x90, 0x10, 0x1c, 0xf6, 0xc2, 0x85, 0x03, 0x07, 0xb8, 0x70, 0xe2, 0xc2, 0x05, 0xc1, 0x0d,
-	0x71, 0x5b, 0x24, 0x24, 0x2e, 0x80, 0xc4, 0x01, 0x55, 0xd5, 0xab, 0xee, 0x7a, 0xd5, 0x55, 0xdd,
-	0x93, 0x8d, 0x42, 0x8c, 0xb4, 0x27, 0xbb, 0x5f, 0xbd, 0x9a, 0x7e, 0xf5, 0x7b, 0xbf, 0x7a, 0xaf,
-	0xfa, 0x55, 0x15, 0xa9, 0x75, 0x07, 0x5e, 0x18, 0x6f, 0x8f, 0xc2, 0x20, 0x0e, 0xe8, 0xe2, 0xdb,
-	0xe3, 0x07, 0x5e, 0x34, 0x3a, 0xf2, 0x42, 0x6f, 0x9b, 0xcb, 0x5b, 0x17, 0x0e, 0x83, 0xe0, 0x70,
-	0xe0, 0xed, 0x74, 0x47, 0xfe, 0x4e, 0x77, 0x38, 0x0c, 0xe2, 0x6e, 0xec, 0x07, 0xc3, 0x48, 0xe8,
-	0xb7, 0x2e, 0x42, 0x2b, 0x7f, 0x7a, 0x30, 0x3e, 0xd8, 0x79, 0x27, 0xec, 0x8e, 0x46, 0x5e, 0x28,
-	0xdb, 0x6f, 0xf2, 0x3f, 0xbd, 0xad, 0x43, 0x6f, 0xb8, 0x15, 0xbd, 0xd3, 0x3d, 0x3c, 0xf4, 0xc2,
-	0x9d, 0x60, 0xc4, 0x7f, 0xc1, 0xf0, 0x6b, 0x97, 0xf4, 0x5f, 0x8b, 0xfd, 0x63, 0x2f, 0x8a, 0xbb,
-	0xc7, 0x23, 0xa1, 0xe0, 0xfe, 0xd1, 0x21, 0x95, 0xd7, 0xbf, 0xe8, 0xf5, 0xc6, 0x71, 0x10, 0xd2,
-	0x4b, 0xa4, 0xe6, 0xc1, 0xff, 0x1d, 0xbf, 0xbf, 0xea, 0xac, 0x3b, 0x1b, 0xd5, 0x7d, 0x22, 0x45,
-	0x7b, 0x7d, 0x7a, 0x99, 0x34, 0x12, 0x85, 0x61, 0xf7, 0xd8, 0x5b, 0x2d, 0x71, 0x95, 0xba, 0x14,
-	0x7e, 0xba, 0x7b, 0xec, 0xd1, 0x15, 0x32, 0x1f, 0xc5, 0xdd, 0x78, 0x1c, 0xad, 0xce, 0xf0, 0x56,
-	0x78, 0xa2, 0xaf, 0x90, 0x5a, 0x2f, 0xf4, 0xba, 0xb1, 0xd7, 0x61, 0x46, 0xac, 0xce, 0xae, 0x3b,
-	0x1b, 0xb5, 0xdd, 0xd6, 0xb6, 0xb0, 0x70, 0x5b, 0x5a, 0xb8, 0xfd, 0x96, 0xb4, 0x70, 0x9f, 0x08,
-	0x75, 0x26, 0x60, 0x9d, 0xc7, 0xa3, 0x7e, 0xd2, 0x79, 0xae, 0xb8, 0xb3, 0x50, 0x67, 0x02, 0xf7,
-	0xbf, 0xc9, 0xd9, 0x7b, 0xfc, 0xa7, 0xe4, 0x48, 0xf7, 0xbd, 0x2f, 0x8c, 0xbd, 0x28, 0xce, 0x8e,
-	0xc7, 0xc9, 0x8e, 0xc7, 0x7d, 0x99, 0xac, 0xe8, 0xbd, 0xa3, 0x51, 0x30, 0x8c, 0xbc, 0x42, 0xbc,
-	0xdc, 0x7f, 0x3a, 0x64, 0xf5, 0x35, 0x2f, 0xea, 0x85, 0xfe, 0x83, 0xa4, 0x77, 0x24, 0x5f, 0x7e,
-	0x89, 0xd4, 0x22, 0xaf, 0x1b, 0xf6, 0x8e, 0x3a, 0xef, 0x04, 0x61, 0xd2, 0x5b, 0x88, 0x3e, 0x1b,
-	0x84, 0x7d, 0xba, 0x46, 0x2a, 0x51, 0x10, 0xc6, 0x9d, 0xb7, 0xbd, 0x13, 0x00, 0xba, 0xcc, 0x9e,
-	0x3f, 0xe9, 0x9d, 0xd0, 0x55, 0x52, 0x0e, 0xbd, 0x87, 0x5e, 0x18, 0x79, 0x1c, 0xe4, 0xca, 0xbe,
-	0x7c, 0x64, 0xe8, 0x07, 0x07, 0x07, 0x91, 0x17, 0x73, 0x80, 0x1b, 0xfb, 0xf0, 0x44, 0x97, 0xc9,
-	0xdc, 0xc0, 0x3f, 0xf6, 0x63, 0x0e, 0x5d, 0x63, 0x5f, 0x3c, 0xe8, 0x23, 0x98, 0x5f, 0x9f, 0x29,
-	0xf2, 0x78, 0x99, 0xab, 0xd8, 0x3c, 0x5e, 0xe1, 0xad, 0xf0, 0xe4, 0x8e, 0xc8, 0x9a, 0x61, 0xf4,
-	0x00, 0xde, 0x32, 0x99, 0x8b, 0x83, 0xb8, 0x3b, 0xe0, 0x03, 0x6f, 0xec, 0x8b, 0x07, 0xfa, 0x3f,
-	0x24, 0xf9, 0xe9, 0x0e, 0x1b, 0x44, 0x69, 0x7d, 0x86, 0x3b, 0x5a, 0x9f, 0x45, 0xdb, 0x89, 0x33,
-	0x92, 0x01, 0xdc, 0xf7, 0x62, 0x77, 0x4c, 0xce, 0xbe, 0x11, 0xf4, 0xfd, 0x83, 0x13, 0xdd, 0xd3,
-	0x4f, 0x94, 0xda, 0x8c, 0x22, 0xfa, 0x6b, 0xa7, 0xa5, 0xc8, 0xcb, 0x64, 0xe5, 0x35, 0x6f, 0xe0,
-	0xc5, 0x46, 0x7e, 0xe0, 0xae, 0x9a, 0x6f, 0xdc, 0xbb, 0xe4, 0x5c, 0xa6, 0xab, 0xed, 0xb5, 0x7a,
-	0xdf, 0x3f, 0x3b, 0xa4, 0xbe, 0xef, 0x45, 0xc1, 0x38, 0xec, 0x79, 0x6f, 0x9d, 0x8c, 0x3c, 0x7a,
-	0x81, 0x90, 0x30, 0xea, 0xc4, 0x27, 0x23, 0x2f, 0xb5, 0xb3, 0x12, 0x46, 0xac, 0x6d, 0xaf, 0x4f,
-	0xd7, 0x49, 0x5d, 0xb6, 0x2a, 0xe0, 0x10, 0xd1, 0xce, 0xa1, 0x71, 0x49, 0x43, 0x6a, 0x8c, 0xba,
-	0x61, 0xf7, 0x18, 0x10, 0xaa, 0x09, 0x95, 0x37, 0x99, 0xe8, 0x29, 0x46, 0x80, 0x2e, 0x59, 0x13,
-	0x73, 0x58, 0x1d, 0xb3, 0x04, 0x5a, 0x1f, 0x9c, 0x53, 0x3c, 0xb8, 0x52, 0x66, 0x70, 0xee, 0x5d,
-	0xd2, 0x32, 0xbd, 0x02, 0x1c, 0x92, 0x0b, 0x2f, 0x8b, 0xc2, 0x17, 0xe4, 0x4c, 0x51, 0xbb, 0x9f,
-	0xaa, 0x58, 0x81, 0x87, 0x20, 0x42, 0x85, 0x9d, 0x21, 0x22, 0x4e, 0x28, 0x20, 0xba, 0xef, 0x3a,
-	0xe4, 0x19, 0xcb, 0x20, 0x73, 0x43, 0xc2, 0x27, 0xc8, 0x52, 0x08, 0xea, 0xe2, 0xf7, 0xd3, 0xb8,
-	0x70, 0x31, 0x1b, 0x17, 0x10, 0xfa, 0x0b, 0xa1, 0xf2, 0xc4, 0xe2, 0xc3, 0x97, 0xc9, 0x9a, 0x98,
-	0xa8, 0x26, 0x1e, 0xfc, 0x1b, 0xa6, 0x00, 0x63, 0x89, 0xc9, 0x80, 0xa9, 0x58, 0x72, 0x97, 0xb4,
-	0xc4, 0x7c, 0x37, 0x52, 0x44, 0xef, 0x8b, 0xdc, 0xe3, 0xbe, 0x42, 0xce, 0x1b, 0xfb, 0x5a, 0x5e,
-	0x8c, 0x3b, 0x7f, 0x50, 0x22, 0x4d, 0xd9, 0xef, 0xff, 0xfc, 0x41, 0xec, 0x85, 0x80, 0xc6, 0x01,
-	0x7f, 0x50, 0x02, 0x5b, 0x18, 0x89, 0xf6, 0xbd, 0x3e, 0xbd, 0x42, 0x9a, 0xa9, 0x86, 0x1a, 0x51,
-	0xa5, 0x0e, 0xc7, 0xec, 0x1a, 0x59, 0x48, 0xb5, 0x54, 0xd4, 0x1a, 0x52, 0x4d, 0x84, 0x8e, 0x34,
-	0xf2, 0xce, 0xe6, 0x2d, 0x2a, 0xe6, 0x1e, 0x27, 0xa4, 0xcc, 0x3f, 0x4a, 0x48, 0xd1, 0x20, 0x2b,
-	0x6b, 0xbe, 0xfa, 0x81, 0x43, 0xce, 0xe3, 0x70, 0x20, 0x46, 0x23, 0xbd, 0x95, 0x45, 0xc7, 0x99,
-	0x0e, 0x9d, 0x52, 0x3e, 0x3a, 0x78, 0xc9, 0x85, 0x6d, 0x9c, 0xd5, 0x6c, 0x7c, 0x95, 0x5c, 0x30,
-	0x9b, 0x08, 0xa4, 0x28, 0xf4, 0xb1, 0xfb, 0xc3, 0x12, 0xb9, 0xa8, 0x4f, 0x69, 0xd1, 0x78, 0xaa,
-	0x22, 0x97, 0x3e, 0x90, 0x79, 0x19, 0x9b, 0x72, 0xc8, 0x0a, 0xeb, 0x1c, 0xe4, 0x0e, 0xcb, 0x3a,
-	0x47, 0x83, 0xb9, 0xaa, 0xcd, 0x9e, 0xaf, 0x39, 0xe4, 0x92, 0x15, 0xa4, 0xdc, 0xc8, 0xf7, 0x19,
-	0x42, 0x65, 0x00, 0x03, 0xd3, 0xd2, 0xd0, 0xb7, 0x6e, 0x0f, 0x7d, 0xe0, 0xc6, 0x25, 0xdc, 0x97,
-	0x85, 0xbf, 0x5f, 0x39, 0xe4, 0x3c, 0x0e, 0x3f, 0x98, 0x95, 0xa7, 0x65, 0x56, 0x63, 0x40, 0xe7,
-	0xb2, 0xbc, 0x35, 0x0f, 0x62, 0x6a, 0xde, 0xbe, 0xca, 0xd2, 0xad, 0x1a, 0x0d, 0x35, 0xd2, 0x66,
-	0x7f, 0x41, 0x23, 0x8c, 0xdb, 0x66, 0xb9, 0xcc, 0xf8, 0x0b, 0x56, 0x23, 0xf4, 0x9f, 0xf8, 0x5e,
-	0x89, 0xcc, 0xbf, 0xe1, 0xc5, 0xa1, 0xdf, 0xa3, 0xe7, 0x49, 0xf5, 0x98, 0xff, 0xa7, 0x84, 0x7d,
-	0x21, 0xd8, 0xeb, 0xb3, 0x19, 0x04, 0x8d, 0x6a, 0xde, 0x11, 0x22, 0x8e, 0xf6, 0xb3, 0xa4, 0x0e,
-	0x0a, 0x28, 0xed, 0x08, 0xd9, 0x7f, 0x66, 0xf8, 0xfc, 0xb6, 0x43, 0xce, 0x88, 0xd8, 0x24, 0x10,
-	0x52, 0xa2, 0x89, 0x8a, 0x85, 0x53, 0x88, 0x45, 0x29, 0x0f, 0x8b, 0x47, 0x09, 0x96, 0x77, 0xc8,
-	0x32, 0x36, 0x08, 0xfc, 0x9c, 0xe7, 0x3a, 0xf7, 0xbd, 0x12, 0x5b, 0xdd, 0x8b, 0xa9, 0x2f, 0xfa,
-	0x9d, 0xaa, 0xb8, 0x88, 0x6c, 0x87, 0x05, 0x9d, 0x8d, 0x76, 0xb0, 0x9e, 0x53, 0xa0, 0xfe, 0x68,
-	0xd1, 0xf0, 0x88, 0x7d, 0xb4, 0x68, 0x88, 0xe4, 0x06, 0xc1, 0x97, 0x08, 0xbc, 0x54, 0x09, 0x7e,
-	0xab, 0xd9, 0xe0, 0x07, 0x6e, 0x81, 0x01, 0xb1, 0x60, 0xf7, 0x53, 0x87, 0x9c, 0x11, 0x71, 0x02,
-	0x73, 0xe8, 0xa9, 0x4d, 0xb6, 0xfc, 0xa8, 0x76, 0x87, 0x2c, 0x63, 0x6b, 0xa7, 0x21, 0xd8, 0x1d,
-	0xb2, 0x2c, 0xc2, 0x90, 0xc6, 0x2e, 0xad, 0x13, 0xf2, 0xac, 0xfb, 0x3c, 0x39, 0xab, 0x75, 0x32,
-	0xbf, 0x0a, 0xf7, 0xfa, 0xf5, 0x0c, 0x99, 0x7f, 0x33, 0x18, 0xf8, 0xbd, 0x13, 0xa6, 0x37, 0xe2,
-	0xff, 0x29, 0x26, 0x09, 0x81, 0x40, 0x10, 0x1a, 0x55, 0x04, 0x85, 0x88, 0x23, 0xb8, 0x45, 0x28,
-	0x28, 0xf4, 0x39, 0x11, 0x78, 0xf1, 0x0a, 0x70, 0x5c, 0x12, 0x2d, 0xaf, 0xa5, 0x0d, 0xec, 0xc3,
-	0x1c, 0xd4, 0x7b, 0xc1, 0xf0, 0xc0, 0x3f, 0x04, 0x50, 0xeb, 0x42, 0x78, 0x8f, 0xcb, 0xd8, 0x8c,
-	0xe0, 0x81, 0x29, 0x08, 0x01, 0x57, 0xf9, 0x48, 0x6f, 0x91, 0xe5, 0xee, 0xc3, 0xae, 0x3f, 0xe8,
-	0x3e, 0x18, 0x78, 0x9d, 0x28, 0xee, 0x86, 0x71, 0x1a, 0xad, 0xaa, 0xfb, 0x34, 0x69, 0xbb, 0xcf,
-	0x9a, 0x78, 0x64, 0xba, 0x49, 0x52, 0x69, 0xc7, 0x1b, 0xf6, 0x85, 0xbe, 0x88, 0x50, 0x8b, 0x49,
-	0xcb, 0xeb, 0xc3, 0xbe, 0x0c, 0x82, 0x6a, 0x04, 0xad, 0x3c, 0x4e, 0x04, 0xad, 0x3e, 0x46, 0x04,
-	0x25, 0xda, 0xe7, 0x4a, 0x8b, 0x54, 0x06, 0xdd, 0xe1, 0xe1, 0xb8, 0x7b, 0xe8, 0xad, 0xd6, 0x44,
-	0x9b, 0x7c, 0x76, 0x7f, 0x51, 0x92, 0xd1, 0x55, 0x38, 0x54, 0x89, 0x49, 0xaa, 0xeb, 0x9c, 0x29,
-	0x5d, 0x57, 0x9a, 0xda, 0x75, 0x33, 0xf9, 0xae, 0x9b, 0x9d, 0xce, 0x75, 0x73, 0x8f, 0xe8, 0xba,
-	0x79, 0x8b, 0xeb, 0x72, 0x53, 0x10, 0x02, 0xb0, 0xa2, 0x01, 0x98, 0x24, 0x03, 0x89, 0x5f, 0x3a,
-	0x81, 0xac, 0x13, 0xc3, 0xfd, 0x65, 0x29, 0x0d, 0x7d, 0xbc, 0x9f, 0xef, 0x9d, 0xb6, 0x6c, 0x90,
-	0x1a, 0x0f, 0xd9, 0xc0, 0x36, 0xab, 0x21, 0x1b, 0x14, 0x52, 0x43, 0x64, 0x06, 0x03, 0x35, 0x14,
-	0xaf, 0x8b, 0x0c, 0x91, 0x78, 0x5d, 0xa7, 0x35, 0x4e, 0x1f, 0x7e, 0x5a, 0x50, 0x4d, 0x31, 0x2c,
-	0xca, 0x1f, 0x60, 0x58, 0x6e, 0xfe, 0x00, 0x4f, 0x02, 0x04, 0x2c, 0x7f, 0xfc, 0xa1, 0x24, 0xf3,
-	0x07, 0x9e, 0x25, 0x1f, 0x47, 0x3f, 0xdb, 0x14, 0xaa, 0xe4, 0x4c, 0xa1, 0x6a, 0x76, 0x0a, 0x61,
-	0x70, 0xa7, 0x99, 0x42, 0x49, 0xe6, 0xd2, 0xe7, 0x8f, 0xd6, 0x0b, 0x71, 0xd7, 0x7d, 0x41, 0x96,
-	0x58, 0x33, 0x8c, 0xc9, 0xed, 0xf6, 0xdd, 0x59, 0x32, 0xbb, 0x3f, 0x1e, 0x78, 0xf4, 0x1c, 0x29,
-	0x87, 0xe3, 0x81, 0x52, 0x92, 0x99, 0x67, 0x8f, 0x7b, 0x7d, 0xd6, 0x9d, 0x37, 0x28, 0xae, 0xae,
-	0x30, 0x01, 0x77, 0x74, 0x8b, 0x54, 0xfa, 0x7e, 0xc4, 0xc0, 0xea, 0xc3, 0xbc, 0x4c, 0x9e, 0xe9,
-	0x75, 0xb2, 0x70, 0x1c, 0x0c, 0xfd, 0x38, 0x08, 0x3b, 0x23, 0x2f, 0xf4, 0x83, 0x7e, 0x04, 0x33,
-	0xb4, 0x09, 0xe2, 0x37, 0x85, 0x94, 0xfd, 0x48, 0xc4, 0x26, 0xb3, 0x1f, 0x9f, 0xc8, 0x05, 0x83,
-	0x7c, 0x4e, 0x57, 0x22, 0xc2, 0x01, 0xe0, 0x53, 0x58, 0x89, 0x70, 0x17, 0xd0, 0xab, 0xa4, 0xd9,
-	0x0b, 0x86, 0x7d, 0x9f, 0x51, 0x49, 0x28, 0x09, 0x47, 0x36, 0x12, 0x29, 0x57, 0xbb, 0x48, 0x48,
-	0x7c, 0x14, 0x7a, 0xd1, 0x51, 0x30, 0xe8, 0x47, 0xe0, 0x45, 0x45, 0x42, 0x29, 0x99, 0x1d, 0x0f,
-	0xfd, 0x18, 0x7c, 0xc8, 0xff, 0xa7, 0x37, 0xc8, 0x52, 0x8f, 0x61, 0xd8, 0x1b, 0xc7, 0xfe, 0x43,
-	0xaf, 0xd3, 0x0b, 0xc6, 0xc3, 0x98, 0x27, 0xa1, 0xc6, 0xfe, 0xa2, 0xd2, 0x70, 0x8f, 0xc9, 0x19,
-	0x41, 0xfd, 0xe1, 0x91, 0xff, 0xc0, 0x8f, 0x79, 0x2e, 0xaa, 0xec, 0xcb, 0x47, 0x3d, 0x7d, 0xd6,
-	0x1f, 0x27, 0x7d, 0x36, 0x1e, 0x29, 0x7d, 0x22, 0xdf, 0x37, 0xb5, 0x69, 0x8c, 0x56, 0x42, 0x0b,
-	0xfa, 0xaa, 0x7e, 0x86, 0x2c, 0x41, 0xe1, 0x64, 0x3c, 0xf0, 0x14, 0x0a, 0xa6, 0x64, 0x70, 0x72,
-	0xc8, 0x50, 0x2a, 0x26, 0xc3, 0x4c, 0x21, 0x19, 0x66, 0x0b, 0xc8, 0x30, 0x37, 0x0d, 0x19, 0xe6,
-	0x8b, 0xc9, 0x50, 0xb6, 0x92, 0xa1, 0x52, 0x44, 0x86, 0x6a, 0x31, 0x19, 0x08, 0x26, 0x03, 0x72,
-	0x49, 0x2d, 0xcf, 0x25, 0x75, 0xcd, 0x25, 0x5b, 0x84, 0xaa, 0x1e, 0x81, 0xe9, 0x6d, 0x9b, 0xb8,
-	0xee, 0x07, 0xb3, 0x6c, 0xdd, 0x0c, 0x25, 0x99, 0xf1, 0xe0, 0x74, 0xe5, 0x61, 0xc5, 0x6a, 0x91,
-	0x85, 0x8d, 0xe1, 0xa6, 0x0c, 0x89, 0xd1, 0xc4, 0x30, 0x96, 0x75, 0x0b, 0x18, 0xc6, 0x92, 0x6e,
-	0x3e, 0xc3, 0x20, 0xf3, 0x5a, 0x19, 0x56, 0xe3, 0xed, 0x05, 0x0c, 0xab, 0x73, 0xa5, 0x5c, 0x86,
-	0x35, 0xc4, 0x52, 0xc2, 0xc0, 0xb0, 0x26, 0x6f, 0xc9, 0x61, 0xd8, 0x02, 0x1f, 0x44, 0x2e, 0xc3,
-	0x16, 0x39, 0x14, 0x66, 0x86, 0x2d, 0x69, 0x6b, 0x1c, 0xc4, 0x30, 0xaa, 0x7d, 0xfe, 0x7c, 0x9e,
-	0xa5, 0x1e, 0xc4, 0x98, 0xdc, 0x55, 0xc7, 0x6d, 0xc2, 0x5d, 0xa3, 0xac, 0x39, 0x56, 0x0c, 0x05,
-	0x3b, 0x46, 0x56, 0xee, 0x6c, 0xb6, 0xde, 0xf8, 0x4b, 0x89, 0x2c, 0x41, 0x5d, 0x4b, 0x09, 0x2b,
-	0x1f, 0x27, 0x9f, 0x27, 0x96, 0x7c, 0x58, 0xd4, 0x50, 0x01, 0x2f, 0x8a, 0x1a, 0x5b, 0x84, 0x42,
-	0xcd, 0x4f, 0x0d, 0x19, 0x48, 0x5d, 0x99, 0xae, 0xee, 0x36, 0x39, 0x83, 0xd4, 0x4d, 0x3f, 0xaf,
-	0xea, 0xbf, 0x3b, 0x43, 0xe6, 0xda, 0x8c, 0x17, 0x2c, 0xc8, 0x70, 0x82, 0xa4, 0x26, 0x94, 0xf9,
-	0xf3, 0x5e, 0x9f, 0x3e, 0x43, 0x88, 0x68, 0x52, 0xdc, 0x5e, 0xe5, 0x92, 0x42, 0xbf, 0x5f, 0x25,
-	0xcd, 0x70, 0x3c, 0x1c, 0xfa, 0xc3, 0xc3, 0x0e, 0x2a, 0x4f, 0x34, 0x40, 0x7a, 0x5f, 0x54, 0x29,
-	0x9e, 0x25, 0x75, 0xf1, 0x06, 0x50, 0x82, 0x4c, 0xc2, 0x65, 0xf7, 0x8d, 0x55, 0xc3, 0xf9, 0xc7,
-	0x49, 0xda, 0xe5, 0x8f, 0x9e, 0xb4, 0x2b, 0x5a, 0x86, 0xd0, 0x4b, 0xae, 0xd5, 0x4c, 0xf5, 0x5a,
-	0xdb, 0x16, 0x27, 0x99, 0xdd, 0xf8, 0x6f, 0x39, 0x32, 0x91, 0x70, 0x4f, 0x48, 0x1f, 0x63, 0xd4,
-	0x9d, 0x3c, 0xd4, 0xf5, 0xec, 0x8e, 0x2c, 0x9e, 0x29, 0xb0, 0x78, 0x36, 0x53, 0xa9, 0xbe, 0x25,
-	0xbf, 0xd4, 0xc1, 0x1e, 0x20, 0x91, 0x9d, 0x21, 0xee, 0xdf, 0x4b, 0x69, 0xa4, 0xe2, 0x9d, 0x4e,
-	0x55, 0x72, 0x53, 0x0d, 0x17, 0xd9, 0xcd, 0x42, 0x6d, 0x91, 0xdf, 0x2c, 0x20, 0xeb, 0x09, 0x2e,
-	0x4b, 0x6d, 0xf1, 0x51, 0xa9, 0x51, 0x1b, 0xf9, 0x82, 0x68, 0xd1, 0x5f, 0xf7, 0x45, 0x2d, 0xb3,
-	0x49, 0xa4, 0xb1, 0xa7, 0x9e, 0x39, 0x54, 0xd1, 0x4f, 0xab, 0xbd, 0x12, 0xf9, 0xdc, 0x24, 0xf1,
-	0x3c, 0xa9, 0xc2, 0x54, 0x4b, 0xb2, 0xc4, 0xb9, 0x6c, 0x96, 0x10, 0x9e, 0x17, 0xb0, 0xb1, 0x3c,
-	0xf1, 0x63, 0x47, 0x86, 0x2d, 0xc4, 0xd1, 0x27, 0x13, 0x34, 0x10, 0x64, 0xb3, 0x05, 0xf4, 0x9d,
-	0x33, 0xd1, 0x17, 0x99, 0x5a, 0x4c, 0xdf, 0x5b, 0x32, 0x6a, 0x62, 0xee, 0xe2, 0x1e, 0x2a, 0x6f,
-	0xdc, 0xdb, 0xb2, 0x06, 0xaa, 0x61, 0x9e, 0xd3, 0xe5, 0x6f, 0x25, 0x52, 0xfe, 0x7f, 0x3f, 0x8a,
-	0x83, 0xf0, 0x84, 0x81, 0x73, 0x24, 0xfe, 0x4d, 0xad, 0xa9, 0x82, 0x64, 0xaf, 0xcf, 0xc2, 0xa1,
-	0x6c, 0x56, 0xd0, 0xab, 0x81, 0x8c, 0xe3, 0xb7, 0x4c, 0xe6, 0xbc, 0x87, 0xde, 0x30, 0x86, 0xe9,
-	0x2d, 0x1e, 0xf8, 0x47, 0x79, 0x30, 0x8c, 0x99, 0x5c, 0xd6, 0xb5, 0xc4, 0x23, 0x4b, 0xc0, 0xc3,
-	0x20, 0xf6, 0x0f, 0xfc, 0x1e, 0x3f, 0xab, 0x97, 0x22, 0xd7, 0x54, 0xc5, 0x7b, 0xfd, 0xa7, 0x18,
-	0x67, 0x55, 0xec, 0x2a, 0x98, 0x4c, 0x4a, 0xfe, 0xaa, 0xa2, 0x05, 0xc9, 0x65, 0xd2, 0x48, 0xce,
-	0x69, 0x70, 0xa8, 0x08, 0xec, 0x0c, 0x82, 0x90, 0x1f, 0x02, 0xf9, 0xd0, 0x91, 0xa5, 0x33, 0xc0,
-	0x5f, 0x3a, 0x58, 0xc7, 0xd9, 0xc9, 0xc1, 0xb9, 0x64, 0xc1, 0x79, 0xa6, 0x10, 0xe7, 0x59, 0x23,
-	0xce, 0xea, 0x68, 0xe7, 0xac, 0xa3, 0x9d, 0xcf, 0x1f, 0x6d, 0xd9, 0x30, 0xda, 0x17, 0xe5, 0xc1,
-	0xc3, 0x64, 0xb0, 0xc0, 0xcd, 0x7c, 0xd2, 0xb9, 0x93, 0x99, 0xb4, 0xcc, 0x25, 0xba, 0x9e, 0xb2,
-	0x5a, 0x21, 0xb6, 0x5f, 0x04, 0xf2, 0x9c, 0x49, 0x23, 0x82, 0xb9, 0xd9, 0x99, 0xa2, 0x44, 0x98,
-	0x75, 0xa6, 0x2c, 0x0b, 0xda, 0x9d, 0x29, 0x22, 0x78, 0x9e, 0x33, 0x6b, 0x38, 0xc3, 0x28, 0xce,
-	0xac, 0xa3, 0x2f, 0xab, 0x8c, 0x33, 0x1b, 0xb0, 0xfb, 0xaf, 0x3a, 0xf3, 0x38, 0x3d, 0xcd, 0xa8,
-	0xf8, 0x24, 0x37, 0xc0, 0xdf, 0x25, 0x72, 0xcc, 0x4a, 0x88, 0x5f, 0xcb, 0x86, 0x78, 0x49, 0x0f,
-	0x09, 0x2a, 0x0b, 0xf3, 0x5f, 0x2f, 0xc9, 0x0a, 0x99, 0x36, 0x53, 0x4e, 0x71, 0xc0, 0xc2, 0xd9,
-	0xdd, 0x36, 0x91, 0xca, 0xf9, 0x13, 0xa9, 0x62, 0x9e, 0x48, 0x1a, 0x16, 0xd3, 0x4d, 0xa4, 0x97,
-	0x64, 0xe9, 0x2f, 0x33, 0x8b, 0xf4, 0x8e, 0x98, 0xc1, 0xee, 0x7f, 0xc9, 0xb3, 0x95, 0x59, 0x57,
-	0x17, 0xf4, 0xfc, 0x87, 0x43, 0xca, 0xf7, 0x82, 0xe3, 0x63, 0x06, 0xdc, 0x33, 0x84, 0xf4, 0xc4,
-	0xbf, 0x8a, 0x75, 0x20, 0xd9, 0xeb, 0xd3, 0x0b, 0xa4, 0xda, 0xed, 0xf7, 0x43, 0x2f, 0x8a, 0xbc,
-	0x30, 0x49, 0xcb, 0x52, 0x90, 0x13, 0xd8, 0x9e, 0xda, 0x39, 0xca, 0xcc, 0xbc, 0xd7, 0xe0, 0x3e,
-	0x96, 0xc1, 0x1d, 0x00, 0x48, 0xcf, 0xa6, 0x29, 0x03, 0x75, 0x72, 0x06, 0x5a, 0xc2, 0x03, 0xc5,
-	0xaf, 0x9b, 0xd1, 0x5f, 0x97, 0x84, 0xd7, 0xe4, 0x75, 0xa9, 0x8b, 0x72, 0x70, 0x77, 0xdf, 0x57,
-	0x76, 0x62, 0xa0, 0xeb, 0x69, 0x8b, 0xae, 0x8a, 0xf9, 0x10, 0x5d, 0x2d, 0xb4, 0x91, 0xeb, 0x64,
-	0x13, 0x9a, 0x15, 0x1c, 0x42, 0x31, 0x9a, 0x55, 0x9d, 0xb8, 0x83, 0x34, 0xe7, 0xa4, 0xa0, 0x14,
-	0x85, 0x37, 0x69, 0x67, 0x6e, 0x78, 0x93, 0xee, 0x91, 0xa3, 0x62, 0xe1, 0xed, 0x9b, 0x8e, 0x0c,
-	0x6f, 0x1a, 0x57, 0x9e, 0xd0, 0x9c, 0xc1, 0x83, 0x9f, 0x35, 0x50, 0x49, 0xb3, 0x66, 0x3a, 0x2a,
-	0xbd, 0x28, 0x77, 0x24, 0x74, 0x1e, 0xe9, 0xfd, 0xb0, 0x0f, 0xd3, 0xc0, 0x94, 0x81, 0xba, 0xa0,
-	0xe3, 0xef, 0x4a, 0x64, 0xbe, 0xdd, 0xe3, 0x7b, 0x43, 0xe7, 0x49, 0xb5, 0xdb, 0x93, 0x01, 0x19,
-	0x2a, 0xce, 0x42, 0x20, 0x3e, 0x56, 0xa0, 0x51, 0xdd, 0x88, 0x12, 0x22, 0x9e, 0x04, 0xae, 0x92,
-	0x66, 0x1c, 0xfa, 0x87, 0x87, 0x5e, 0xd8, 0x41, 0xc7, 0x61, 0x1a, 0x20, 0x85, 0x6f, 0x26, 0x45,
-	0x4d, 0x74, 0x96, 0x55, 0x03, 0x90, 0x82, 0x2d, 0x4f, 0xef, 0x20, 0x11, 0xfa, 0x42, 0x29, 0x6b,
-	0x5f, 0x28, 0x37, 0x08, 0x1d, 0x1e, 0x74, 0x80, 0x1f, 0x9d, 0x81, 0x1f, 0x29, 0x2b, 0xda, 0x85,
-	0xe1, 0x41, 0x5b, 0x34, 0x7c, 0xca, 0x8f, 0x18, 0xb4, 0xbf, 0x49, 0x0e, 0x1d, 0x89, 0x41, 0x29,
-	0x21, 0x41, 0x85, 0xd2, 0x99, 0x02, 0xca, 0xd2, 0x74, 0x50, 0xce, 0x98, 0xa0, 0xcc, 0xfd, 0xe4,
-	0x32, 0x0f, 0x68, 0xce, 0x3c, 0xa0, 0x64, 0x9b, 0x5a, 0x8e, 0x27, 0xdd, 0xf6, 0xb2, 0x12, 0xc7,
-	0xfd, 0xab, 0x72, 0x66, 0x49, 0xf4, 0x3b, 0x6d, 0xbb, 0xd4, 0xa9, 0xed, 0xb0, 0x4b, 0x6d, 0x23,
-	0x3d, 0xec, 0x52, 0xe7, 0x7a, 0x0a, 0x0a, 0x05, 0x45, 0x9e, 0x22, 0x48, 0xcd, 0xe4, 0xa9, 0x9a,
-	0x56, 0x4f, 0x30, 0x7b, 0x4a, 0xac, 0x3b, 0x33, 0x9e, 0x52, 0x4e, 0x45, 0x25, 0x98, 0x17, 0xed,
-	0x6a, 0xc3, 0x48, 0x73, 0x77, 0xb5, 0xc1, 0xf1, 0x00, 0x19, 0x8b, 0xbb, 0x1f, 0x26, 0xa7, 0xa2,
-	0x30, 0xc9, 0x4f, 0x53, 0x30, 0x41, 0xb8, 0xce, 0x4d, 0x35, 0x03, 0xe6, 0xad, 0x33, 0x00, 0x0f,
-	0x76, 0x9a, 0x19, 0x90, 0x1c, 0xaa, 0xd2, 0xe8, 0xaf, 0x75, 0x42, 0xd4, 0x4b, 0xb7, 0xa6, 0x75,
-	0xff, 0xe5, 0xf5, 0xda, 0xfd, 0xfd, 0x36, 0xa9, 0xf3, 0xb2, 0xc5, 0x1b, 0xdd, 0x61, 0xf7, 0xd0,
-	0x0b, 0xe9, 0x7b, 0x0e, 0x69, 0xe2, 0xdb, 0x66, 0xf4, 0xba, 0x21, 0xa1, 0x9a, 0x6e, 0xb3, 0xb5,
-	0x36, 0x8a, 0x15, 0x85, 0x4d, 0xee, 0x8d, 0x49, 0x7b, 0x89, 0x2e, 0x88, 0x08, 0xbc, 0x2e, 0x0b,
-	0x58, 0x5f, 0xf9, 0xed, 0x9f, 0xde, 0x2f, 0x2d, 0xb9, 0xf5, 0x9d, 0x87, 0xb7, 0x77, 0xa4, 0xec,
-	0xae, 0xb3, 0x49, 0xbf, 0xef, 0x90, 0xa5, 0xcc, 0x35, 0x2e, 0xba, 0x99, 0x7d, 0x99, 0xed, 0xa6,
-	0x5b, 0xeb, 0xc6, 0x54, 0xba, 0x60, 0xdb, 0xcd, 0x49, 0x7b, 0x99, 0xd2, 0x3e, 0xb4, 0x27, 0xd6,
-	0x45, 0xdc, 0xbc, 0x05, 0xda, 0x50, 0xcd, 0x8b, 0x38, 0x5e, 0xf8, 0xea, 0x95, 0x09, 0x2f, 0xe3,
-	0x9d, 0x30, 0x13, 0x5e, 0xe6, 0x5b, 0x5c, 0x80, 0xd7, 0x31, 0x6f, 0xd4, 0xf0, 0xda, 0xcd, 0xe0,
-	0xf5, 0x1d, 0x87, 0x2c, 0x68, 0xf7, 0xb2, 0xe8, 0x86, 0x09, 0x01, 0xd3, 0xad, 0xaf, 0xd6, 0x73,
-	0x53, 0x68, 0x82, 0x55, 0x5b, 0x93, 0x36, 0xa5, 0x8b, 0x7d, 0xde, 0xaa, 0xe1, 0x44, 0x37, 0x31,
-	0x4e, 0xcc, 0xae, 0x1f, 0x25, 0xb5, 0x6d, 0x74, 0xf1, 0xeb, 0x86, 0x8d, 0x35, 0x86, 0x2b, 0x32,
-	0xad, 0x9b, 0xd3, 0x29, 0x83, 0x81, 0x2f, 0x4c, 0xda, 0x2b, 0x74, 0x19, 0x68, 0x26, 0xbf, 0xe7,
-	0xd6, 0xe3, 0x93, 0x91, 0xc7, 0x8d, 0x5c, 0x71, 0x97, 0x98, 0x91, 0xe8, 0x72, 0x0f, 0x33, 0xf4,
-	0x03, 0x47, 0xd9, 0x6b, 0x53, 0xaf, 0xab, 0xd0, 0x6d, 0x3b, 0x91, 0x4c, 0x77, 0x62, 0x5a, 0x3b,
-	0x53, 0xeb, 0x83, 0xc5, 0x2f, 0x4e, 0xda, 0x6b, 0xf4, 0x5c, 0x42, 0x3e, 0x64, 0xb3, 0x40, 0x76,
-	0x99, 0xd2, 0x8c, 0xd1, 0x11, 0xc7, 0x36, 0x7b, 0xaf, 0xc7, 0x84, 0xad, 0xf5, 0xfa, 0x91, 0x09,
-	0x5b, 0xfb, 0x55, 0x21, 0xc0, 0x16, 0x28, 0x69, 0xc0, 0x76, 0xd7, 0x8c, 0xed, 0x4f, 0x9c, 0x64,
-	0x57, 0x0a, 0x21, 0x7b, 0xd3, 0x46, 0x3b, 0x23, 0xae, 0x5b, 0x53, 0x6a, 0x83, 0xad, 0x2f, 0x4d,
-	0xda, 0xe7, 0xe8, 0x59, 0x20, 0xaa, 0x01, 0xd3, 0x73, 0x9b, 0x06, 0x4c, 0x81, 0x09, 0xcb, 0xa6,
-	0x2b, 0x2a, 0x74, 0xab, 0x88, 0x87, 0xe8, 0x5e, 0x43, 0x6b, 0x7b, 0x5a, 0x75, 0x30, 0xf8, 0xe5,
-	0x49, 0x7b, 0x95, 0xae, 0xe8, 0xc4, 0x15, 0x85, 0x6e, 0x6e, 0xf1, 0xaa, 0x7b, 0x06, 0x59, 0x2c,
-	0x9a, 0x98, 0xc9, 0x3f, 0x77, 0xd2, 0x54, 0xae, 0xdd, 0x0d, 0xa0, 0xb7, 0x8a, 0xe9, 0x88, 0x2f,
-	0x22, 0xb4, 0x6e, 0x3f, 0x42, 0x0f, 0xb0, 0xfd, 0xee, 0xa4, 0x7d, 0x9e, 0xae, 0x65, 0x29, 0x2c,
-	0x4c, 0x14, 0x80, 0xaf, 0xd0, 0x65, 0x83, 0xf9, 0x11, 0xc7, 0xdb, 0x74, 0xb5, 0xc2, 0x84, 0x77,
-	0xce, 0x3d, 0x12, 0x13, 0xde, 0x79, 0x37, 0x36, 0x00, 0x6f, 0x9d, 0xcc, 0x2a, 0xde, 0xbb, 0x36,
-	0xbc, 0x7f, 0xe6, 0xc8, 0xc4, 0xab, 0xa3, 0xbd, 0x5d, 0x44, 0x52, 0x0d, 0xeb, 0x9d, 0xa9, 0xf5,
-	0xc1, 0xea, 0x57, 0x20, 0x58, 0x60, 0x5a, 0xab, 0x38, 0xaf, 0x6d, 0x1a, 0x71, 0x66, 0x76, 0x7f,
-	0xd5, 0x21, 0x75, 0xf5, 0x42, 0x01, 0xbd, 0x6a, 0xe3, 0x28, 0x3a, 0xbd, 0xde, 0xba, 0x56, 0xa4,
-	0x06, 0xc6, 0x5d, 0x9f, 0xb4, 0x17, 0x68, 0x03, 0x28, 0x2c, 0xb6, 0xe5, 0x45, 0x06, 0x75, 0x09,
-	0x33, 0x49, 0x48, 0x98, 0x21, 0xef, 0xf1, 0x74, 0x85, 0x4e, 0xe4, 0x9b, 0xd3, 0x95, 0xe9, 0x1a,
-	0x83, 0x39, 0x5d, 0x19, 0x8f, 0xf7, 0xbb, 0x1b, 0x90, 0xae, 0x80, 0x98, 0x70, 0x54, 0x80, 0x1b,
-	0xd5, 0xa0, 0xb5, 0xd4, 0xa8, 0x88, 0x63, 0xa3, 0x9e, 0x85, 0x37, 0x61, 0x63, 0x38, 0xd9, 0x6f,
-	0xc2, 0xc6, 0x74, 0xa4, 0x1e, 0xb0, 0x01, 0xba, 0xa9, 0xd8, 0xec, 0x6a, 0xd8, 0x7c, 0xc3, 0x21,
-	0x0d, 0x74, 0x54, 0x9e, 0x5e, 0xb3, 0x91, 0x44, 0xc3, 0xe5, 0x7a, 0xa1, 0x1e, 0xd8, 0xf2, 0xdc,
-	0xa4, 0xbd, 0x48, 0x9b, 0x40, 0x22, 0x15, 0x93, 0xc5, 0x4d, 0x15, 0x13, 0x4c, 0x19, 0x38, 0x87,
-	0x6f, 0xa5, 0x0c, 0x3a, 0xb0, 0x6a, 0xa7, 0x0c, 0x3e, 0x7a, 0x89, 0x29, 0x23, 0xd6, 0xdb, 0x2a,
-	0x65, 0x84, 0x04, 0x56, 0x38, 0x8b, 0xfa, 0x29, 0x5c, 0x9a, 0xc3, 0x04, 0xed, 0xb4, 0x66, 0x6b,
-	0x73, 0x1a, 0x55, 0x30, 0x6a, 0x73, 0xd2, 0x3e, 0x43, 0x97, 0x12, 0xd6, 0x8c, 0xa0, 0x9d, 0x1b,
-	0xd6, 0xa4, 0xf5, 0xc4, 0x30, 0x66, 0x42, 0xca, 0x1b, 0x3b, 0x40, 0x86, 0x13, 0xbd, 0x76, 0xde,
-	0x18, 0x01, 0x02, 0xde, 0xa8, 0x00, 0xed, 0x6a, 0x00, 0xb1, 0x55, 0x29, 0x3e, 0x72, 0x4a, 0xad,
-	0x84, 0xd0, 0xc1, 0xd9, 0x28, 0x56, 0x44, 0xab, 0x52, 0xa0, 0x0e, 0x02, 0x66, 0x69, 0x13, 0x01,
-	0xc3, 0x4c, 0xfa, 0x12, 0x21, 0xe9, 0x09, 0x39, 0x7a, 0xd9, 0x9a, 0x10, 0xd3, 0xa3, 0x47, 0xad,
-	0x2b, 0xf9, 0x4a, 0x60, 0xc5, 0xe5, 0x49, 0xbb, 0x41, 0x6b, 0x32, 0x57, 0x8e, 0x07, 0x62, 0xfd,
-	0xd1, 0x70, 0x2b, 0x3c, 0xf2, 0x8d, 0x07, 0x1e, 0x50, 0xb7, 0x81, 0x8e, 0x4f, 0x99, 0x27, 0x52,
-	0xf6, 0x44, 0x9e, 0x79, 0x22, 0x19, 0xce, 0x61, 0xb9, 0x57, 0x60, 0x22, 0xc9, 0xbc, 0xc7, 0x1a,
-	0xb9, 0x29, 0x35, 0x5a, 0x95, 0xa6, 0x44, 0x0c, 0x86, 0xf4, 0xc8, 0x8f, 0x09, 0x86, 0xcc, 0x09,
-	0x2c, 0x13, 0x0c, 0xd9, 0x53, 0x43, 0x00, 0x83, 0x4c, 0x61, 0x09, 0x0c, 0xbb, 0x08, 0x86, 0x77,
-	0x1d, 0x52, 0x53, 0xce, 0x04, 0xd1, 0x2b, 0xd6, 0x94, 0xa3, 0x42, 0x70, 0xb5, 0x40, 0x0b, 0x2c,
-	0xb8, 0x3a, 0x69, 0x37, 0x69, 0x5d, 0xa6, 0xa3, 0x64, 0xf8, 0xcd, 0xcd, 0x74, 0xf8, 0xd2, 0x06,
-	0xe5, 0x48, 0x09, 0xb5, 0x7a, 0x59, 0x3d, 0x5d, 0xd0, 0xba, 0x5a, 0xa0, 0x85, 0x6c, 0x00, 0x32,
-	0x70, 0x35, 0x61, 0x83, 0xcb, 0x6d, 0xe0, 0x02, 0x88, 0xab, 0x4d, 0x7c, 0x52, 0x82, 0xe6, 0xf8,
-	0x19, 0x9d, 0x04, 0x68, 0x6d, 0x14, 0x2b, 0x82, 0x31, 0xd7, 0x60, 0x7e, 0x00, 0x23, 0xb8, 0xae,
-	0xc0, 0xa4, 0x4e, 0x49, 0x62, 0x4f, 0xc4, 0x11, 0x51, 0x4e, 0x29, 0x50, 0xab, 0xc3, 0x8b, 0x10,
-	0x31, 0x1c, 0x75, 0x00, 0x44, 0x80, 0x17, 0x0a, 0x22, 0xbb, 0x18, 0x11, 0x16, 0xba, 0xd4, 0x53,
-	0x0c, 0xd4, 0xea, 0x74, 0x8c, 0xc6, 0xb5, 0x22, 0x35, 0x14, 0xba, 0x80, 0x1c, 0x0a, 0x12, 0x0b,
-	0x9b, 0x0a, 0x12, 0x32, 0xe5, 0xa1, 0x3d, 0x6b, 0x6a, 0x4d, 0x1f, 0x78, 0x5f, 0xb2, 0x75, 0xbd,
-	0x50, 0x0f, 0xa5, 0x3c, 0x20, 0x09, 0x94, 0xe0, 0x45, 0xca, 0x73, 0x79, 0xca, 0x03, 0x91, 0x5e,
-	0x7b, 0x48, 0x76, 0xe2, 0xf2, 0x6a, 0x0f, 0xfa, 0x3e, 0x5f, 0x5e, 0xed, 0x21, 0xb3, 0xb5, 0xa7,
-	0xd7, 0x1e, 0x8e, 0xa4, 0x82, 0x5a, 0x7b, 0x48, 0x84, 0x1c, 0x2a, 0xb4, 0x2b, 0x49, 0xad, 0x89,
-	0xa4, 0x18, 0x2a, 0xe3, 0xf6, 0x26, 0x40, 0x05, 0xec, 0x41, 0x50, 0xed, 0xea, 0x50, 0xa5, 0x65,
-	0x87, 0x14, 0x28, 0x6b, 0x2e, 0xc9, 0xc0, 0xf4, 0xdc, 0x14, 0x9a, 0xa6, 0xb2, 0x03, 0x86, 0x08,
-	0xca, 0x0e, 0x89, 0x10, 0x13, 0x4a, 0xee, 0x8a, 0x5a, 0x09, 0x85, 0x77, 0x82, 0xec, 0x84, 0xd2,
-	0xf6, 0x68, 0x30, 0xa1, 0x60, 0xab, 0x44, 0x25, 0x14, 0x88, 0xf4, 0xa5, 0x8b, 0xdc, 0x7a, 0xc9,
-	0x5b, 0xba, 0x68, 0xdb, 0x3a, 0x79, 0x4b, 0x17, 0x7d, 0x27, 0x47, 0x5f, 0xba, 0x80, 0x15, 0x68,
-	0xe9, 0x22, 0x65, 0x0a, 0x97, 0x72, 0x50, 0x32, 0xed, 0x97, 0xd9, 0xb9, 0x64, 0x46, 0x09, 0xb8,
-	0x84, 0x50, 0xda, 0xd5, 0x51, 0x4a, 0xd7, 0x2f, 0x09, 0x46, 0xd6, 0xf5, 0x8b, 0x8e, 0xd0, 0x46,
-	0xb1, 0xa2, 0x69, 0xfd, 0x82, 0xd0, 0x81, 0xf5, 0x8b, 0x94, 0xe1, 0xc5, 0x2f, 0x14, 0x89, 0xed,
-	0x19, 0x49, 0xad, 0x6b, 0xdb, 0x17, 0xbf, 0xb8, 0x22, 0x8c, 0x17, 0xbf, 0xa2, 0x3e, 0xab, 0x2e,
-	0x7e, 0x85, 0x44, 0xff, 0x5e, 0x82, 0x5a, 0x6f, 0xde, 0xf7, 0x12, 0xae, 0x21, 0xe7, 0x7d, 0x2f,
-	0x69, 0x85, 0x63, 0xfd, 0x7b, 0x49, 0x58, 0x80, 0xbe, 0x97, 0x40, 0xa4, 0xac, 0x7b, 0xed, 0xd8,
-	0x18, 0x6a, 0xfe, 0xf6, 0x75, 0xaf, 0x11, 0x1b, 0x99, 0xc3, 0x14, 0x6c, 0x76, 0x35, 0x6c, 0xd2,
-	0xef, 0x25, 0x89, 0x8c, 0x3d, 0x3f, 0x61, 0x5c, 0xae, 0x17, 0xea, 0x99, 0xbe, 0x97, 0x54, 0x4c,
-	0xe0, 0x7b, 0x09, 0x44, 0x77, 0x9d, 0xcd, 0xff, 0x9d, 0xfd, 0x5c, 0x69, 0xf4, 0xe0, 0xc1, 0x3c,
-	0xdf, 0x3f, 0xbc, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xa1, 0xf2, 0xba, 0x96, 0x4f,
-	0x00, 0x00,
+	// 4932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x6c, 0x24, 0x49,
+	0x5a, 0x56, 0x56, 0xf9, 0x51, 0xfe, 0xab, 0xca, 0x8f, 0xf0, 0xab, 0x9c, 0xdd, 0x33, 0x53, 0x93,
+	0xdd, 0xed, 0x76, 0xbb, 0xbb, 0xed, 0x19, 0xf7, 0xec, 0xcc, 0x4e, 0xcf, 0x0e, 0x5a, 0x6f, 0xf7,
+	0xa0, 0x35, 0x4b, 0xb3, 0x23, 0xf7, 0xc0, 0x4a, 0x2b, 0xa4, 0x22, 0xbb, 0x2a, 0x6d, 0xa7, 0xb6,
+	0x5c, 0x59, 0x64, 0x66, 0xb9, 0xd7, 0x68, 0x25, 0xd4, 0x1c, 0x00, 0xf1, 0x1c, 0x79, 0xe1, 0x00,
+	0x37, 0x38, 0x20, 0xf1, 0x38, 0xec, 0x05, 0x09, 0x71, 0xe0, 0x00, 0x17, 0x4e, 0x08, 0x89, 0x0b,
+	0x12, 0x37, 0xc4, 0x01, 0x69, 0x38, 0x71, 0x01, 0x24, 0x0e, 0x28, 0x22, 0xfe, 0xc8, 0x8c, 0x88,
+	0x8c, 0x7c, 0x78, 0x5a, 0x43, 0x1b, 0x31, 0x27, 0x3b, 0x23, 0xfe, 0xc8, 0xfa, 0xe3, 0xfb, 0xbf,
+	0xff, 0x91, 0x11, 0x91, 0x09, 0x4d, 0x77, 0xe8, 0x85, 0xf1, 0xce, 0x38, 0x0c, 0xe2, 0x80, 0x2c,
+	0x7e, 0x6f, 0xf2, 0xcc, 0x8b, 0xc6, 0x27, 0x5e, 0xe8, 0xed, 0xb0, 0x76, 0xfb, 0xfa, 0x71, 0x10,
+	0x1c, 0x0f, 0xbd, 0x5d, 0x77, 0xec, 0xef, 0xba, 0xa3, 0x51, 0x10, 0xbb, 0xb1, 0x1f, 0x8c, 0x22,
+	0x2e, 0x6f, 0xbf, 0x8e, 0xbd, 0xec, 0xea, 0xd9, 0xe4, 0x68, 0xf7, 0x79, 0xe8, 0x8e, 0xc7, 0x5e,
+	0x28, 0xfa, 0xef, 0xb1, 0x3f, 0xfd, 0xfb, 0xc7, 0xde, 0xe8, 0x7e, 0xf4, 0xdc, 0x3d, 0x3e, 0xf6,
+	0xc2, 0xdd, 0x60, 0xcc, 0xee, 0x60, 0xb8, 0xdb, 0x1b, 0xfa, 0xdd, 0x62, 0xff, 0xd4, 0x8b, 0x62,
+	0xf7, 0x74, 0xcc, 0x05, 0x9c, 0x7f, 0xb6, 0xa0, 0xf1, 0xd1, 0xf7, 0xbd, 0xfe, 0x24, 0x0e, 0x42,
+	0xf2, 0x06, 0x34, 0x3d, 0xfc, 0xbf, 0xe7, 0x0f, 0x3a, 0x56, 0xd7, 0xda, 0x9a, 0x3b, 0x04, 0xd1,
+	0x74, 0x30, 0x20, 0x37, 0xa0, 0x9d, 0x08, 0x8c, 0xdc, 0x53, 0xaf, 0x53, 0x63, 0x22, 0x2d, 0xd1,
+	0xf8, 0x53, 0xee, 0xa9, 0x47, 0xd6, 0x60, 0x26, 0x8a, 0xdd, 0x78, 0x12, 0x75, 0xea, 0xac, 0x17,
+	0xaf, 0xc8, 0x07, 0xd0, 0xec, 0x87, 0x9e, 0x1b, 0x7b, 0x3d, 0xaa, 0x44, 0x67, 0xaa, 0x6b, 0x6d,
+	0x35, 0xf7, 0xec, 0x1d, 0xae, 0xe1, 0x8e, 0xd0, 0x70, 0xe7, 0x13, 0xa1, 0xe1, 0x21, 0x70, 0x71,
+	0xda, 0x40, 0x07, 0x4f, 0xc6, 0x83, 0x64, 0xf0, 0x74, 0xf9, 0x60, 0x2e, 0x4e, 0x1b, 0x9c, 0xaf,
+	0xc1, 0xea, 0x23, 0x76, 0x2b, 0x31, 0xd3, 0x43, 0xef, 0xe7, 0x27, 0x5e, 0x14, 0x67, 0xe7, 0x63,
+	0x65, 0xe7, 0xe3, 0xbc, 0x0f, 0x6b, 0xfa, 0xe8, 0x68, 0x1c, 0x8c, 0x22, 0xaf, 0x14, 0x2f, 0xe7,
+	0xbf, 0x2d, 0xe8, 0x3c, 0xf6, 0xa2, 0x7e, 0xe8, 0x3f, 0x4b, 0x46, 0x47, 0xe2, 0xc7, 0xdf, 0x80,
+	0x66, 0xe4, 0xb9, 0x61, 0xff, 0xa4, 0xf7, 0x3c, 0x08, 0x93, 0xd1, 0xbc, 0xe9, 0x3b, 0x41, 0x38,
+	0x20, 0x1b, 0xd0, 0x88, 0x82, 0x30, 0xee, 0x7d, 0xcf, 0x3b, 0x47, 0xa0, 0x67, 0xe9, 0xf5, 0xb7,
+	0xbc, 0x73, 0xd2, 0x81, 0xd9, 0xd0, 0x3b, 0xf3, 0xc2, 0xc8, 0x63, 0x20, 0x37, 0x0e, 0xc5, 0x25,
+	0x45, 0x3f, 0x38, 0x3a, 0x8a, 0xbc, 0x98, 0x01, 0xdc, 0x3e, 0xc4, 0x2b, 0xb2, 0x02, 0xd3, 0x43,
+	0xff, 0xd4, 0x8f, 0x19, 0x74, 0xed, 0x43, 0x7e, 0xa1, 0xcf, 0x60, 0xa6, 0x5b, 0x2f, 0xb3, 0xf8,
+	0x6c, 0xb7, 0xae, 0x23, 0x24, 0x59, 0xbc, 0xc1, 0x7a, 0xf1, 0xca, 0x19, 0xc3, 0x86, 0x61, 0xf6,
+	0x08, 0xde, 0x0a, 0x4c, 0xc7, 0x41, 0xec, 0x0e, 0xd9, 0xc4, 0xdb, 0x87, 0xfc, 0x82, 0x7c, 0x08,
+	0xc9, 0xad, 0x7b, 0x74, 0x12, 0xb5, 0x6e, 0x9d, 0x19, 0x5a, 0xf7, 0xa2, 0x9d, 0xc4, 0x18, 0xc9,
+	0x04, 0x9e, 0x7a, 0xb1, 0x33, 0x81, 0xd5, 0x27, 0xc1, 0xc0, 0x3f, 0x3a, 0xd7, 0x2d, 0xfd, 0x85,
+	0x52, 0x9b, 0x52, 0x44, 0xff, 0xd9, 0xaa, 0x14, 0x79, 0x1f, 0xd6, 0x1e, 0x7b, 0x43, 0x2f, 0x36,
+	0xf2, 0x43, 0x1d, 0xaa, 0xd9, 0xc6, 0x79, 0x08, 0xeb, 0x99, 0xa1, 0x79, 0x3f, 0xab, 0x8f, 0xfd,
+	0x37, 0x0b, 0x5a, 0x87, 0x5e, 0x14, 0x4c, 0xc2, 0xbe, 0xf7, 0xc9, 0xf9, 0xd8, 0x23, 0xd7, 0x01,
+	0xc2, 0xa8, 0x17, 0x9f, 0x8f, 0xbd, 0x54, 0xcf, 0x46, 0x18, 0xd1, 0xbe, 0x83, 0x01, 0xe9, 0x42,
+	0x4b, 0xf4, 0x4a, 0xe0, 0x00, 0xef, 0x67, 0xd0, 0x38, 0xd0, 0x16, 0x12, 0x63, 0x37, 0x74, 0x4f,
+	0x11, 0xa1, 0x26, 0x17, 0xf9, 0x98, 0x36, 0xbd, 0xc2, 0x08, 0xe0, 0xc2, 0x06, 0xf7, 0x61, 0x79,
+	0xce, 0x02, 0x68, 0x7d, 0x72, 0x56, 0xf9, 0xe4, 0x6a, 0x99, 0xc9, 0x39, 0x0f, 0xc1, 0x36, 0xfd,
+	0x04, 0x1a, 0xa4, 0x10, 0x5e, 0x1a, 0x85, 0xaf, 0x0b, 0x4f, 0x91, 0x87, 0x5f, 0xa9, 0x58, 0xa1,
+	0x4e, 0x81, 0x87, 0x8a, 0x7c, 0x86, 0xf0, 0x38, 0x21, 0x81, 0xe8, 0xbc, 0xb0, 0xe0, 0xb5, 0x9c,
+	0x49, 0x16, 0x86, 0x84, 0x9f, 0x80, 0xa5, 0x10, 0xc5, 0xf9, 0xfd, 0xd3, 0xb8, 0xf0, 0x7a, 0x36,
+	0x2e, 0x28, 0xe8, 0x2f, 0x84, 0xd2, 0x15, 0x8d, 0x0f, 0xbf, 0x08, 0x1b, 0xdc, 0x51, 0x4d, 0x3c,
+	0xf8, 0x5f, 0x70, 0x01, 0xca, 0x12, 0x93, 0x02, 0x95, 0x58, 0xf2, 0x10, 0x6c, 0xee, 0xef, 0x46,
+	0x8a, 0xe8, 0x63, 0x15, 0xf3, 0x38, 0x1f, 0xc0, 0x35, 0xe3, 0xd8, 0x9c, 0x1f, 0x56, 0x07, 0xff,
+	0xa8, 0x06, 0xf3, 0x62, 0xdc, 0x8f, 0xfb, 0xc3, 0xd8, 0x0b, 0x11, 0x8d, 0x23, 0x76, 0x21, 0x05,
+	0xb6, 0x30, 0xe2, 0xfd, 0x07, 0x03, 0x72, 0x13, 0xe6, 0x53, 0x09, 0x39, 0xa2, 0x0a, 0x19, 0x86,
+	0xd9, 0x26, 0x2c, 0xa4, 0x52, 0x32, 0x6a, 0x6d, 0x21, 0xc6, 0x43, 0x47, 0x1a, 0x79, 0xa7, 0x8a,
+	0x8a, 0x8a, 0xe9, 0x97, 0x09, 0x29, 0x33, 0x97, 0x09, 0x29, 0x1a, 0x64, 0xb3, 0x9a, 0xad, 0xfe,
+	0xc0, 0x82, 0x6b, 0x6a, 0x38, 0xe0, 0xb3, 0x11, 0xd6, 0xca, 0xa2, 0x63, 0x55, 0x43, 0xa7, 0x56,
+	0x8c, 0x8e, 0x5a, 0x72, 0xa9, 0x3a, 0x4e, 0x69, 0x3a, 0x7e, 0x1d, 0xae, 0x9b, 0x55, 0x44, 0x52,
+	0x94, 0xda, 0xd8, 0xf9, 0xc3, 0x1a, 0xbc, 0xae, 0xbb, 0x34, 0xef, 0xbc, 0x52, 0x91, 0x4b, 0x9f,
+	0xc8, 0x8c, 0x88, 0x4d, 0x05, 0x64, 0xc5, 0x3a, 0x47, 0x31, 0x47, 0x4e, 0x9d, 0xa3, 0xc1, 0x3c,
+	0xa7, 0x79, 0xcf, 0xaf, 0x5a, 0xf0, 0x46, 0x2e, 0x48, 0x85, 0x91, 0xef, 0xdb, 0x40, 0x44, 0x00,
+	0x43, 0xd5, 0xd2, 0xd0, 0xd7, 0xcd, 0x0f, 0x7d, 0x68, 0xc6, 0x25, 0x75, 0x2c, 0x0d, 0x7f, 0x7f,
+	0x63, 0xc1, 0x35, 0x35, 0xfc, 0xa8, 0xac, 0xbc, 0x2a, 0x5e, 0xad, 0x02, 0x3a, 0x9d, 0xe5, 0xad,
+	0x79, 0x12, 0x95, 0x79, 0xfb, 0x75, 0xb8, 0xae, 0x46, 0x43, 0x8d, 0xb4, 0xd9, 0x3b, 0x68, 0x84,
+	0x71, 0xf6, 0xe1, 0xb5, 0x9c, 0x3b, 0xe4, 0x2a, 0xa1, 0xdf, 0xe2, 0xf7, 0x6a, 0x30, 0xf3, 0xc4,
+	0x8b, 0x43, 0xbf, 0x4f, 0xae, 0xc1, 0xdc, 0x29, 0xfb, 0x4f, 0x0a, 0xfb, 0xbc, 0xe1, 0x60, 0x40,
+	0x3d, 0x08, 0x3b, 0xe5, 0xbc, 0xc3, 0x9b, 0x18, 0xda, 0x6f, 0x42, 0x0b, 0x05, 0x94, 0xb4, 0xc3,
+	0xdb, 0xfe, 0x6f, 0x86, 0xcf, 0xdf, 0xb6, 0x60, 0x99, 0xc7, 0x26, 0x8e, 0x90, 0x14, 0x4d, 0x64,
+	0x2c, 0xac, 0x52, 0x2c, 0x6a, 0x45, 0x58, 0x5c, 0x26, 0x58, 0x3e, 0x80, 0x15, 0x55, 0x21, 0xb4,
+	0x73, 0x91, 0xe9, 0x9c, 0x4f, 0x6b, 0xb0, 0x26, 0x5c, 0x9f, 0x8f, 0xbb, 0x52, 0x71, 0x51, 0xd1,
+	0x1d, 0x0b, 0xba, 0x3c, 0xda, 0x61, 0x3d, 0x27, 0x41, 0xfd, 0xf9, 0xa2, 0xe1, 0x09, 0xac, 0x67,
+	0x10, 0x29, 0x0c, 0x82, 0xef, 0x01, 0xfe, 0xa8, 0x14, 0xfc, 0x3a, 0xd9, 0xe0, 0x87, 0x66, 0xc1,
+	0x09, 0xd1, 0x60, 0xf7, 0x67, 0x16, 0x2c, 0xf3, 0x38, 0xa1, 0x72, 0xe8, 0x95, 0x39, 0x5b, 0x71,
+	0x54, 0x7b, 0x00, 0x2b, 0xaa, 0xb6, 0x55, 0x08, 0xf6, 0x00, 0x56, 0x78, 0x18, 0xd2, 0xd8, 0xa5,
+	0x0d, 0x52, 0x2c, 0xeb, 0xbc, 0x03, 0xab, 0xda, 0x20, 0xf3, 0x4f, 0xa9, 0xa3, 0xfe, 0xb6, 0x0e,
+	0x33, 0x1f, 0x07, 0x43, 0xbf, 0x7f, 0x4e, 0xe5, 0xc6, 0xec, 0x3f, 0x49, 0x25, 0xde, 0xc0, 0x11,
+	0xc4, 0x4e, 0x19, 0x41, 0xde, 0xc4, 0x10, 0xbc, 0x0f, 0x04, 0x05, 0x06, 0x8c, 0x08, 0x6c, 0xf1,
+	0x0a, 0x71, 0x5c, 0xe2, 0x3d, 0x8f, 0xd3, 0x0e, 0xfa, 0x60, 0x8e, 0xe2, 0xfd, 0x60, 0x74, 0xe4,
+	0x1f, 0x23, 0xa8, 0x2d, 0xde, 0xf8, 0x88, 0xb5, 0x51, 0x8f, 0x60, 0x81, 0x29, 0x08, 0x11, 0x57,
+	0x71, 0x49, 0xde, 0x82, 0x15, 0xf7, 0xcc, 0xf5, 0x87, 0xee, 0xb3, 0xa1, 0xd7, 0x8b, 0x62, 0x37,
+	0x8c, 0xd3, 0x68, 0x35, 0x77, 0x48, 0x92, 0xbe, 0xa7, 0xb4, 0x8b, 0x45, 0xa6, 0x7b, 0x90, 0xb6,
+	0xf6, 0xbc, 0xd1, 0x80, 0xcb, 0xf3, 0x08, 0xb5, 0x98, 0xf4, 0x7c, 0x34, 0x1a, 0x88, 0x20, 0x28,
+	0x47, 0xd0, 0xc6, 0xcb, 0x44, 0xd0, 0xb9, 0x97, 0x88, 0xa0, 0xa0, 0x3d, 0xae, 0xd8, 0xd0, 0x18,
+	0xba, 0xa3, 0xe3, 0x89, 0x7b, 0xec, 0x75, 0x9a, 0xbc, 0x4f, 0x5c, 0x3b, 0x7f, 0x55, 0x13, 0xd1,
+	0x95, 0x1b, 0x54, 0x8a, 0x49, 0xb2, 0xe9, 0xac, 0x8a, 0xa6, 0xab, 0x55, 0x36, 0x5d, 0xbd, 0xd8,
+	0x74, 0x53, 0xd5, 0x4c, 0x37, 0x7d, 0x49, 0xd3, 0xcd, 0xe4, 0x98, 0xae, 0x30, 0x05, 0x29, 0x00,
+	0x36, 0x34, 0x00, 0x93, 0x64, 0x20, 0xf0, 0x4b, 0x1d, 0x28, 0xd7, 0x31, 0x9c, 0xbf, 0xae, 0xa5,
+	0xa1, 0x8f, 0x8d, 0xf3, 0xbd, 0xab, 0x96, 0x0d, 0x52, 0xe5, 0x31, 0x1b, 0xe4, 0x79, 0x35, 0x66,
+	0x83, 0x52, 0x6a, 0xf0, 0xcc, 0x60, 0xa0, 0x86, 0x64, 0x75, 0x9e, 0x21, 0xc4, 0x65, 0x86, 0xd6,
+	0x6a, 0xfa, 0xf0, 0xa1, 0x93, 0xc5, 0xb0, 0x2c, 0x7f, 0xa0, 0x62, 0x85, 0xf9, 0x03, 0x2d, 0x89,
+	0x10, 0xd0, 0xfc, 0xf1, 0x4f, 0x35, 0x91, 0x3f, 0x54, 0x2f, 0xf9, 0x32, 0xfa, 0xe5, 0xb9, 0x50,
+	0xa3, 0xc0, 0x85, 0xe6, 0xb2, 0x2e, 0xa4, 0x82, 0x5b, 0xc5, 0x85, 0x92, 0xcc, 0xa5, 0xfb, 0x8f,
+	0x36, 0x4a, 0xe1, 0xae, 0xf3, 0x15, 0x58, 0xd3, 0x47, 0x99, 0x7f, 0x4c, 0x1d, 0xf6, 0x2b, 0x0d,
+	0x98, 0x3a, 0x9c, 0x0c, 0x3d, 0xb2, 0x0e, 0xb3, 0xe1, 0x64, 0x28, 0x2d, 0xc9, 0xcc, 0xd0, 0xcb,
+	0x83, 0x01, 0x1d, 0xce, 0x3a, 0x24, 0x53, 0x37, 0x68, 0x03, 0x33, 0xb4, 0x0d, 0x8d, 0x81, 0x1f,
+	0x51, 0xb0, 0x06, 0xe8, 0x97, 0xc9, 0x35, 0xb9, 0x0d, 0x0b, 0xa7, 0xc1, 0xc8, 0xa7, 0xab, 0xb3,
+	0x63, 0x2f, 0xf4, 0x83, 0x41, 0x84, 0x1e, 0x3a, 0x8f, 0xcd, 0x1f, 0xf3, 0x56, 0x7a, 0x93, 0x88,
+	0x3a, 0xb3, 0x1f, 0x9f, 0x8b, 0x82, 0x41, 0x5c, 0xa7, 0x95, 0x08, 0x37, 0x40, 0x67, 0x46, 0xae,
+	0x44, 0x98, 0x09, 0xc8, 0x2d, 0x98, 0xef, 0x07, 0xa3, 0x81, 0x4f, 0xa9, 0xc4, 0x85, 0xb8, 0x21,
+	0xdb, 0x49, 0x2b, 0x13, 0x7b, 0x1d, 0x20, 0x3e, 0x09, 0xbd, 0xe8, 0x24, 0x18, 0x0e, 0x22, 0xb4,
+	0xa2, 0xd4, 0x42, 0x08, 0x4c, 0x4d, 0x46, 0x7e, 0x8c, 0x36, 0x64, 0xff, 0x93, 0xbb, 0xb0, 0xd4,
+	0xa7, 0x18, 0xf6, 0x27, 0xb1, 0x7f, 0xe6, 0xf5, 0xfa, 0xc1, 0x64, 0x14, 0xb3, 0x24, 0xd4, 0x3e,
+	0x5c, 0x94, 0x3a, 0x1e, 0xd1, 0x76, 0x4a, 0x50, 0x7f, 0x74, 0xe2, 0x3f, 0xf3, 0x63, 0x96, 0x8b,
+	0x1a, 0x87, 0xe2, 0x52, 0x4f, 0x9f, 0xad, 0x97, 0x49, 0x9f, 0xed, 0x4b, 0xa5, 0x4f, 0xc5, 0xf6,
+	0xf3, 0x9a, 0x1b, 0x2b, 0x95, 0xd0, 0x82, 0x56, 0x23, 0x76, 0xa1, 0xe9, 0x1e, 0x1f, 0x87, 0xde,
+	0x31, 0xdb, 0x6a, 0xeb, 0x2c, 0x72, 0xdc, 0xa5, 0x26, 0xb2, 0x05, 0x8b, 0xa3, 0xa0, 0x37, 0x70,
+	0x63, 0xb7, 0xf7, 0xcc, 0x3b, 0x71, 0xcf, 0xfc, 0x20, 0xec, 0x2c, 0x31, 0xb1, 0xf9, 0x51, 0xf0,
+	0xd8, 0x8d, 0xdd, 0x6f, 0x60, 0x2b, 0xd9, 0x85, 0xe5, 0xd0, 0xeb, 0x07, 0x67, 0x5e, 0x78, 0xde,
+	0x93, 0x6c, 0x40, 0xb8, 0x7f, 0x8a, 0xae, 0x4f, 0x52, 0x5b, 0x7c, 0x0d, 0x6c, 0x19, 0xf7, 0x64,
+	0x30, 0x37, 0xc0, 0x32, 0x33, 0x40, 0x47, 0x92, 0x38, 0x44, 0x01, 0x6e, 0x88, 0x35, 0x98, 0x19,
+	0x7a, 0x67, 0xde, 0x30, 0xea, 0xac, 0x70, 0x26, 0xf3, 0x2b, 0x72, 0x07, 0x16, 0x8f, 0x82, 0xd0,
+	0xeb, 0xbb, 0x51, 0xdc, 0x3b, 0x09, 0x42, 0xff, 0x17, 0x82, 0x51, 0x67, 0x95, 0xdd, 0x6b, 0x41,
+	0xb4, 0x7f, 0x93, 0x37, 0x53, 0x8d, 0xbd, 0x33, 0x77, 0x38, 0x61, 0x33, 0xed, 0xf9, 0xa3, 0xd8,
+	0x0b, 0xcf, 0xdc, 0x61, 0x67, 0x8d, 0x49, 0x93, 0xb4, 0xeb, 0x00, 0x7b, 0x28, 0xd9, 0x8f, 0xc3,
+	0x60, 0x32, 0xee, 0x25, 0xa4, 0xeb, 0xac, 0x73, 0x2c, 0x58, 0xf3, 0x23, 0xd1, 0x4a, 0x29, 0x85,
+	0xa0, 0x7b, 0xdf, 0x1f, 0x87, 0x5e, 0x14, 0x51, 0xd1, 0x0e, 0x8f, 0x3c, 0xbc, 0xe3, 0xa3, 0xa4,
+	0x9d, 0xc6, 0xd1, 0x04, 0xaf, 0x5e, 0xd4, 0x3f, 0xf1, 0x06, 0x93, 0xa1, 0xd7, 0xd9, 0xe0, 0x71,
+	0x34, 0xe9, 0x79, 0x8a, 0x1d, 0x34, 0x8e, 0xf2, 0xe4, 0xd7, 0x7b, 0xee, 0x8f, 0x06, 0xc1, 0xf3,
+	0x8e, 0xcd, 0xf4, 0x6d, 0xf1, 0xc6, 0xef, 0xb0, 0x36, 0x56, 0xb8, 0xb3, 0xc8, 0xdb, 0xb9, 0x86,
+	0x85, 0x3b, 0xbb, 0x72, 0x5e, 0xcc, 0xc2, 0x12, 0xae, 0x94, 0x4d, 0x86, 0x9e, 0x14, 0x73, 0x52,
+	0xef, 0xb7, 0x0a, 0xbc, 0xbf, 0x56, 0xee, 0xfd, 0xf5, 0x52, 0xef, 0x9f, 0x2a, 0xf1, 0xfe, 0xe9,
+	0x2a, 0xde, 0x3f, 0x53, 0xee, 0xfd, 0xb3, 0xb9, 0xde, 0xdf, 0x28, 0xf3, 0xfe, 0xb9, 0x72, 0xef,
+	0x07, 0xd5, 0xfb, 0x15, 0x1f, 0x6c, 0x16, 0xf9, 0x60, 0xab, 0xd8, 0x07, 0xdb, 0xd5, 0x7c, 0x70,
+	0xfe, 0x32, 0x3e, 0xb8, 0xf0, 0x39, 0x7d, 0x70, 0xb1, 0xb2, 0x0f, 0x2e, 0x95, 0xfa, 0x20, 0xb9,
+	0x94, 0x0f, 0x2e, 0x5f, 0xc6, 0x07, 0x57, 0xaa, 0xfb, 0xe0, 0xea, 0xa5, 0x7c, 0x70, 0xad, 0xb2,
+	0x0f, 0xae, 0x17, 0xfa, 0x60, 0x47, 0xf1, 0xc1, 0xfb, 0x40, 0x64, 0x17, 0xc4, 0x04, 0x9e, 0x97,
+	0x9a, 0x9d, 0xcf, 0xa6, 0xe9, 0x93, 0x31, 0x2e, 0xba, 0x4e, 0x86, 0x57, 0xab, 0xd2, 0x96, 0xb4,
+	0xe6, 0x75, 0xb6, 0xb1, 0xa0, 0x98, 0xed, 0xd6, 0x73, 0x43, 0x0a, 0xad, 0xab, 0x4b, 0x42, 0x0a,
+	0x2d, 0xab, 0x8b, 0x43, 0x0a, 0xd6, 0xd6, 0xb9, 0x21, 0xa5, 0xd9, 0xad, 0x97, 0x87, 0x94, 0x56,
+	0xb7, 0x5e, 0x16, 0x52, 0xda, 0x4c, 0xc4, 0x14, 0x52, 0xe6, 0x59, 0x4f, 0x41, 0x48, 0x59, 0xe8,
+	0xd6, 0xcb, 0x42, 0xca, 0x22, 0x83, 0xc2, 0x1c, 0x52, 0x96, 0xba, 0xf5, 0xfc, 0x90, 0x42, 0xba,
+	0xf5, 0xa2, 0x90, 0xb2, 0xcc, 0x67, 0x5f, 0x16, 0x52, 0x56, 0xba, 0xf5, 0xea, 0x21, 0x65, 0xb5,
+	0x5b, 0xff, 0x5c, 0x21, 0x65, 0xad, 0x5b, 0x2f, 0x0a, 0x29, 0xce, 0xcf, 0xc1, 0xaa, 0x46, 0xf6,
+	0xc2, 0x47, 0xa2, 0xb7, 0x81, 0xb1, 0x4a, 0x7a, 0x20, 0x5a, 0x33, 0xec, 0x26, 0x50, 0x3f, 0x63,
+	0x3c, 0xa5, 0x0f, 0x43, 0x7f, 0xdf, 0x80, 0x25, 0x5c, 0x74, 0x97, 0x52, 0xe0, 0x97, 0x95, 0xf1,
+	0x17, 0x57, 0x19, 0x6b, 0x74, 0x6c, 0x55, 0xcb, 0x70, 0x6d, 0x63, 0x86, 0x7b, 0x62, 0xa6, 0xe3,
+	0x3c, 0x2b, 0x98, 0xaf, 0x67, 0x0a, 0xe6, 0xa7, 0x71, 0xe8, 0x8f, 0x8e, 0x7f, 0xc6, 0x1d, 0x4e,
+	0x3c, 0x23, 0x59, 0xbf, 0x5b, 0x48, 0xd6, 0x85, 0x9c, 0xbb, 0xfe, 0xf4, 0xc1, 0x28, 0x7e, 0xb0,
+	0xc7, 0xef, 0x9a, 0x9f, 0x1d, 0xdf, 0x49, 0xb2, 0xe3, 0x62, 0x05, 0xed, 0x8a, 0x72, 0xe7, 0x92,
+	0x39, 0x77, 0x3e, 0x31, 0xe7, 0x4e, 0x52, 0x41, 0x6b, 0x53, 0x66, 0xfd, 0x28, 0x9b, 0x59, 0x97,
+	0x2b, 0x28, 0xae, 0xe7, 0xdd, 0x03, 0x53, 0xde, 0x5d, 0xa9, 0x70, 0xa3, 0x6c, 0x56, 0xfe, 0x96,
+	0x31, 0x2b, 0xaf, 0x56, 0xb8, 0x57, 0x95, 0x9c, 0xbd, 0x66, 0xc8, 0xd9, 0xef, 0x24, 0x39, 0x7b,
+	0xbd, 0x8a, 0xcd, 0xd2, 0x8c, 0x2e, 0x47, 0x94, 0xb2, 0x8c, 0x7e, 0x1f, 0x08, 0xee, 0xb8, 0xc9,
+	0xe9, 0x5c, 0x11, 0x97, 0x52, 0xa9, 0xb3, 0x03, 0xcb, 0x8a, 0xb8, 0xe9, 0xf6, 0xb2, 0xfc, 0x8b,
+	0x3a, 0x4c, 0xef, 0x0f, 0xbd, 0x30, 0xa6, 0x05, 0x00, 0x8b, 0x80, 0xa9, 0x0a, 0xb3, 0xec, 0xfa,
+	0x60, 0x40, 0x5e, 0x03, 0xe0, 0x5d, 0x52, 0x5c, 0x9b, 0x63, 0x2d, 0xa5, 0x81, 0xed, 0x16, 0xcc,
+	0x87, 0x93, 0xd1, 0xc8, 0x1f, 0x1d, 0xf7, 0x94, 0xcd, 0x81, 0x36, 0xb6, 0x3e, 0x65, 0x8d, 0x34,
+	0x74, 0xf1, 0x5f, 0x40, 0x21, 0x2c, 0xeb, 0x59, 0xdb, 0x53, 0xe3, 0x9e, 0xdd, 0xcc, 0xcb, 0x3c,
+	0x32, 0xcf, 0x7e, 0xfe, 0x47, 0xe6, 0x86, 0x56, 0xae, 0xeb, 0x1b, 0x9e, 0x73, 0x99, 0xbd, 0x63,
+	0xed, 0x50, 0x1a, 0x64, 0xce, 0xc2, 0xfd, 0x96, 0x25, 0x8a, 0x3c, 0x66, 0x09, 0x61, 0x63, 0x15,
+	0x75, 0xab, 0x08, 0x75, 0xfd, 0x51, 0x4b, 0xd1, 0xb8, 0x5e, 0xa2, 0xf1, 0x54, 0x66, 0x9f, 0xf8,
+	0x2d, 0x58, 0x56, 0xf4, 0x41, 0x12, 0xe5, 0x33, 0xc4, 0xf9, 0xcf, 0x5a, 0x9a, 0x8a, 0xd9, 0xa0,
+	0x2b, 0x55, 0x78, 0xca, 0x8a, 0xf3, 0xca, 0x33, 0x87, 0xda, 0xbc, 0xf6, 0xcc, 0x01, 0x59, 0x2f,
+	0x3e, 0xb3, 0xd4, 0xe6, 0x4b, 0xba, 0x1a, 0xb5, 0x15, 0x5b, 0x40, 0xb7, 0x5e, 0x68, 0x8b, 0x66,
+	0xb7, 0x5e, 0xcc, 0x9e, 0x56, 0xe6, 0x48, 0xe3, 0x00, 0xd6, 0x74, 0xe4, 0x0b, 0xab, 0xa0, 0x77,
+	0x60, 0x0e, 0x5d, 0x2d, 0x29, 0x83, 0xd6, 0xb3, 0x65, 0x10, 0xb7, 0x3c, 0x87, 0x8d, 0x16, 0x42,
+	0x7f, 0x6c, 0x89, 0xb0, 0xa5, 0x70, 0xf4, 0x8b, 0x09, 0x1a, 0x0a, 0x64, 0x53, 0x25, 0xf4, 0x9d,
+	0x36, 0xd1, 0x57, 0x51, 0xb5, 0x9c, 0xbe, 0x6f, 0x89, 0xa8, 0xa9, 0x72, 0x57, 0x1d, 0x21, 0xf3,
+	0xc6, 0x79, 0x1b, 0x56, 0xd4, 0x11, 0xc6, 0x1f, 0x51, 0x86, 0xfc, 0x47, 0x0d, 0x66, 0xbf, 0xe9,
+	0x47, 0x71, 0x10, 0x9e, 0x53, 0x70, 0x4e, 0xf8, 0xbf, 0xa9, 0x36, 0x73, 0xd8, 0x72, 0x30, 0xa0,
+	0xe1, 0x50, 0x74, 0x4b, 0xe8, 0x35, 0xb1, 0x8d, 0xe1, 0xb7, 0x02, 0xd3, 0xde, 0x99, 0x37, 0x8a,
+	0xd1, 0xbd, 0xf9, 0x05, 0x5b, 0x12, 0x0f, 0x46, 0x31, 0x6d, 0x17, 0xbb, 0x4a, 0xfc, 0x92, 0x56,
+	0x98, 0xa3, 0x20, 0xf6, 0x8f, 0xfc, 0x3e, 0x56, 0x00, 0x02, 0xb9, 0x79, 0xb9, 0xf9, 0x60, 0xf0,
+	0x0a, 0xe3, 0xac, 0x8c, 0x5d, 0x43, 0x25, 0x93, 0x94, 0xbf, 0xe6, 0x94, 0x8a, 0xfb, 0x06, 0xb4,
+	0x93, 0x53, 0x92, 0x0c, 0x2a, 0xc0, 0x73, 0x39, 0xd8, 0xc8, 0x8e, 0x60, 0x7e, 0x66, 0x89, 0x8d,
+	0x2b, 0xc4, 0x5f, 0x18, 0x58, 0xc7, 0xd9, 0x2a, 0xc0, 0xb9, 0x96, 0x83, 0x73, 0xbd, 0x14, 0xe7,
+	0x29, 0x23, 0xce, 0xf2, 0x6c, 0xa7, 0x73, 0x67, 0x3b, 0x53, 0x3c, 0xdb, 0x59, 0xc3, 0x6c, 0xdf,
+	0x85, 0x55, 0x6d, 0xb2, 0xc8, 0xcd, 0x62, 0xd2, 0x39, 0x17, 0xf5, 0x74, 0x93, 0x89, 0x0f, 0xbd,
+	0x62, 0x3b, 0x75, 0xaa, 0xfe, 0x3c, 0x90, 0x17, 0x38, 0x0d, 0x0f, 0xe6, 0x66, 0x63, 0xf2, 0x0d,
+	0xba, 0xac, 0x31, 0xc5, 0xa6, 0x5c, 0xbe, 0x31, 0x41, 0x3c, 0xf8, 0xe6, 0x1a, 0xb3, 0xd9, 0xad,
+	0xe7, 0x18, 0xb3, 0xd5, 0xad, 0x17, 0x19, 0xb3, 0x8d, 0x67, 0xef, 0x64, 0x63, 0x9e, 0xc2, 0x86,
+	0xc1, 0x26, 0x85, 0x01, 0xfe, 0x21, 0x88, 0x39, 0x4b, 0x21, 0x7e, 0x23, 0x1b, 0xe2, 0x05, 0x3d,
+	0x04, 0xa8, 0x34, 0xcc, 0xff, 0x5a, 0x4d, 0xec, 0x4f, 0x69, 0x9e, 0x72, 0x85, 0x03, 0x96, 0x9a,
+	0xdd, 0xf3, 0x1c, 0x69, 0xb6, 0xd8, 0x91, 0x1a, 0x66, 0x47, 0xd2, 0xb0, 0xa8, 0xe6, 0x48, 0xef,
+	0x89, 0x8d, 0xb7, 0x8c, 0x17, 0xe9, 0x03, 0x55, 0x06, 0x3b, 0x5f, 0x85, 0xf5, 0xcc, 0xc0, 0x9c,
+	0x9f, 0xd4, 0x46, 0xfe, 0x97, 0x05, 0xb3, 0x8f, 0x82, 0xd3, 0x53, 0x0a, 0xdc, 0x6b, 0x00, 0x7d,
+	0xfe, 0xaf, 0xa4, 0x1d, 0xb6, 0x1c, 0x0c, 0xc8, 0x75, 0x98, 0x73, 0x07, 0x83, 0xd0, 0x8b, 0x22,
+	0x2f, 0x4c, 0xd2, 0xb2, 0x68, 0x28, 0x08, 0x6c, 0xaf, 0xec, 0x2d, 0x86, 0x8c, 0xdf, 0x6b, 0x70,
+	0x9f, 0x8a, 0xe0, 0x8e, 0x00, 0xa4, 0x27, 0xc3, 0xa5, 0x89, 0x5a, 0x05, 0x13, 0xad, 0xa9, 0x13,
+	0x55, 0x7f, 0xae, 0xae, 0xff, 0x5c, 0x12, 0x5e, 0x93, 0x9f, 0x4b, 0x4d, 0x54, 0x80, 0xbb, 0xf3,
+	0x43, 0xe9, 0x1c, 0x04, 0x0e, 0xbd, 0x6a, 0xd1, 0x55, 0x52, 0x1f, 0xa3, 0x6b, 0x0e, 0x6d, 0x44,
+	0x9d, 0x6c, 0x42, 0xb3, 0xd1, 0xad, 0xe7, 0xa3, 0x39, 0xa7, 0x13, 0x77, 0x08, 0x9d, 0x2c, 0x28,
+	0x65, 0xe1, 0x4d, 0xe8, 0x59, 0x18, 0xde, 0x84, 0x79, 0xc4, 0xac, 0x68, 0x78, 0xfb, 0x0d, 0x4b,
+	0x84, 0x37, 0x8d, 0x2b, 0x5f, 0x90, 0xcf, 0xa8, 0x93, 0x9f, 0x32, 0x50, 0x49, 0xd3, 0xa6, 0x1a,
+	0x95, 0xde, 0x15, 0xe7, 0x01, 0x74, 0x1e, 0xe9, 0xe3, 0x54, 0x1b, 0xa6, 0x81, 0x29, 0x03, 0x75,
+	0xc9, 0xc0, 0x7f, 0xac, 0xc1, 0xcc, 0x7e, 0x9f, 0xad, 0xd8, 0x5c, 0x83, 0x39, 0xb7, 0x2f, 0x02,
+	0x32, 0x6e, 0xff, 0xf1, 0x06, 0xfe, 0xb0, 0x82, 0x9d, 0xf2, 0x31, 0x10, 0xde, 0xc4, 0x92, 0xc0,
+	0x2d, 0x98, 0x8f, 0x43, 0x9f, 0xbe, 0xbd, 0xd9, 0x53, 0x0e, 0xa3, 0xb6, 0xb1, 0x15, 0x9f, 0x99,
+	0x24, 0x31, 0x3e, 0x58, 0xac, 0x1a, 0x60, 0x2b, 0xea, 0xf2, 0xea, 0x8e, 0xf1, 0x2a, 0x4f, 0x28,
+	0xb3, 0xda, 0x13, 0xca, 0x5d, 0x20, 0xa3, 0xa3, 0x1e, 0xf2, 0xa3, 0x37, 0xf4, 0x23, 0xa9, 0xa2,
+	0x5d, 0x18, 0x1d, 0xed, 0xf3, 0x8e, 0x9f, 0xf4, 0x23, 0x0a, 0xed, 0xdf, 0x25, 0x47, 0x7e, 0xf9,
+	0xa4, 0xa4, 0x90, 0x20, 0x43, 0x69, 0x55, 0x80, 0xb2, 0x56, 0x0d, 0xca, 0xba, 0x09, 0xca, 0xc2,
+	0x47, 0x2e, 0xf3, 0x84, 0xa6, 0xcd, 0x13, 0x4a, 0x0e, 0x89, 0x89, 0xf9, 0xa4, 0x87, 0x4e, 0x72,
+	0x89, 0xe3, 0xfc, 0xbb, 0x74, 0x62, 0x98, 0x8f, 0xbb, 0x6a, 0x67, 0xc4, 0x52, 0xdd, 0xf1, 0x8c,
+	0x58, 0x1e, 0xe9, 0xf1, 0x8c, 0x58, 0xa1, 0xa5, 0x70, 0xa1, 0xa0, 0xcc, 0x52, 0xa0, 0x88, 0x99,
+	0x2c, 0xd5, 0xec, 0xd6, 0x2b, 0x58, 0x8a, 0xd7, 0x9d, 0x19, 0x4b, 0x49, 0x67, 0x92, 0x13, 0xcc,
+	0xcb, 0xce, 0x94, 0xe1, 0x4c, 0x0b, 0xcf, 0x94, 0xa1, 0xe1, 0x11, 0x32, 0x1a, 0x77, 0x3f, 0x4b,
+	0xce, 0x24, 0xab, 0x24, 0xbf, 0x4a, 0xc1, 0x44, 0xc1, 0x75, 0xba, 0x92, 0x07, 0xcc, 0xe4, 0x7a,
+	0x80, 0x3a, 0xd9, 0x2a, 0x1e, 0x90, 0x1c, 0x69, 0xd6, 0xe8, 0xaf, 0x0d, 0x52, 0xa8, 0x97, 0x1e,
+	0x0c, 0xd3, 0xed, 0x57, 0x38, 0xea, 0x2f, 0x6a, 0xd0, 0x3a, 0x9c, 0x0c, 0xbd, 0x6f, 0x9f, 0x79,
+	0x61, 0xe8, 0x0f, 0xd8, 0x6b, 0xb3, 0x01, 0xfe, 0x9f, 0xaa, 0x06, 0xa2, 0x49, 0xad, 0xa3, 0x6b,
+	0xc5, 0x75, 0x74, 0x3d, 0x5b, 0x47, 0x6b, 0xbb, 0x49, 0x53, 0x99, 0xdd, 0xa4, 0x9c, 0x5d, 0xc3,
+	0xe9, 0xdc, 0x83, 0x08, 0xaf, 0x6c, 0x89, 0xc2, 0xf9, 0x13, 0x2b, 0x79, 0xa3, 0x56, 0x02, 0xb0,
+	0x74, 0x5f, 0x30, 0x03, 0x53, 0xad, 0x14, 0xa6, 0x7a, 0x55, 0x98, 0xa6, 0xf2, 0x60, 0x72, 0x3e,
+	0x04, 0xdb, 0xa4, 0x6b, 0xfa, 0xae, 0x74, 0xa1, 0xd1, 0x9d, 0x17, 0x35, 0xe9, 0xed, 0x5c, 0xe9,
+	0x0e, 0x57, 0xed, 0x4d, 0x7e, 0x79, 0x16, 0xf8, 0x8a, 0x9b, 0x99, 0xba, 0xb3, 0xc5, 0x8f, 0xdf,
+	0x0d, 0xc3, 0xe3, 0xb7, 0xf2, 0xf2, 0xae, 0x8a, 0x41, 0xe9, 0xcb, 0xbb, 0xf4, 0x57, 0x13, 0xdd,
+	0x8a, 0x5f, 0xde, 0x95, 0xed, 0xb3, 0x10, 0x4a, 0x57, 0x34, 0x78, 0xfe, 0xa6, 0x95, 0xbc, 0xbd,
+	0x6b, 0xe0, 0x5c, 0xa9, 0xef, 0xaa, 0xb4, 0xaa, 0x55, 0xa5, 0x55, 0xbd, 0x88, 0x56, 0x26, 0x75,
+	0xaa, 0xd2, 0xea, 0xc3, 0xe4, 0x75, 0xde, 0x1c, 0x4e, 0xa9, 0xc3, 0x35, 0x7b, 0x3a, 0x3f, 0x06,
+	0xd7, 0x8c, 0xc3, 0xf3, 0x7e, 0x5e, 0x1f, 0xff, 0x3b, 0x75, 0xa8, 0x3f, 0x1d, 0x06, 0x64, 0x15,
+	0x66, 0xa2, 0x61, 0x90, 0xaa, 0x38, 0x1d, 0x0d, 0x03, 0xbe, 0x98, 0x40, 0x9b, 0x25, 0x27, 0x9d,
+	0x8d, 0x86, 0x81, 0x78, 0xf1, 0x27, 0x76, 0xc3, 0x63, 0x4f, 0x54, 0xf9, 0x78, 0x45, 0x7f, 0x92,
+	0x6f, 0x12, 0xf6, 0x06, 0xee, 0xb9, 0xd8, 0xb7, 0x07, 0xde, 0xf4, 0xd8, 0x3d, 0x8f, 0xe8, 0xcb,
+	0x83, 0xc7, 0x41, 0x30, 0xe8, 0xa5, 0x67, 0x30, 0x78, 0x68, 0x6b, 0xd1, 0xd6, 0x27, 0xe2, 0x1c,
+	0xc6, 0x26, 0x2c, 0x30, 0xee, 0xf4, 0xe4, 0x77, 0x93, 0x78, 0x9e, 0xa2, 0xcd, 0x89, 0xdc, 0x9b,
+	0xd0, 0x7a, 0x36, 0x09, 0x47, 0xb8, 0x31, 0x29, 0x4e, 0xae, 0x35, 0x69, 0x1b, 0xdf, 0x97, 0x8c,
+	0xb4, 0x5d, 0x8b, 0x82, 0xb5, 0xf5, 0x39, 0x2d, 0xcd, 0x69, 0x81, 0x15, 0x5e, 0x26, 0xb0, 0x36,
+	0x2f, 0x15, 0x58, 0x3f, 0xad, 0xc1, 0x22, 0x0f, 0x56, 0x4f, 0x87, 0x81, 0xb4, 0xfe, 0x9e, 0x18,
+	0xc3, 0xca, 0x33, 0x46, 0xad, 0xc8, 0x18, 0xf5, 0x0a, 0xc6, 0x98, 0xaa, 0x66, 0x8c, 0xe9, 0x2a,
+	0xc6, 0x98, 0x29, 0x36, 0xc6, 0x6c, 0x91, 0x31, 0xb4, 0x9d, 0x45, 0x67, 0x1b, 0x96, 0x24, 0x44,
+	0x90, 0xdf, 0x66, 0xda, 0x3a, 0xff, 0x6a, 0xc1, 0xb2, 0x88, 0x53, 0x4f, 0x87, 0xc1, 0x95, 0x0a,
+	0xd1, 0xa9, 0xca, 0x3c, 0x3a, 0x1b, 0x3c, 0x8d, 0x47, 0xe6, 0xc4, 0xb8, 0x1a, 0x2c, 0xea, 0xf9,
+	0xf4, 0x9f, 0x85, 0x15, 0x75, 0xa6, 0x85, 0x81, 0x78, 0x07, 0xe8, 0x5d, 0xa5, 0xf0, 0xbb, 0x9a,
+	0x0d, 0xbf, 0x14, 0x5f, 0xaa, 0x22, 0x2e, 0x80, 0x2e, 0xf2, 0xe8, 0x26, 0xf1, 0xf0, 0xff, 0x69,
+	0xac, 0xa0, 0x0c, 0x94, 0xb0, 0x28, 0x66, 0xe0, 0x36, 0x2c, 0xf1, 0xb8, 0x2c, 0xd3, 0x4f, 0x96,
+	0x4d, 0x4d, 0xef, 0xdc, 0x05, 0x22, 0xcb, 0x1a, 0x6e, 0x9c, 0x0a, 0xef, 0xbd, 0xf8, 0x2a, 0xb4,
+	0xd8, 0x26, 0xdb, 0x13, 0x77, 0xe4, 0x1e, 0x7b, 0x21, 0xf9, 0xd4, 0x82, 0x79, 0xf5, 0xcb, 0x44,
+	0xe4, 0xb6, 0x61, 0xf9, 0xc7, 0xf4, 0xe5, 0x23, 0x7b, 0xab, 0x5c, 0x90, 0x6b, 0xe3, 0xdc, 0xbd,
+	0xd8, 0x5f, 0x22, 0x0b, 0x3c, 0xbc, 0x75, 0xc5, 0x76, 0xeb, 0x2f, 0xfd, 0xc3, 0xbf, 0xfc, 0xb0,
+	0xb6, 0xe4, 0xb4, 0x76, 0xcf, 0xde, 0xde, 0x15, 0x6d, 0x0f, 0xad, 0x6d, 0xf2, 0xfb, 0x16, 0x2c,
+	0x09, 0x52, 0x8a, 0x3b, 0x45, 0x64, 0x3b, 0xfb, 0x63, 0x79, 0x5f, 0x45, 0xb2, 0xef, 0x56, 0x92,
+	0x45, 0xdd, 0xee, 0x5d, 0xec, 0xaf, 0x10, 0x32, 0xc0, 0xfe, 0x44, 0xbb, 0x88, 0xa9, 0xb7, 0x40,
+	0xda, 0xb2, 0x7a, 0x11, 0xc3, 0x4b, 0xfd, 0x4c, 0x8f, 0x09, 0x2f, 0xe3, 0xf7, 0x83, 0xec, 0xad,
+	0x72, 0x41, 0x05, 0xaf, 0x53, 0xd6, 0xa9, 0xe1, 0xb5, 0x97, 0xc1, 0xeb, 0x77, 0x2d, 0x58, 0xd0,
+	0xbe, 0xe1, 0x43, 0xb6, 0x4c, 0x08, 0x98, 0xbe, 0x10, 0x64, 0xdf, 0xa9, 0x20, 0x89, 0x5a, 0xdd,
+	0xbf, 0xd8, 0x27, 0x64, 0x71, 0xc0, 0x7a, 0x35, 0x9c, 0xc8, 0xb6, 0x8a, 0x13, 0xd5, 0xeb, 0x8f,
+	0x92, 0x93, 0x18, 0xca, 0x47, 0x82, 0xee, 0xe6, 0xb1, 0xc6, 0xf0, 0x39, 0x15, 0xfb, 0x5e, 0x35,
+	0x61, 0x54, 0xf0, 0x2b, 0x17, 0xfb, 0x6b, 0x64, 0x05, 0x69, 0x26, 0x4a, 0xcf, 0x6e, 0x7c, 0x3e,
+	0xf6, 0x98, 0x92, 0x6b, 0xce, 0x12, 0x55, 0x52, 0xf9, 0x10, 0x0c, 0x55, 0xf4, 0x47, 0x96, 0x74,
+	0xf4, 0x51, 0xba, 0x6f, 0x44, 0x76, 0xf2, 0x89, 0x64, 0xfa, 0x7e, 0x8a, 0xbd, 0x5b, 0x59, 0x1e,
+	0x35, 0x7e, 0xf7, 0x62, 0x7f, 0x83, 0xac, 0x27, 0xe4, 0x53, 0x74, 0xe6, 0xc8, 0xae, 0x10, 0x92,
+	0x51, 0x3a, 0x62, 0xd8, 0x66, 0xbf, 0x01, 0x63, 0xc2, 0x36, 0xf7, 0x53, 0x35, 0xf6, 0xbd, 0x6a,
+	0xc2, 0x0a, 0xb6, 0x48, 0x49, 0x03, 0xb6, 0x7b, 0x66, 0x6c, 0xff, 0xd4, 0x4a, 0xce, 0x50, 0x29,
+	0xc8, 0xde, 0xcb, 0xa3, 0x9d, 0x11, 0xd7, 0xfb, 0x15, 0xa5, 0x51, 0xd7, 0xf7, 0x2e, 0xf6, 0xd7,
+	0xc9, 0x2a, 0x12, 0xd5, 0x80, 0xe9, 0xfa, 0xb6, 0x01, 0x53, 0x64, 0xc2, 0x8a, 0xe9, 0x73, 0x26,
+	0xe4, 0x7e, 0x19, 0x0f, 0x95, 0x6f, 0x60, 0xd8, 0x3b, 0x55, 0xc5, 0x51, 0xe1, 0xf7, 0x2f, 0xf6,
+	0x3b, 0x64, 0x4d, 0x27, 0x2e, 0x3f, 0x96, 0xc1, 0x34, 0xee, 0x38, 0xcb, 0x8a, 0xc6, 0xbc, 0x8b,
+	0xaa, 0xfc, 0x97, 0x56, 0xba, 0xf0, 0xa4, 0xde, 0x3d, 0x22, 0x6f, 0x95, 0xd3, 0x51, 0xfd, 0x68,
+	0x85, 0xfd, 0xf6, 0x25, 0x46, 0xa0, 0xee, 0x0f, 0x2f, 0xf6, 0xaf, 0x91, 0x8d, 0x2c, 0x85, 0xb9,
+	0x8a, 0x1c, 0xf0, 0x35, 0xb2, 0x62, 0x50, 0x3f, 0x62, 0x78, 0x9b, 0x3e, 0xc3, 0x61, 0xc2, 0xbb,
+	0xe0, 0x9b, 0x23, 0xf6, 0x4e, 0x55, 0x71, 0x05, 0x6f, 0x9d, 0xcc, 0x32, 0xde, 0x7b, 0x79, 0x78,
+	0xff, 0xb9, 0x25, 0x96, 0x89, 0x74, 0xb4, 0x77, 0xca, 0x48, 0xaa, 0x61, 0xbd, 0x5b, 0x59, 0x1e,
+	0xb5, 0xfe, 0x00, 0x83, 0x85, 0x4a, 0x6b, 0x19, 0xe7, 0x8d, 0x6d, 0x23, 0xce, 0x54, 0xef, 0x5f,
+	0xb6, 0xa0, 0x25, 0x7f, 0x7c, 0x82, 0xdc, 0xca, 0xe3, 0xa8, 0xf2, 0xa5, 0x03, 0x7b, 0xb3, 0x4c,
+	0x0c, 0x95, 0xbb, 0x7d, 0xb1, 0xbf, 0x40, 0xda, 0x48, 0x61, 0x5e, 0x49, 0xf1, 0x0c, 0xea, 0x00,
+	0x55, 0x89, 0xb7, 0x50, 0x45, 0x3e, 0x65, 0xe9, 0x4a, 0xf9, 0x7a, 0x83, 0x39, 0x5d, 0x99, 0x3e,
+	0x79, 0x61, 0xdf, 0xa9, 0x20, 0x89, 0x1a, 0x6d, 0x61, 0xba, 0x42, 0x62, 0x72, 0x0d, 0x38, 0x4e,
+	0x6d, 0xd2, 0x4c, 0x95, 0x8a, 0x18, 0x36, 0xf2, 0x77, 0x13, 0x4c, 0xd8, 0x18, 0xbe, 0x02, 0x61,
+	0x6f, 0x96, 0x89, 0x29, 0xd8, 0x20, 0xdd, 0x64, 0x6c, 0xf6, 0x34, 0x6c, 0x7e, 0xdd, 0x82, 0xb6,
+	0xf2, 0x59, 0x05, 0xb2, 0x99, 0x47, 0x12, 0x0d, 0x97, 0xdb, 0xa5, 0x72, 0xa8, 0xcb, 0x9d, 0x8b,
+	0xfd, 0x45, 0x32, 0x8f, 0x24, 0x92, 0x31, 0x59, 0xdc, 0x96, 0x31, 0x51, 0x29, 0x83, 0xdf, 0x6c,
+	0xc8, 0xa5, 0x8c, 0xf2, 0x72, 0xb3, 0xbd, 0x59, 0x26, 0x66, 0xa2, 0x0c, 0x7f, 0x24, 0x91, 0x29,
+	0xc3, 0x5b, 0xb0, 0xc2, 0x59, 0xd4, 0xdf, 0xd8, 0x26, 0x05, 0x4c, 0xd0, 0xde, 0xec, 0xb5, 0xb7,
+	0xab, 0x88, 0xa2, 0x52, 0xdb, 0x17, 0xfb, 0xcb, 0x64, 0x29, 0x61, 0xcd, 0x18, 0xfb, 0x99, 0x62,
+	0xf3, 0xa4, 0x95, 0x28, 0x46, 0x55, 0x48, 0x79, 0x93, 0x0f, 0x90, 0xe1, 0xed, 0x6f, 0x7b, 0xb3,
+	0x4c, 0xcc, 0xc4, 0x1b, 0x19, 0xa0, 0x3d, 0x0d, 0x20, 0x5a, 0x95, 0xaa, 0xaf, 0x27, 0x93, 0x5c,
+	0x42, 0xe8, 0xe0, 0x6c, 0x95, 0x0b, 0x2a, 0x55, 0x29, 0x52, 0x47, 0x01, 0x66, 0x69, 0x5b, 0x01,
+	0x86, 0xaa, 0xf4, 0x03, 0x80, 0x74, 0xbd, 0x94, 0xdc, 0xc8, 0x4d, 0x88, 0xe9, 0x9b, 0x20, 0xf6,
+	0xcd, 0x62, 0x21, 0xd4, 0xe2, 0xc6, 0xc5, 0x7e, 0x9b, 0x34, 0x45, 0xae, 0x9c, 0x0c, 0x79, 0xfd,
+	0xd1, 0x76, 0x1a, 0x2c, 0xf2, 0x4d, 0x86, 0x1e, 0x52, 0xb7, 0xad, 0xbc, 0xcd, 0x62, 0x76, 0xa4,
+	0xec, 0xbb, 0x5d, 0xf6, 0xed, 0x52, 0x39, 0xd4, 0xe3, 0x26, 0x3a, 0x92, 0xc8, 0x7b, 0xb4, 0x93,
+	0xa9, 0xd2, 0x24, 0x73, 0x42, 0x95, 0x88, 0xc2, 0x90, 0xae, 0xef, 0x99, 0x60, 0xc8, 0xbc, 0x10,
+	0x63, 0xdf, 0x2c, 0x16, 0x52, 0x60, 0x10, 0x29, 0x2c, 0x81, 0x61, 0x4f, 0x81, 0xe1, 0x85, 0x05,
+	0x4d, 0xe9, 0x04, 0x3b, 0xb9, 0x99, 0x9b, 0x72, 0x64, 0x08, 0x6e, 0x95, 0x48, 0xa1, 0x06, 0xb7,
+	0x2e, 0xf6, 0xe7, 0x49, 0x4b, 0xa4, 0xa3, 0x64, 0xfa, 0xf3, 0xdb, 0xe9, 0xf4, 0x85, 0x0e, 0xd2,
+	0x01, 0x68, 0x92, 0x6b, 0x65, 0xf9, 0x2c, 0xac, 0x7d, 0xab, 0x44, 0x4a, 0xd1, 0x01, 0xc9, 0xc0,
+	0xc4, 0xb8, 0x0e, 0x0e, 0xd3, 0x81, 0x35, 0x60, 0x5c, 0x9d, 0x57, 0xcf, 0xf5, 0x92, 0x02, 0x3b,
+	0x2b, 0xe7, 0x56, 0xed, 0xad, 0x72, 0x41, 0x54, 0x66, 0x13, 0xfd, 0x03, 0x19, 0xc1, 0x64, 0x39,
+	0x26, 0x2d, 0x02, 0x89, 0x3e, 0x11, 0x43, 0x44, 0x3a, 0x53, 0x4b, 0x72, 0x0d, 0x5e, 0x86, 0x88,
+	0xe1, 0x60, 0x2e, 0x22, 0x82, 0xbc, 0x90, 0x10, 0xd9, 0x53, 0x11, 0xa1, 0xa1, 0x4b, 0x3e, 0x73,
+	0x4b, 0x72, 0x8d, 0xae, 0xa2, 0xb1, 0x59, 0x26, 0xa6, 0x84, 0x2e, 0x24, 0x87, 0x84, 0xc4, 0xc2,
+	0xb6, 0x84, 0x84, 0x48, 0x79, 0xca, 0x09, 0x4b, 0x92, 0x9b, 0x3e, 0xd4, 0x53, 0x74, 0xf6, 0xed,
+	0x52, 0x39, 0x25, 0xe5, 0x21, 0x49, 0xf0, 0xc0, 0x08, 0x4f, 0x79, 0x0e, 0x4b, 0x79, 0xd8, 0xa4,
+	0xaf, 0x3d, 0x24, 0xe7, 0xc6, 0x8a, 0xd6, 0x1e, 0xf4, 0x53, 0x69, 0xf6, 0xdd, 0x4a, 0xb2, 0xe6,
+	0xb5, 0x87, 0x13, 0x21, 0x20, 0xaf, 0x3d, 0x24, 0x8d, 0x0c, 0x2a, 0xe5, 0x0c, 0x1d, 0xc9, 0x4d,
+	0x24, 0xe5, 0x50, 0x19, 0x0f, 0xe3, 0x21, 0x54, 0xc8, 0x1e, 0x05, 0xaa, 0x3d, 0x1d, 0xaa, 0x74,
+	0xd9, 0x21, 0x05, 0x2a, 0x37, 0x97, 0x64, 0x60, 0xba, 0x53, 0x41, 0xd2, 0xb4, 0xec, 0xa0, 0x42,
+	0x84, 0xcb, 0x0e, 0x49, 0xa3, 0x4a, 0x28, 0x71, 0x86, 0x2f, 0x97, 0x50, 0xea, 0xb9, 0x25, 0xfb,
+	0x76, 0xa9, 0x9c, 0x89, 0x50, 0x78, 0xb0, 0x47, 0x26, 0x14, 0x36, 0xe9, 0xa5, 0x0b, 0xde, 0xa6,
+	0xb0, 0x74, 0xd1, 0x0e, 0x21, 0xd9, 0xdb, 0x55, 0x44, 0xcd, 0xa5, 0x0b, 0x6a, 0xa1, 0x94, 0x2e,
+	0xa2, 0x4d, 0xe2, 0x52, 0x01, 0x4a, 0xa6, 0xd3, 0x5d, 0xf6, 0xed, 0x52, 0x39, 0x13, 0x97, 0x14,
+	0x94, 0xf6, 0x74, 0x94, 0xd2, 0xfa, 0x25, 0xc1, 0x28, 0xb7, 0x7e, 0xd1, 0x11, 0xda, 0x2a, 0x17,
+	0x34, 0xd5, 0x2f, 0x0a, 0x3a, 0x58, 0xbf, 0x88, 0x36, 0xb5, 0xf8, 0xc5, 0x23, 0x0d, 0xf9, 0x19,
+	0x49, 0x3e, 0x85, 0x61, 0x6f, 0x96, 0x89, 0x99, 0x8a, 0x5f, 0x7e, 0x9a, 0x40, 0x2e, 0x7e, 0x79,
+	0x8b, 0xfe, 0xbc, 0xc4, 0xef, 0x51, 0xf8, 0xbc, 0xa4, 0x9e, 0x78, 0xb0, 0xef, 0x54, 0x90, 0x34,
+	0x3f, 0x2f, 0x71, 0x0d, 0x94, 0xe7, 0x25, 0x6c, 0x92, 0xea, 0xde, 0x7c, 0x6c, 0x0c, 0x27, 0x54,
+	0xec, 0xcd, 0x32, 0x31, 0x53, 0xdd, 0x2b, 0x63, 0xb3, 0xa7, 0x61, 0x93, 0x3e, 0x2f, 0x09, 0x64,
+	0xf2, 0xf3, 0x93, 0x8a, 0xcb, 0xed, 0x52, 0x39, 0xd3, 0xf3, 0x92, 0x8c, 0x09, 0x3e, 0x2f, 0x61,
+	0x93, 0xb6, 0xe0, 0x29, 0x1f, 0x08, 0xb9, 0x5b, 0x54, 0xd6, 0x6a, 0x3b, 0xd0, 0xf6, 0xbd, 0x6a,
+	0xc2, 0xc6, 0x05, 0xcf, 0xc9, 0xd0, 0xeb, 0x8a, 0xcd, 0x59, 0x65, 0xc1, 0x53, 0xde, 0x3c, 0xcf,
+	0x2c, 0x78, 0x4a, 0xf7, 0x2d, 0x5e, 0xf0, 0x34, 0xec, 0x30, 0xdb, 0xbb, 0x95, 0xe5, 0x73, 0x16,
+	0x3c, 0x65, 0x9d, 0xd5, 0x05, 0x4f, 0x59, 0x69, 0x65, 0xc1, 0xb3, 0x04, 0xdb, 0xdc, 0xdd, 0x7d,
+	0xfb, 0x5e, 0x35, 0x61, 0xe3, 0x82, 0x67, 0x16, 0xdb, 0x3d, 0x33, 0xb6, 0xd2, 0x82, 0xa7, 0x82,
+	0xec, 0xbd, 0xa2, 0xa2, 0x3a, 0x83, 0xeb, 0xfd, 0x8a, 0xd2, 0xc6, 0x05, 0xcf, 0x2c, 0xa6, 0x62,
+	0xc1, 0x53, 0xc1, 0x94, 0x6a, 0xfb, 0x1c, 0xe6, 0x92, 0x6d, 0x51, 0xe2, 0xe4, 0x71, 0x2f, 0xdd,
+	0xbd, 0xb3, 0x6f, 0x14, 0xca, 0xa0, 0x3a, 0x6f, 0x5e, 0xec, 0xb7, 0x08, 0xee, 0x66, 0x77, 0xa3,
+	0x61, 0xc0, 0x6b, 0x60, 0x67, 0x96, 0xea, 0x10, 0x0d, 0x03, 0x7c, 0x2a, 0x68, 0xc9, 0x3b, 0x8f,
+	0xe6, 0xfa, 0x33, 0xb3, 0x07, 0x6b, 0x6f, 0x96, 0x89, 0xa1, 0x0a, 0x0e, 0xd6, 0x9f, 0xc8, 0xb3,
+	0x68, 0x18, 0x70, 0x24, 0x80, 0x34, 0x50, 0x8b, 0x88, 0x4e, 0x3e, 0xd9, 0x91, 0x33, 0x4d, 0x5e,
+	0xdf, 0xba, 0xb4, 0x6f, 0x14, 0xca, 0x28, 0x93, 0x47, 0xde, 0x24, 0x93, 0xdf, 0x93, 0x27, 0xff,
+	0x03, 0x80, 0x74, 0xcb, 0xce, 0xf4, 0x50, 0x98, 0xd9, 0xfc, 0xb3, 0x6f, 0x16, 0x0b, 0x29, 0x0f,
+	0x85, 0xc8, 0x83, 0x64, 0xce, 0xed, 0xed, 0x64, 0xce, 0x0f, 0xad, 0xed, 0x6f, 0x4c, 0x7d, 0xb7,
+	0x36, 0x7e, 0xf6, 0x6c, 0x86, 0x9d, 0x21, 0x78, 0xf0, 0x3f, 0x03, 0x00, 0xa5, 0x3d, 0xe6, 0xfc,
+	0x69, 0x66, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6124,6 +7643,18 @@ type AlertManagerClient interface {
 	DescribeActions(ctx context.Context, in *DescribeActionsRequest, opts ...grpc.CallOption) (*DescribeActionsResponse, error)
 	ModifyAction(ctx context.Context, in *ModifyActionRequest, opts ...grpc.CallOption) (*ModifyActionResponse, error)
 	DeleteActions(ctx context.Context, in *DeleteActionsRequest, opts ...grpc.CallOption) (*DeleteActionsResponse, error)
+	//10.RuleOverride
+	//********************************************************************************************************
+	CreateRuleOverride(ctx context.Context, in *CreateRuleOverrideRequest, opts ...grpc.CallOption) (*CreateRuleOverrideResponse, error)
+	DescribeRuleOverrides(ctx context.Context, in *DescribeRuleOverridesRequest, opts ...grpc.CallOption) (*DescribeRuleOverridesResponse, error)
+	ModifyRuleOverride(ctx context.Context, in *ModifyRuleOverrideRequest, opts ...grpc.CallOption) (*ModifyRuleOverrideResponse, error)
+	DeleteRuleOverrides(ctx context.Context, in *DeleteRuleOverridesRequest, opts ...grpc.CallOption) (*DeleteRuleOverridesResponse, error)
+	//11.Slo
+	//********************************************************************************************************
+	CreateSlo(ctx context.Context, in *CreateSloRequest, opts ...grpc.CallOption) (*CreateSloResponse, error)
+	DescribeSlos(ctx context.Context, in *DescribeSlosRequest, opts ...grpc.CallOption) (*DescribeSlosResponse, error)
+	ModifySlo(ctx context.Context, in *ModifySloRequest, opts ...grpc.CallOption) (*ModifySloResponse, error)
+	DeleteSlos(ctx context.Context, in *DeleteSlosRequest, opts ...grpc.CallOption) (*DeleteSlosResponse, error)
 }
 
 type alertManagerClient struct {
@@ -6494,6 +8025,78 @@ func (c *alertManagerClient) DeleteActions(ctx context.Context, in *DeleteAction
 	return out, nil
 }
 
+func (c *alertManagerClient) CreateRuleOverride(ctx context.Context, in *CreateRuleOverrideRequest, opts ...grpc.CallOption) (*CreateRuleOverrideResponse, error) {
+	out := new(CreateRuleOverrideResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/CreateRuleOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeRuleOverrides(ctx context.Context, in *DescribeRuleOverridesRequest, opts ...grpc.CallOption) (*DescribeRuleOverridesResponse, error) {
+	out := new(DescribeRuleOverridesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeRuleOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) ModifyRuleOverride(ctx context.Context, in *ModifyRuleOverrideRequest, opts ...grpc.CallOption) (*ModifyRuleOverrideResponse, error) {
+	out := new(ModifyRuleOverrideResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/ModifyRuleOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DeleteRuleOverrides(ctx context.Context, in *DeleteRuleOverridesRequest, opts ...grpc.CallOption) (*DeleteRuleOverridesResponse, error) {
+	out := new(DeleteRuleOverridesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DeleteRuleOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) CreateSlo(ctx context.Context, in *CreateSloRequest, opts ...grpc.CallOption) (*CreateSloResponse, error) {
+	out := new(CreateSloResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/CreateSlo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeSlos(ctx context.Context, in *DescribeSlosRequest, opts ...grpc.CallOption) (*DescribeSlosResponse, error) {
+	out := new(DescribeSlosResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeSlos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) ModifySlo(ctx context.Context, in *ModifySloRequest, opts ...grpc.CallOption) (*ModifySloResponse, error) {
+	out := new(ModifySloResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/ModifySlo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DeleteSlos(ctx context.Context, in *DeleteSlosRequest, opts ...grpc.CallOption) (*DeleteSlosResponse, error) {
+	out := new(DeleteSlosResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DeleteSlos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlertManagerServer is the server API for AlertManager service.
 type AlertManagerServer interface {
 	//0.executor
@@ -6556,6 +8159,18 @@ type AlertManagerServer interface {
 	DescribeActions(context.Context, *DescribeActionsRequest) (*DescribeActionsResponse, error)
 	ModifyAction(context.Context, *ModifyActionRequest) (*ModifyActionResponse, error)
 	DeleteActions(context.Context, *DeleteActionsRequest) (*DeleteActionsResponse, error)
+	//10.RuleOverride
+	//********************************************************************************************************
+	CreateRuleOverride(context.Context, *CreateRuleOverrideRequest) (*CreateRuleOverrideResponse, error)
+	DescribeRuleOverrides(context.Context, *DescribeRuleOverridesRequest) (*DescribeRuleOverridesResponse, error)
+	ModifyRuleOverride(context.Context, *ModifyRuleOverrideRequest) (*ModifyRuleOverrideResponse, error)
+	DeleteRuleOverrides(context.Context, *DeleteRuleOverridesRequest) (*DeleteRuleOverridesResponse, error)
+	//11.Slo
+	//********************************************************************************************************
+	CreateSlo(context.Context, *CreateSloRequest) (*CreateSloResponse, error)
+	DescribeSlos(context.Context, *DescribeSlosRequest) (*DescribeSlosResponse, error)
+	ModifySlo(context.Context, *ModifySloRequest) (*ModifySloResponse, error)
+	DeleteSlos(context.Context, *DeleteSlosRequest) (*DeleteSlosResponse, error)
 }
 
 // UnimplementedAlertManagerServer can be embedded to have forward compatible implementations.
@@ -6682,6 +8297,30 @@ func (*UnimplementedAlertManagerServer) ModifyAction(ctx context.Context, req *M
 func (*UnimplementedAlertManagerServer) DeleteActions(ctx context.Context, req *DeleteActionsRequest) (*DeleteActionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteActions not implemented")
 }
+func (*UnimplementedAlertManagerServer) CreateRuleOverride(ctx context.Context, req *CreateRuleOverrideRequest) (*CreateRuleOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRuleOverride not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeRuleOverrides(ctx context.Context, req *DescribeRuleOverridesRequest) (*DescribeRuleOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeRuleOverrides not implemented")
+}
+func (*UnimplementedAlertManagerServer) ModifyRuleOverride(ctx context.Context, req *ModifyRuleOverrideRequest) (*ModifyRuleOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyRuleOverride not implemented")
+}
+func (*UnimplementedAlertManagerServer) DeleteRuleOverrides(ctx context.Context, req *DeleteRuleOverridesRequest) (*DeleteRuleOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRuleOverrides not implemented")
+}
+func (*UnimplementedAlertManagerServer) CreateSlo(ctx context.Context, req *CreateSloRequest) (*CreateSloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSlo not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeSlos(ctx context.Context, req *DescribeSlosRequest) (*DescribeSlosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSlos not implemented")
+}
+func (*UnimplementedAlertManagerServer) ModifySlo(ctx context.Context, req *ModifySloRequest) (*ModifySloResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifySlo not implemented")
+}
+func (*UnimplementedAlertManagerServer) DeleteSlos(ctx context.Context, req *DeleteSlosRequest) (*DeleteSlosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlos not implemented")
+}
 
 func RegisterAlertManagerServer(s *grpc.Server, srv AlertManagerServer) {
 	s.RegisterService(&_AlertManager_serviceDesc, srv)
@@ -7407,6 +9046,150 @@ func _AlertManager_DeleteActions_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertManager_CreateRuleOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).CreateRuleOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/CreateRuleOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).CreateRuleOverride(ctx, req.(*CreateRuleOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeRuleOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRuleOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeRuleOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeRuleOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeRuleOverrides(ctx, req.(*DescribeRuleOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_ModifyRuleOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRuleOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).ModifyRuleOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/ModifyRuleOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).ModifyRuleOverride(ctx, req.(*ModifyRuleOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DeleteRuleOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DeleteRuleOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DeleteRuleOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DeleteRuleOverrides(ctx, req.(*DeleteRuleOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_CreateSlo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).CreateSlo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/CreateSlo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).CreateSlo(ctx, req.(*CreateSloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeSlos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSlosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeSlos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeSlos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeSlos(ctx, req.(*DescribeSlosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_ModifySlo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifySloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).ModifySlo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/ModifySlo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).ModifySlo(ctx, req.(*ModifySloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DeleteSlos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSlosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DeleteSlos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DeleteSlos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DeleteSlos(ctx, req.(*DeleteSlosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AlertManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubesphere.alert.AlertManager",
 	HandlerType: (*AlertManagerServer)(nil),
@@ -7571,6 +9354,38 @@ var _AlertManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteActions",
 			Handler:    _AlertManager_DeleteActions_Handler,
 		},
+		{
+			MethodName: "CreateRuleOverride",
+			Handler:    _AlertManager_CreateRuleOverride_Handler,
+		},
+		{
+			MethodName: "DescribeRuleOverrides",
+			Handler:    _AlertManager_DescribeRuleOverrides_Handler,
+		},
+		{
+			MethodName: "ModifyRuleOverride",
+			Handler:    _AlertManager_ModifyRuleOverride_Handler,
+		},
+		{
+			MethodName: "DeleteRuleOverrides",
+			Handler:    _AlertManager_DeleteRuleOverrides_Handler,
+		},
+		{
+			MethodName: "CreateSlo",
+			Handler:    _AlertManager_CreateSlo_Handler,
+		},
+		{
+			MethodName: "DescribeSlos",
+			Handler:    _AlertManager_DescribeSlos_Handler,
+		},
+		{
+			MethodName: "ModifySlo",
+			Handler:    _AlertManager_ModifySlo_Handler,
+		},
+		{
+			MethodName: "DeleteSlos",
+			Handler:    _AlertManager_DeleteSlos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alert.proto",
@@ -626,6 +626,10 @@ type ResourceStatus struct {
 	NextResendInterval   uint32   `protobuf:"varint,5,opt,name=next_resend_interval,json=nextResendInterval,proto3" json:"next_resend_interval"`
 	NextSendableTime     string   `protobuf:"bytes,6,opt,name=next_sendable_time,json=nextSendableTime,proto3" json:"next_sendable_time"`
 	AggregatedAlerts     string   `protobuf:"bytes,7,opt,name=aggregated_alerts,json=aggregatedAlerts,proto3" json:"aggregated_alerts"`
+	NoData               bool     `protobuf:"varint,8,opt,name=no_data,json=noData,proto3" json:"no_data"`
+	NegativeCount        uint32   `protobuf:"varint,9,opt,name=negative_count,json=negativeCount,proto3" json:"negative_count"`
+	Flapping             bool     `protobuf:"varint,10,opt,name=flapping,proto3" json:"flapping"`
+	Inhibited            bool     `protobuf:"varint,11,opt,name=inhibited,proto3" json:"inhibited"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -705,6 +709,34 @@ func (m *ResourceStatus) GetAggregatedAlerts() string {
 	return ""
 }
 
+func (m *ResourceStatus) GetNoData() bool {
+	if m != nil {
+		return m.NoData
+	}
+	return false
+}
+
+func (m *ResourceStatus) GetNegativeCount() uint32 {
+	if m != nil {
+		return m.NegativeCount
+	}
+	return 0
+}
+
+func (m *ResourceStatus) GetFlapping() bool {
+	if m != nil {
+		return m.Flapping
+	}
+	return false
+}
+
+func (m *ResourceStatus) GetInhibited() bool {
+	if m != nil {
+		return m.Inhibited
+	}
+	return false
+}
+
 type AlertStatus struct {
 	RuleId               string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	RuleName             string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
@@ -1447,115 +1479,118 @@ func init() {
 func init() { proto.RegisterFile("custom.proto", fileDescriptor_0669528d4dffbbe2) }
 
 var fileDescriptor_0669528d4dffbbe2 = []byte{
-	// 1717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x06, 0x45, 0xfd, 0x90, 0xc5, 0x5f, 0xb5, 0xb4, 0xd2, 0x98, 0xf6, 0x7a, 0x69, 0xae, 0x63,
-	0x0b, 0xc8, 0x8a, 0x74, 0xb4, 0x0b, 0xe4, 0x60, 0x20, 0x80, 0xb2, 0x1b, 0x23, 0x44, 0x36, 0x81,
-	0x41, 0x19, 0x30, 0x90, 0xcb, 0x60, 0x34, 0xd3, 0x24, 0x1b, 0x1e, 0xce, 0x4c, 0xba, 0x7b, 0xb4,
-	0x16, 0x92, 0x53, 0xf2, 0x06, 0xca, 0x3b, 0xe4, 0x12, 0xe4, 0x90, 0x43, 0x8e, 0x01, 0x12, 0x20,
-	0x4f, 0x90, 0x9c, 0x72, 0xcf, 0x25, 0xf7, 0x3c, 0x40, 0xd0, 0x55, 0x3d, 0xe4, 0xcc, 0x90, 0xfa,
-	0x59, 0x24, 0x17, 0x03, 0x3e, 0x49, 0xfd, 0x55, 0x75, 0x4f, 0x75, 0xd5, 0x57, 0x5f, 0xcd, 0x10,
-	0x9a, 0x7e, 0xaa, 0x74, 0xbc, 0x18, 0x26, 0x32, 0xd6, 0x31, 0xeb, 0x7e, 0x95, 0x5e, 0x72, 0x95,
-	0xcc, 0xb9, 0xe4, 0x43, 0x2f, 0xe4, 0x52, 0xf7, 0xde, 0x9b, 0xc5, 0xf1, 0x2c, 0xe4, 0x23, 0x2f,
-	0x11, 0x23, 0x2f, 0x8a, 0x62, 0xed, 0x69, 0x11, 0x47, 0x8a, 0xfc, 0x7b, 0xef, 0x5b, 0x2b, 0xae,
-	0x2e, 0xd3, 0xe9, 0xe8, 0x8d, 0xf4, 0x92, 0x84, 0xcb, 0xcc, 0xfe, 0x0c, 0xff, 0xf8, 0xa7, 0x33,
-	0x1e, 0x9d, 0xaa, 0x37, 0xde, 0x6c, 0xc6, 0xe5, 0x28, 0x4e, 0xf0, 0x84, 0x0d, 0xa7, 0x3d, 0x29,
-	0x9f, 0xa6, 0xc5, 0x82, 0x2b, 0xed, 0x2d, 0x12, 0xeb, 0xd0, 0xc0, 0x98, 0x68, 0x31, 0xf8, 0x43,
-	0x15, 0x3e, 0x78, 0xc5, 0x95, 0x2f, 0xc5, 0x25, 0x3f, 0x37, 0xb8, 0xfa, 0x52, 0xe8, 0xf9, 0x84,
-	0xab, 0x38, 0x95, 0x3e, 0x9f, 0xf0, 0x5f, 0xa4, 0x5c, 0x69, 0xf6, 0x04, 0x1a, 0x8a, 0x7b, 0xd2,
-	0x9f, 0xbb, 0x6f, 0x62, 0x19, 0x38, 0x95, 0x7e, 0xe5, 0xa4, 0x3e, 0x01, 0x82, 0xbe, 0x8c, 0x65,
-	0xc0, 0xde, 0x81, 0x9a, 0x8a, 0xa5, 0x76, 0xbf, 0xe2, 0xd7, 0xce, 0x16, 0x5a, 0xf7, 0xcc, 0xfa,
-	0x27, 0xfc, 0x9a, 0x39, 0xb0, 0x27, 0xf9, 0x15, 0x97, 0x8a, 0x3b, 0xd5, 0x7e, 0xe5, 0xa4, 0x36,
-	0xc9, 0x96, 0xec, 0x08, 0x76, 0xe3, 0xe9, 0x54, 0x71, 0xed, 0x6c, 0xf7, 0x2b, 0x27, 0xad, 0x89,
-	0x5d, 0xb1, 0x43, 0xd8, 0x09, 0xc5, 0x42, 0x68, 0x67, 0x07, 0x61, 0x5a, 0xb0, 0x8f, 0xa1, 0x23,
-	0x6d, 0x58, 0x2e, 0x3d, 0xd9, 0xd9, 0xc5, 0x27, 0xb5, 0x33, 0xf8, 0x02, 0x51, 0x13, 0x0b, 0xde,
-	0xd0, 0x15, 0x81, 0xb3, 0xd7, 0xaf, 0x9a, 0x58, 0x70, 0x3d, 0x0e, 0xd8, 0x63, 0x00, 0x32, 0x45,
-	0xde, 0x82, 0x3b, 0x35, 0x34, 0xd6, 0x11, 0xf9, 0x99, 0xb7, 0xe0, 0xac, 0x07, 0xb5, 0x40, 0x28,
-	0xef, 0x32, 0xe4, 0x81, 0x53, 0xef, 0x57, 0x4f, 0x6a, 0x93, 0xe5, 0x9a, 0x7d, 0x07, 0xda, 0x32,
-	0x8d, 0x22, 0x11, 0xcd, 0x5c, 0xa5, 0x3d, 0x9d, 0x2a, 0x07, 0x70, 0x7b, 0xcb, 0xa2, 0x17, 0x08,
-	0xb2, 0x77, 0xa1, 0x9e, 0xc4, 0xa1, 0xf0, 0xaf, 0xcd, 0xd3, 0x1b, 0xe8, 0x51, 0x23, 0x60, 0x1c,
-	0xb0, 0x3e, 0x34, 0xa5, 0x72, 0xa7, 0x22, 0xd4, 0x5c, 0x1a, 0x7b, 0x13, 0xed, 0x20, 0xd5, 0x67,
-	0x08, 0x8d, 0x03, 0x93, 0x68, 0xfe, 0x35, 0xf7, 0x53, 0x1d, 0xa3, 0x43, 0x8b, 0x1c, 0x32, 0x68,
-	0x1c, 0x0c, 0x12, 0x18, 0xdc, 0x55, 0x2e, 0x95, 0xc4, 0x91, 0xe2, 0x26, 0x83, 0x3a, 0xd6, 0x5e,
-	0x88, 0x95, 0x6a, 0x4d, 0x68, 0xc1, 0x5e, 0x00, 0xdd, 0xd5, 0x35, 0x29, 0xdf, 0xea, 0x57, 0x4f,
-	0x1a, 0x67, 0xc7, 0xc3, 0x32, 0x57, 0x87, 0x78, 0xec, 0x84, 0x52, 0x78, 0xc1, 0xf5, 0xe0, 0xdf,
-	0xbb, 0xd0, 0x40, 0xec, 0x15, 0xd7, 0x9e, 0x08, 0x0b, 0xe9, 0x25, 0x22, 0xdc, 0x92, 0x5e, 0xe2,
-	0xc1, 0x2d, 0xe9, 0x25, 0x2a, 0xac, 0xd2, 0xfb, 0x29, 0x34, 0x7c, 0xc9, 0x3d, 0xcd, 0x5d, 0x43,
-	0x57, 0x24, 0x44, 0xe3, 0xac, 0x37, 0x24, 0x2e, 0x0f, 0x33, 0x2e, 0x0f, 0xbf, 0xc8, 0xb8, 0x3c,
-	0x01, 0x72, 0x37, 0xc0, 0x86, 0xda, 0xec, 0xe0, 0xb3, 0x4b, 0xb5, 0xf9, 0x00, 0x9a, 0xf6, 0xfe,
-	0xe4, 0x44, 0xf4, 0xa1, 0x76, 0xd8, 0x54, 0xbe, 0x3d, 0xb4, 0xaf, 0xca, 0xf7, 0x21, 0xb4, 0x57,
-	0xe5, 0xb3, 0x0c, 0x32, 0x1e, 0xcd, 0xac, 0x80, 0x78, 0xcb, 0x8f, 0xa0, 0xb3, 0xf2, 0x4a, 0x3c,
-	0xe9, 0x2d, 0x9c, 0xba, 0x8d, 0xc6, 0xba, 0x7d, 0x6e, 0x40, 0x4b, 0x06, 0x7d, 0x9d, 0x70, 0x3a,
-	0x0b, 0xa8, 0xa9, 0xa4, 0xfa, 0xe2, 0x3a, 0xe1, 0x78, 0x52, 0x89, 0x0c, 0x0d, 0x72, 0x58, 0x91,
-	0xc1, 0x38, 0xd8, 0x68, 0xf1, 0x84, 0x26, 0x39, 0x10, 0x84, 0x27, 0x9c, 0x02, 0xb3, 0x0e, 0x01,
-	0x92, 0x06, 0x45, 0xc3, 0x69, 0xa1, 0xdf, 0x3e, 0x59, 0x5e, 0xad, 0x0c, 0xec, 0x29, 0xb4, 0xac,
-	0xbb, 0x1f, 0x47, 0x53, 0x31, 0x73, 0xda, 0x74, 0x3f, 0x02, 0x5f, 0x22, 0x66, 0xfa, 0x19, 0x53,
-	0x1f, 0x4b, 0xa7, 0x43, 0xe5, 0xb7, 0x4b, 0xf6, 0x09, 0x1c, 0x7a, 0x57, 0x9e, 0x08, 0x4d, 0x45,
-	0x4d, 0x8e, 0xa5, 0xa6, 0x62, 0x76, 0xd1, 0x8d, 0x2d, 0x6d, 0x17, 0xc6, 0x84, 0x85, 0x7b, 0x06,
-	0x2b, 0xd4, 0xe5, 0x51, 0x40, 0xfe, 0xfb, 0xe8, 0xdf, 0x5d, 0x5a, 0x7e, 0x14, 0x05, 0xe8, 0xdd,
-	0x83, 0x5a, 0xe8, 0x45, 0xb3, 0xd4, 0x9b, 0x71, 0x87, 0x51, 0x6d, 0xb2, 0xb5, 0x89, 0x6a, 0xc1,
-	0xb5, 0x14, 0xbe, 0x72, 0x0e, 0xa8, 0xe7, 0xed, 0xd2, 0x24, 0x49, 0xa6, 0x21, 0x57, 0xae, 0x1f,
-	0xa7, 0x91, 0x76, 0x0e, 0xb1, 0x23, 0x00, 0xa1, 0x97, 0x06, 0x31, 0xc2, 0x92, 0xc4, 0x4a, 0x68,
-	0x71, 0xb5, 0x74, 0x7a, 0x84, 0x4e, 0xed, 0x25, 0x4c, 0x8e, 0xcf, 0xe1, 0x68, 0x11, 0x2b, 0xed,
-	0x4a, 0xee, 0xf3, 0x48, 0xbb, 0xc4, 0x25, 0x8c, 0xf8, 0x08, 0xa3, 0x39, 0x30, 0xd6, 0x09, 0x1a,
-	0xb1, 0x61, 0x30, 0xe8, 0xef, 0x02, 0x8b, 0xa6, 0xae, 0x17, 0x04, 0x92, 0x2b, 0xe5, 0x86, 0x42,
-	0x61, 0xe3, 0x1c, 0xe3, 0x86, 0x4e, 0x34, 0x3d, 0x27, 0xc3, 0x6b, 0xa1, 0xf4, 0x38, 0x18, 0xfc,
-	0xb5, 0x0a, 0xef, 0x16, 0xda, 0x9b, 0x7a, 0x4e, 0x7d, 0xab, 0xc3, 0xff, 0x57, 0x1d, 0xce, 0x51,
-	0x98, 0x24, 0x78, 0x49, 0xe1, 0xb2, 0x42, 0xb7, 0xee, 0x53, 0xe8, 0xf6, 0x9a, 0x42, 0xff, 0x0a,
-	0xde, 0xdb, 0x5c, 0xc2, 0x3b, 0xb5, 0xf9, 0x33, 0xe8, 0xe0, 0xfd, 0x03, 0xf4, 0xce, 0x29, 0xf4,
-	0xe3, 0x5b, 0x14, 0x9a, 0x8e, 0x9d, 0xb4, 0x73, 0xbb, 0x8c, 0x5a, 0xff, 0x65, 0x0b, 0xda, 0xd9,
-	0x38, 0xb0, 0xa9, 0x78, 0x0a, 0xad, 0x65, 0xc1, 0x30, 0xdf, 0x15, 0xab, 0x5a, 0x16, 0xc4, 0x94,
-	0x3f, 0x85, 0x96, 0x9f, 0x4a, 0x69, 0x78, 0x1d, 0xf2, 0x2b, 0x1e, 0x5a, 0xf6, 0x34, 0x2d, 0xf8,
-	0xda, 0x60, 0x26, 0xf7, 0x59, 0x4b, 0xd8, 0x46, 0xa9, 0xe2, 0x1d, 0x5a, 0x19, 0x4a, 0x7d, 0xf2,
-	0x09, 0x1c, 0xfa, 0xe9, 0x22, 0x0d, 0x3d, 0xcd, 0x03, 0x57, 0x99, 0xb6, 0x26, 0x67, 0x62, 0x17,
-	0x5b, 0xda, 0x2e, 0x78, 0x14, 0x2c, 0x77, 0x44, 0xfc, 0x6b, 0xd3, 0x59, 0xe8, 0x2e, 0x22, 0xcd,
-	0xe5, 0x95, 0x17, 0x5a, 0xe2, 0x31, 0x63, 0x9b, 0xa0, 0x69, 0x6c, 0x2d, 0x46, 0x39, 0x70, 0x87,
-	0x01, 0x51, 0x3d, 0xb0, 0x0f, 0x89, 0x88, 0x5d, 0x63, 0xb9, 0xb0, 0x06, 0xdb, 0x84, 0xfb, 0xde,
-	0x6c, 0x26, 0xf9, 0x0c, 0x43, 0xc2, 0x94, 0x29, 0x2b, 0xef, 0xdd, 0x95, 0x81, 0x06, 0xea, 0xe0,
-	0x6f, 0xdb, 0x76, 0xe0, 0xd9, 0xfc, 0x1d, 0xc3, 0x9e, 0x51, 0x8b, 0xd5, 0xbc, 0xdb, 0x35, 0xcb,
-	0x71, 0x60, 0x38, 0x86, 0x86, 0xdc, 0xb4, 0xab, 0x19, 0xe0, 0xde, 0x61, 0xf7, 0x31, 0x74, 0x16,
-	0x71, 0x24, 0x0c, 0x85, 0x12, 0x2e, 0x45, 0x1c, 0x28, 0x9b, 0x9b, 0xb6, 0x85, 0x3f, 0x27, 0xd4,
-	0x1c, 0xa2, 0x4c, 0x93, 0x0a, 0x7d, 0x6d, 0x47, 0xda, 0x72, 0x6d, 0xa6, 0x99, 0x95, 0x38, 0x1c,
-	0x22, 0xd9, 0x34, 0xb3, 0x98, 0x19, 0x22, 0xa6, 0x5e, 0x7e, 0x1c, 0x05, 0xc2, 0x88, 0x3b, 0x39,
-	0xd1, 0x9d, 0x5b, 0x4b, 0x14, 0xdd, 0xde, 0x07, 0xd0, 0x73, 0xc9, 0xd5, 0x3c, 0x0e, 0x03, 0x65,
-	0x67, 0x5a, 0x0e, 0x61, 0x0c, 0xb6, 0xd3, 0x48, 0x68, 0x3b, 0xc6, 0xf0, 0x7f, 0x93, 0x51, 0xdf,
-	0xd0, 0xd9, 0x4f, 0x73, 0x6c, 0x00, 0xbc, 0x44, 0x37, 0x67, 0xa0, 0xf2, 0x3a, 0xb0, 0x27, 0xa2,
-	0xb9, 0xb8, 0x14, 0x1a, 0x87, 0x58, 0x6d, 0x92, 0x2d, 0x4d, 0x37, 0x51, 0xc0, 0x85, 0x09, 0x46,
-	0x10, 0xa6, 0xf1, 0x07, 0x50, 0xcf, 0x78, 0xaa, 0xb0, 0x1b, 0x1b, 0x67, 0xfd, 0xf5, 0x8e, 0x28,
-	0x32, 0x7e, 0xb2, 0xda, 0x52, 0x7e, 0xaf, 0x68, 0xbf, 0xd5, 0x7b, 0xc5, 0xa7, 0xd0, 0x48, 0x93,
-	0x60, 0xb9, 0xb9, 0x73, 0xff, 0x66, 0x72, 0x37, 0xc0, 0xe0, 0x9f, 0x55, 0xe8, 0x15, 0x84, 0xc0,
-	0x06, 0xf7, 0xad, 0x94, 0x7f, 0x53, 0xa4, 0x3c, 0xdf, 0xf9, 0x1d, 0x34, 0xda, 0xce, 0x1f, 0xfc,
-	0xb2, 0x34, 0xa6, 0xb3, 0xd2, 0x3e, 0x48, 0xe2, 0xe9, 0xae, 0x0f, 0x90, 0x78, 0x7b, 0x6a, 0x3b,
-	0xb7, 0xcb, 0x48, 0xfc, 0x9f, 0x77, 0xa0, 0xf5, 0x63, 0xa1, 0x74, 0x2c, 0xaf, 0xed, 0x2b, 0xf9,
-	0x63, 0x80, 0x39, 0x01, 0x2b, 0x91, 0xaa, 0x5b, 0x64, 0x1c, 0x18, 0xa5, 0xc8, 0xcc, 0x39, 0xa9,
-	0x6a, 0x58, 0x0c, 0xcb, 0x94, 0xbb, 0x69, 0xf5, 0x76, 0x8d, 0xdb, 0x2e, 0x69, 0xdc, 0x21, 0xec,
-	0xf0, 0x2b, 0x1e, 0x69, 0xab, 0x4d, 0xb4, 0x30, 0xac, 0x8a, 0x62, 0x2d, 0xa6, 0xc2, 0xc7, 0xef,
-	0x52, 0x73, 0xa6, 0x65, 0x55, 0x1e, 0x1e, 0x07, 0x6c, 0x04, 0x07, 0x05, 0x47, 0x4b, 0x02, 0xd2,
-	0x28, 0x96, 0x37, 0x59, 0x26, 0xe4, 0xe5, 0xb0, 0x56, 0x92, 0xc3, 0xf2, 0xeb, 0x74, 0x7d, 0xed,
-	0x75, 0x7a, 0xfd, 0xf5, 0x1d, 0x36, 0xbc, 0xbe, 0x97, 0x14, 0xa9, 0xb1, 0xa6, 0x48, 0xeb, 0xa2,
-	0xda, 0xbc, 0x5f, 0x54, 0x5b, 0xb7, 0x8a, 0x6a, 0x3b, 0x27, 0xaa, 0xc5, 0x5e, 0xea, 0x94, 0xbf,
-	0x9f, 0x36, 0x7c, 0x59, 0x74, 0x37, 0x7d, 0x59, 0xac, 0x0d, 0xfc, 0xfd, 0x0d, 0x03, 0xbf, 0x24,
-	0x8c, 0xec, 0x7f, 0x11, 0xc6, 0x83, 0xb7, 0x12, 0xc6, 0x3f, 0x55, 0x57, 0x6f, 0x48, 0x05, 0x1e,
-	0x7f, 0x23, 0xa5, 0xb1, 0xd8, 0x7b, 0x24, 0x8e, 0x77, 0xf4, 0x1e, 0x09, 0x64, 0xa1, 0xf7, 0x8a,
-	0x55, 0xaf, 0x97, 0x15, 0xb4, 0xd0, 0x81, 0x24, 0x90, 0x1b, 0x3a, 0x90, 0x74, 0xd1, 0x76, 0x60,
-	0xae, 0x9b, 0x9b, 0x79, 0xdd, 0x5a, 0x67, 0x06, 0x89, 0x62, 0x91, 0x19, 0x47, 0xb0, 0x4b, 0x5f,
-	0x38, 0xc8, 0xcd, 0xda, 0xc4, 0xae, 0x06, 0xbf, 0xa9, 0xc0, 0xe3, 0x5b, 0xea, 0x76, 0xa7, 0xee,
-	0xbd, 0x86, 0x7d, 0x7b, 0xdd, 0xb5, 0x97, 0xdb, 0x27, 0xeb, 0xca, 0x57, 0x3c, 0xb9, 0x5b, 0xd8,
-	0x79, 0xc1, 0xf5, 0xd9, 0x7f, 0x76, 0x80, 0xa1, 0x3a, 0xfe, 0xd4, 0x8b, 0xbc, 0x19, 0x97, 0x2f,
-	0xf1, 0x97, 0x37, 0xf6, 0xf7, 0x4a, 0x69, 0xda, 0x16, 0x7e, 0x18, 0x61, 0xcf, 0xd7, 0x1f, 0x74,
-	0xef, 0xaf, 0x5e, 0xbd, 0x17, 0x6f, 0xb7, 0x89, 0x92, 0x30, 0x18, 0xdf, 0x9c, 0x7f, 0xc4, 0x3e,
-	0x0c, 0xac, 0x63, 0x9f, 0xc4, 0xbb, 0xff, 0x46, 0xe8, 0x79, 0x3f, 0x4b, 0x73, 0x9f, 0x18, 0xf5,
-	0xeb, 0x7f, 0xfc, 0xeb, 0xb7, 0x5b, 0x3d, 0xe6, 0x8c, 0xae, 0xbe, 0x37, 0x22, 0x37, 0xd7, 0xb8,
-	0xb9, 0x99, 0x1b, 0xfb, 0x7d, 0x05, 0x0e, 0x37, 0x7d, 0x4b, 0xb0, 0xd3, 0x7b, 0x22, 0x2b, 0x7e,
-	0x36, 0xf6, 0x86, 0x0f, 0x75, 0xb7, 0x57, 0x78, 0x71, 0x73, 0xee, 0xb0, 0xa3, 0xe2, 0x15, 0xfa,
-	0x54, 0x02, 0x85, 0x41, 0x1f, 0xb0, 0xfd, 0x65, 0xd0, 0xae, 0x35, 0xb0, 0xdf, 0x55, 0xe0, 0x60,
-	0xc3, 0x54, 0x64, 0xcf, 0xee, 0x79, 0x7a, 0xe1, 0xbd, 0xa8, 0x77, 0xfa, 0x40, 0x6f, 0x1b, 0xea,
-	0xd9, 0xcd, 0xf9, 0x31, 0x7b, 0x54, 0x0a, 0x95, 0x06, 0x09, 0x46, 0xca, 0x58, 0x77, 0x15, 0x29,
-	0xe1, 0xec, 0x8f, 0x15, 0x78, 0xb4, 0x91, 0xc8, 0xec, 0x8e, 0x44, 0x6d, 0x52, 0xaa, 0xde, 0xe8,
-	0xc1, 0xfe, 0x36, 0xdc, 0xef, 0xdf, 0x9c, 0xbf, 0xc3, 0x8e, 0x97, 0xe1, 0x5a, 0x76, 0xdb, 0xdc,
-	0x62, 0xc0, 0x8f, 0xd8, 0x81, 0x09, 0x38, 0x13, 0x0d, 0x9b, 0xdc, 0x1f, 0x6e, 0xff, 0x7c, 0x2b,
-	0xb9, 0xbc, 0xdc, 0x45, 0x69, 0x7d, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xc1, 0x70,
-	0xc4, 0x70, 0x16, 0x00, 0x00,
+	// 1767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x24, 0x49,
+	0x11, 0x56, 0x4f, 0xfb, 0xa7, 0x3b, 0xfa, 0xd7, 0x69, 0x8f, 0x5d, 0xdb, 0x3b, 0xb3, 0x53, 0xdb,
+	0xb3, 0xec, 0x5a, 0x62, 0x6c, 0x2f, 0x9e, 0x91, 0x38, 0xac, 0x84, 0x64, 0x66, 0x58, 0xd1, 0x62,
+	0x40, 0xab, 0xf2, 0x4a, 0x2b, 0x71, 0x29, 0x95, 0xab, 0xb2, 0xbb, 0x53, 0x5b, 0x5d, 0x55, 0x64,
+	0x66, 0x79, 0xd6, 0x82, 0x13, 0xbc, 0x81, 0x79, 0x07, 0x2e, 0x88, 0x03, 0x07, 0x2e, 0x48, 0x1c,
+	0x90, 0x78, 0x02, 0x38, 0x71, 0xe7, 0xc2, 0x9d, 0x07, 0x40, 0x19, 0x91, 0xd5, 0x5d, 0xd5, 0xdd,
+	0xfe, 0x19, 0xc1, 0x65, 0xa5, 0x39, 0xd9, 0xf1, 0x45, 0x64, 0x56, 0x64, 0xfc, 0x7c, 0x91, 0xd9,
+	0xd0, 0x0e, 0x73, 0xa5, 0xd3, 0xd9, 0x71, 0x26, 0x53, 0x9d, 0xb2, 0xfe, 0xd7, 0xf9, 0x05, 0x57,
+	0xd9, 0x94, 0x4b, 0x7e, 0x1c, 0xc4, 0x5c, 0xea, 0xc1, 0xa3, 0x49, 0x9a, 0x4e, 0x62, 0x7e, 0x12,
+	0x64, 0xe2, 0x24, 0x48, 0x92, 0x54, 0x07, 0x5a, 0xa4, 0x89, 0x22, 0xfb, 0xc1, 0x07, 0x56, 0x8b,
+	0xd2, 0x45, 0x3e, 0x3e, 0x79, 0x23, 0x83, 0x2c, 0xe3, 0xb2, 0xd0, 0x3f, 0xc3, 0x3f, 0xe1, 0xd1,
+	0x84, 0x27, 0x47, 0xea, 0x4d, 0x30, 0x99, 0x70, 0x79, 0x92, 0x66, 0xb8, 0xc3, 0x9a, 0xdd, 0x9e,
+	0x2c, 0xef, 0xa6, 0xc5, 0x8c, 0x2b, 0x1d, 0xcc, 0x32, 0x6b, 0xd0, 0x42, 0x9f, 0x48, 0x18, 0xfe,
+	0xa1, 0x0e, 0x1f, 0xbe, 0xe2, 0x2a, 0x94, 0xe2, 0x82, 0x9f, 0x19, 0x5c, 0x7d, 0x25, 0xf4, 0xd4,
+	0xe3, 0x2a, 0xcd, 0x65, 0xc8, 0x3d, 0xfe, 0x8b, 0x9c, 0x2b, 0xcd, 0x9e, 0x40, 0x4b, 0xf1, 0x40,
+	0x86, 0x53, 0xff, 0x4d, 0x2a, 0x23, 0xa7, 0xe6, 0xd6, 0x0e, 0x9b, 0x1e, 0x10, 0xf4, 0x55, 0x2a,
+	0x23, 0xf6, 0x1e, 0x34, 0x54, 0x2a, 0xb5, 0xff, 0x35, 0xbf, 0x72, 0x1e, 0xa0, 0x76, 0xdb, 0xc8,
+	0x3f, 0xe1, 0x57, 0xcc, 0x81, 0x6d, 0xc9, 0x2f, 0xb9, 0x54, 0xdc, 0xa9, 0xbb, 0xb5, 0xc3, 0x86,
+	0x57, 0x88, 0x6c, 0x1f, 0xb6, 0xd2, 0xf1, 0x58, 0x71, 0xed, 0x6c, 0xb8, 0xb5, 0xc3, 0x8e, 0x67,
+	0x25, 0xb6, 0x07, 0x9b, 0xb1, 0x98, 0x09, 0xed, 0x6c, 0x22, 0x4c, 0x02, 0xfb, 0x04, 0x7a, 0xd2,
+	0xba, 0xe5, 0xd3, 0x97, 0x9d, 0x2d, 0xfc, 0x52, 0xb7, 0x80, 0xcf, 0x11, 0x35, 0xbe, 0xe0, 0x09,
+	0x7d, 0x11, 0x39, 0xdb, 0x6e, 0xdd, 0xf8, 0x82, 0xf2, 0x28, 0x62, 0x8f, 0x01, 0x48, 0x95, 0x04,
+	0x33, 0xee, 0x34, 0x50, 0xd9, 0x44, 0xe4, 0x67, 0xc1, 0x8c, 0xb3, 0x01, 0x34, 0x22, 0xa1, 0x82,
+	0x8b, 0x98, 0x47, 0x4e, 0xd3, 0xad, 0x1f, 0x36, 0xbc, 0xb9, 0xcc, 0xbe, 0x03, 0x5d, 0x99, 0x27,
+	0x89, 0x48, 0x26, 0xbe, 0xd2, 0x81, 0xce, 0x95, 0x03, 0xb8, 0xbc, 0x63, 0xd1, 0x73, 0x04, 0xd9,
+	0xfb, 0xd0, 0xcc, 0xd2, 0x58, 0x84, 0x57, 0xe6, 0xeb, 0x2d, 0xb4, 0x68, 0x10, 0x30, 0x8a, 0x98,
+	0x0b, 0x6d, 0xa9, 0xfc, 0xb1, 0x88, 0x35, 0x97, 0x46, 0xdf, 0x46, 0x3d, 0x48, 0xf5, 0x39, 0x42,
+	0xa3, 0xc8, 0x04, 0x9a, 0x7f, 0xc3, 0xc3, 0x5c, 0xa7, 0x68, 0xd0, 0x21, 0x83, 0x02, 0x1a, 0x45,
+	0xc3, 0x0c, 0x86, 0xb7, 0xa5, 0x4b, 0x65, 0x69, 0xa2, 0xb8, 0x89, 0xa0, 0x4e, 0x75, 0x10, 0x63,
+	0xa6, 0x3a, 0x1e, 0x09, 0xec, 0x05, 0xd0, 0x59, 0x7d, 0x13, 0xf2, 0x07, 0x6e, 0xfd, 0xb0, 0x75,
+	0x7a, 0x70, 0xbc, 0x5c, 0xab, 0xc7, 0xb8, 0xad, 0x47, 0x21, 0x3c, 0xe7, 0x7a, 0xf8, 0xef, 0x2d,
+	0x68, 0x21, 0xf6, 0x8a, 0xeb, 0x40, 0xc4, 0x95, 0xf0, 0x52, 0x21, 0xdc, 0x10, 0x5e, 0xaa, 0x83,
+	0x1b, 0xc2, 0x4b, 0xa5, 0xb0, 0x08, 0xef, 0x67, 0xd0, 0x0a, 0x25, 0x0f, 0x34, 0xf7, 0x4d, 0xb9,
+	0x62, 0x41, 0xb4, 0x4e, 0x07, 0xc7, 0x54, 0xcb, 0xc7, 0x45, 0x2d, 0x1f, 0x7f, 0x59, 0xd4, 0xb2,
+	0x07, 0x64, 0x6e, 0x80, 0x35, 0xb9, 0xd9, 0x74, 0x6b, 0xab, 0xb9, 0xf9, 0x10, 0xda, 0xf6, 0xfc,
+	0x64, 0x44, 0xe5, 0x43, 0xed, 0xb0, 0x2e, 0x7d, 0xdb, 0x6e, 0xad, 0x92, 0xbe, 0x8f, 0xa0, 0xbb,
+	0x48, 0x9f, 0xad, 0x20, 0x63, 0xd1, 0x2e, 0x12, 0x88, 0xa7, 0xfc, 0x18, 0x7a, 0x0b, 0xab, 0x2c,
+	0x90, 0xc1, 0xcc, 0x69, 0x5a, 0x6f, 0xac, 0xd9, 0x17, 0x06, 0xb4, 0xc5, 0xa0, 0xaf, 0x32, 0x4e,
+	0x7b, 0x01, 0x35, 0x95, 0x54, 0x5f, 0x5e, 0x65, 0x1c, 0x77, 0x5a, 0x2a, 0x86, 0x16, 0x19, 0x2c,
+	0x8a, 0xc1, 0x18, 0x58, 0x6f, 0x71, 0x87, 0x36, 0x19, 0x10, 0x84, 0x3b, 0x1c, 0x01, 0xb3, 0x06,
+	0x11, 0x16, 0x0d, 0x92, 0x86, 0xd3, 0x41, 0xbb, 0x1d, 0xd2, 0xbc, 0x5a, 0x28, 0xd8, 0x53, 0xe8,
+	0x58, 0xf3, 0x30, 0x4d, 0xc6, 0x62, 0xe2, 0x74, 0xe9, 0x7c, 0x04, 0xbe, 0x44, 0xcc, 0xf4, 0x33,
+	0x86, 0x3e, 0x95, 0x4e, 0x8f, 0xd2, 0x6f, 0x45, 0xf6, 0x29, 0xec, 0x05, 0x97, 0x81, 0x88, 0x4d,
+	0x46, 0x4d, 0x8c, 0xa5, 0xa6, 0x64, 0xf6, 0xd1, 0x8c, 0xcd, 0x75, 0xe7, 0x46, 0x85, 0x89, 0x7b,
+	0x06, 0x0b, 0xd4, 0xe7, 0x49, 0x44, 0xf6, 0x3b, 0x68, 0xdf, 0x9f, 0x6b, 0x7e, 0x94, 0x44, 0x68,
+	0x3d, 0x80, 0x46, 0x1c, 0x24, 0x93, 0x3c, 0x98, 0x70, 0x87, 0x51, 0x6e, 0x0a, 0xd9, 0x78, 0x35,
+	0xe3, 0x5a, 0x8a, 0x50, 0x39, 0xbb, 0xd4, 0xf3, 0x56, 0x34, 0x41, 0x92, 0x79, 0xcc, 0x95, 0x1f,
+	0xa6, 0x79, 0xa2, 0x9d, 0x3d, 0xec, 0x08, 0x40, 0xe8, 0xa5, 0x41, 0x0c, 0xb1, 0x64, 0xa9, 0x12,
+	0x5a, 0x5c, 0xce, 0x8d, 0x1e, 0xa2, 0x51, 0x77, 0x0e, 0x93, 0xe1, 0x73, 0xd8, 0x9f, 0xa5, 0x4a,
+	0xfb, 0x92, 0x87, 0x3c, 0xd1, 0x3e, 0xd5, 0x12, 0x7a, 0xbc, 0x8f, 0xde, 0xec, 0x1a, 0xad, 0x87,
+	0x4a, 0x6c, 0x18, 0x74, 0xfa, 0xbb, 0xc0, 0x92, 0xb1, 0x1f, 0x44, 0x91, 0xe4, 0x4a, 0xf9, 0xb1,
+	0x50, 0xd8, 0x38, 0x07, 0xb8, 0xa0, 0x97, 0x8c, 0xcf, 0x48, 0xf1, 0x5a, 0x28, 0x3d, 0x8a, 0x86,
+	0x7f, 0xad, 0xc3, 0xfb, 0x95, 0xf6, 0xa6, 0x9e, 0x53, 0xef, 0x78, 0xf8, 0xff, 0xca, 0xc3, 0xa5,
+	0x12, 0x26, 0x0a, 0x2e, 0xc4, 0x15, 0x86, 0xee, 0xdc, 0xc5, 0xd0, 0xdd, 0x15, 0x86, 0xfe, 0x15,
+	0x3c, 0x5a, 0x9f, 0xc2, 0x5b, 0xb9, 0xf9, 0x73, 0xe8, 0xe1, 0xf9, 0x23, 0xb4, 0x2e, 0x31, 0xf4,
+	0xe3, 0x1b, 0x18, 0x9a, 0xb6, 0xf5, 0xba, 0xa5, 0x55, 0x86, 0xad, 0xff, 0x5c, 0x87, 0x6e, 0x31,
+	0x0e, 0x6c, 0x28, 0x9e, 0x42, 0x67, 0x9e, 0x30, 0x8c, 0x77, 0xcd, 0xb2, 0x96, 0x05, 0x31, 0xe4,
+	0x4f, 0xa1, 0x13, 0xe6, 0x52, 0x9a, 0xba, 0x8e, 0xf9, 0x25, 0x8f, 0x6d, 0xf5, 0xb4, 0x2d, 0xf8,
+	0xda, 0x60, 0x26, 0xf6, 0x45, 0x4b, 0xd8, 0x46, 0xa9, 0xe3, 0x19, 0x3a, 0x05, 0x4a, 0x7d, 0xf2,
+	0x29, 0xec, 0x85, 0xf9, 0x2c, 0x8f, 0x03, 0xcd, 0x23, 0x5f, 0x99, 0xb6, 0x26, 0x63, 0xaa, 0x2e,
+	0x36, 0xd7, 0x9d, 0xf3, 0x24, 0x9a, 0xaf, 0x48, 0xf8, 0x37, 0xa6, 0xb3, 0xd0, 0x5c, 0x24, 0x9a,
+	0xcb, 0xcb, 0x20, 0xb6, 0x85, 0xc7, 0x8c, 0xce, 0x43, 0xd5, 0xc8, 0x6a, 0x0c, 0x73, 0xe0, 0x0a,
+	0x03, 0x22, 0x7b, 0x60, 0x1f, 0x52, 0x21, 0xf6, 0x8d, 0xe6, 0xdc, 0x2a, 0x6c, 0x13, 0xee, 0x04,
+	0x93, 0x89, 0xe4, 0x13, 0x74, 0x09, 0x43, 0xa6, 0x2c, 0xbd, 0xf7, 0x17, 0x0a, 0x1a, 0xa8, 0xec,
+	0x00, 0xb6, 0x93, 0xd4, 0x8f, 0x02, 0x1d, 0x20, 0xbf, 0x37, 0xbc, 0xad, 0x24, 0x7d, 0x15, 0xe8,
+	0xc0, 0x1c, 0x3f, 0x31, 0x96, 0x8b, 0xe3, 0x37, 0xe9, 0xf8, 0x05, 0x4a, 0x87, 0x19, 0x40, 0x63,
+	0x1c, 0x07, 0x59, 0x26, 0x92, 0x09, 0x92, 0x7a, 0xc3, 0x9b, 0xcb, 0xec, 0x11, 0x34, 0x45, 0x32,
+	0x15, 0x17, 0x42, 0x73, 0x22, 0xf4, 0x86, 0xb7, 0x00, 0x86, 0x7f, 0xdb, 0xb0, 0xa3, 0xd6, 0x66,
+	0xee, 0x00, 0xb6, 0x0d, 0x4f, 0x2d, 0x26, 0xed, 0x96, 0x11, 0x47, 0x91, 0xa9, 0x6e, 0x54, 0x94,
+	0xe6, 0x6c, 0xc3, 0x00, 0x77, 0x8e, 0xd9, 0x4f, 0xa0, 0x37, 0x4b, 0x13, 0x61, 0x8a, 0x37, 0xe3,
+	0x52, 0xa4, 0x91, 0xb2, 0x59, 0xe9, 0x5a, 0xf8, 0x0b, 0x42, 0xcd, 0x26, 0xca, 0xd0, 0x83, 0xd0,
+	0x57, 0x76, 0x98, 0xce, 0x65, 0x33, 0x47, 0x2d, 0xb9, 0xe2, 0xf8, 0x2a, 0xe6, 0xa8, 0xc5, 0xcc,
+	0xf8, 0x32, 0xa1, 0x0a, 0xd3, 0x24, 0x12, 0x66, 0xac, 0x90, 0x11, 0x45, 0xbb, 0x33, 0x47, 0xd1,
+	0xec, 0x03, 0x00, 0x3d, 0x95, 0x5c, 0x4d, 0xd3, 0x38, 0x52, 0x76, 0x9a, 0x96, 0x10, 0xc6, 0x60,
+	0x23, 0x4f, 0x84, 0xb6, 0x03, 0x14, 0xff, 0x37, 0xb9, 0x0c, 0x4d, 0x23, 0x85, 0x79, 0x29, 0x11,
+	0x80, 0x87, 0xe8, 0x97, 0x14, 0x94, 0x0b, 0x07, 0xb6, 0x6d, 0x78, 0x6d, 0xb4, 0x0b, 0xd1, 0xf4,
+	0x31, 0x39, 0x5c, 0x99, 0x9d, 0x04, 0x61, 0x18, 0x7f, 0x00, 0xcd, 0xa2, 0x43, 0x14, 0xf2, 0x40,
+	0xeb, 0xd4, 0x5d, 0xed, 0xc5, 0x6a, 0xaf, 0x79, 0x8b, 0x25, 0xcb, 0x37, 0x9a, 0xee, 0x5b, 0xdd,
+	0x68, 0x3e, 0x83, 0x56, 0x9e, 0x45, 0xf3, 0xc5, 0xbd, 0xbb, 0x17, 0x93, 0xb9, 0x01, 0x86, 0xff,
+	0xac, 0xc3, 0xa0, 0x42, 0x41, 0xd6, 0xb9, 0x77, 0x43, 0xe4, 0xdb, 0x32, 0x44, 0xca, 0x9d, 0xdf,
+	0x73, 0xeb, 0x8b, 0xce, 0x1f, 0xfe, 0x72, 0xe9, 0x82, 0x50, 0xa4, 0xf6, 0x5e, 0xc3, 0x85, 0xce,
+	0x7a, 0x8f, 0xe1, 0x62, 0x77, 0xed, 0x96, 0x56, 0x99, 0xe1, 0xf2, 0x97, 0x4d, 0xe8, 0xfc, 0x58,
+	0x28, 0x9d, 0xca, 0x2b, 0xfb, 0x18, 0x78, 0x0c, 0x30, 0x25, 0x60, 0x41, 0x52, 0x4d, 0x8b, 0x8c,
+	0x22, 0xc3, 0x14, 0x85, 0xba, 0x44, 0x55, 0x2d, 0x8b, 0x61, 0x9a, 0x4a, 0x27, 0xad, 0xdf, 0xcc,
+	0x71, 0x1b, 0x4b, 0x1c, 0xb7, 0x07, 0x9b, 0xfc, 0x92, 0x27, 0xda, 0x72, 0x13, 0x09, 0xa6, 0xaa,
+	0x92, 0x54, 0x8b, 0xb1, 0x08, 0xf1, 0x45, 0x6c, 0xf6, 0xb4, 0x55, 0x55, 0x86, 0x47, 0x11, 0x3b,
+	0x81, 0xdd, 0x8a, 0xa1, 0x2d, 0x02, 0xe2, 0x28, 0x56, 0x56, 0xd9, 0x4a, 0x28, 0xd3, 0x61, 0x63,
+	0x89, 0x0e, 0x97, 0x2f, 0xf2, 0xcd, 0x95, 0x8b, 0xfc, 0xea, 0xc3, 0x01, 0xd6, 0x3c, 0x1c, 0x96,
+	0x18, 0xa9, 0xb5, 0xc2, 0x48, 0xab, 0xa4, 0xda, 0xbe, 0x9b, 0x54, 0x3b, 0x37, 0x92, 0x6a, 0xb7,
+	0x44, 0xaa, 0xd5, 0x5e, 0xea, 0x2d, 0xbf, 0xdc, 0xd6, 0xbc, 0x69, 0xfa, 0xeb, 0xde, 0x34, 0x2b,
+	0x57, 0x8d, 0x9d, 0x35, 0x57, 0x8d, 0x25, 0x62, 0x64, 0xff, 0x0b, 0x31, 0xee, 0xbe, 0x15, 0x31,
+	0xfe, 0xa9, 0xbe, 0xb8, 0x9b, 0x55, 0xea, 0xf8, 0x5b, 0x49, 0x8d, 0xd5, 0xde, 0x23, 0x72, 0xbc,
+	0xa5, 0xf7, 0x88, 0x20, 0x2b, 0xbd, 0x57, 0xcd, 0x7a, 0x73, 0x99, 0x41, 0x2b, 0x1d, 0x48, 0x04,
+	0xb9, 0xa6, 0x03, 0x89, 0x17, 0x49, 0x28, 0x77, 0x73, 0xbb, 0xcc, 0x5b, 0xab, 0x95, 0x41, 0xa4,
+	0x58, 0xad, 0x8c, 0x7d, 0xd8, 0xa2, 0xb7, 0x15, 0xd6, 0x66, 0xc3, 0xb3, 0xd2, 0xf0, 0x37, 0x35,
+	0x78, 0x7c, 0x43, 0xde, 0x6e, 0xe5, 0xbd, 0xd7, 0xb0, 0x63, 0x8f, 0xbb, 0x72, 0xad, 0x7e, 0xb2,
+	0xca, 0x7c, 0xd5, 0x9d, 0xfb, 0x95, 0x95, 0xe7, 0x5c, 0x9f, 0xfe, 0x67, 0x13, 0x18, 0xb2, 0xe3,
+	0x4f, 0x83, 0x24, 0x98, 0x70, 0xf9, 0x12, 0x7f, 0xf3, 0x63, 0x7f, 0xaf, 0xc1, 0xe0, 0xe6, 0x9f,
+	0x64, 0xd8, 0xf3, 0xd5, 0x0f, 0xdd, 0xf9, 0x7b, 0xdb, 0xe0, 0xc5, 0xdb, 0x2d, 0xa2, 0x20, 0x0c,
+	0x47, 0xd7, 0x67, 0x1f, 0xb3, 0x8f, 0x22, 0x6b, 0xe8, 0xe2, 0x3a, 0xe5, 0xbe, 0x11, 0x7a, 0xea,
+	0x16, 0x61, 0x76, 0xa9, 0xa2, 0x7e, 0xfd, 0x8f, 0x7f, 0xfd, 0xf6, 0xc1, 0x80, 0x39, 0x27, 0x97,
+	0xdf, 0x3b, 0x21, 0x33, 0xdf, 0x98, 0xf9, 0x85, 0x19, 0xfb, 0x7d, 0x0d, 0xf6, 0xd6, 0xbd, 0x62,
+	0xd8, 0xd1, 0x1d, 0x9e, 0x55, 0x1f, 0xac, 0x83, 0xe3, 0xfb, 0x9a, 0xdb, 0x23, 0xbc, 0xb8, 0x3e,
+	0x73, 0xd8, 0x7e, 0xf5, 0x08, 0x2e, 0xa5, 0x40, 0xa1, 0xd3, 0xbb, 0x6c, 0x67, 0xee, 0xb4, 0x6f,
+	0x15, 0xec, 0x77, 0x35, 0xd8, 0x5d, 0x33, 0x15, 0xd9, 0xb3, 0x3b, 0xbe, 0x5e, 0xb9, 0x17, 0x0d,
+	0x8e, 0xee, 0x69, 0x6d, 0x5d, 0x3d, 0xbd, 0x3e, 0x3b, 0x60, 0x0f, 0x97, 0x5c, 0xa5, 0x41, 0x82,
+	0x9e, 0x32, 0xd6, 0x5f, 0x78, 0x4a, 0x38, 0xfb, 0x63, 0x0d, 0x1e, 0xae, 0x2d, 0x64, 0x76, 0x4b,
+	0xa0, 0xd6, 0x31, 0xd5, 0xe0, 0xe4, 0xde, 0xf6, 0xd6, 0xdd, 0xef, 0x5f, 0x9f, 0xbd, 0xc7, 0x0e,
+	0xe6, 0xee, 0xda, 0xea, 0xb6, 0xb1, 0x45, 0x87, 0x1f, 0xb2, 0x5d, 0xe3, 0xb0, 0xd5, 0x14, 0xc1,
+	0xfd, 0xe1, 0xc6, 0xcf, 0x1f, 0x64, 0x17, 0x17, 0x5b, 0x48, 0xad, 0xcf, 0xff, 0x3b, 0x00, 0xc6,
+	0xc5, 0x60, 0x82, 0xea, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -17,6 +17,7 @@ import (
 	"kubesphere.io/alert/pkg/logger"
 	"kubesphere.io/alert/pkg/models"
 	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
 	"kubesphere.io/alert/pkg/util/stringutil"
 )
 
@@ -868,18 +869,30 @@ func CreateRule(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.CreateRuleRequest{
-		RuleName:         rule.RuleName,
-		Disabled:         rule.Disabled,
-		MonitorPeriods:   rule.MonitorPeriods,
-		Severity:         rule.Severity,
-		MetricsType:      rule.MetricsType,
-		ConditionType:    rule.ConditionType,
-		Thresholds:       rule.Thresholds,
-		Unit:             rule.Unit,
-		ConsecutiveCount: rule.ConsecutiveCount,
-		Inhibit:          rule.Inhibit,
-		PolicyId:         rule.PolicyId,
-		MetricId:         rule.MetricId,
+		RuleName:                 rule.RuleName,
+		Disabled:                 rule.Disabled,
+		MonitorPeriods:           rule.MonitorPeriods,
+		Severity:                 rule.Severity,
+		MetricsType:              rule.MetricsType,
+		ConditionType:            rule.ConditionType,
+		Thresholds:               rule.Thresholds,
+		Unit:                     rule.Unit,
+		ConsecutiveCount:         rule.ConsecutiveCount,
+		Inhibit:                  rule.Inhibit,
+		Aggregation:              rule.Aggregation,
+		NoDataBehavior:           rule.NoDataBehavior,
+		RecoveryThresholds:       rule.RecoveryThresholds,
+		ConsecutiveRecoveryCount: rule.ConsecutiveRecoveryCount,
+		Levels:                   rule.Levels,
+		ForecastHorizon:          rule.ForecastHorizon,
+		EvaluationInterval:       rule.EvaluationInterval,
+		GroupCondition:           rule.GroupCondition,
+		MetricExpression:         rule.MetricExpression,
+		ThresholdSchedule:        rule.ThresholdSchedule,
+		OffsetWindow:             rule.OffsetWindow,
+		Script:                   rule.Script,
+		PolicyId:                 rule.PolicyId,
+		MetricId:                 rule.MetricId,
 	}
 
 	resp, err := client.CreateRule(ctx, req)
@@ -906,6 +919,10 @@ func DescribeRules(request *restful.Request, response *restful.Response) {
 	uints := strings.Split(request.QueryParameter("uints"), ",")
 	consecutiveCounts := parseUint32s(strings.Split(request.QueryParameter("consecutive_counts"), ","))
 	inhibits := parseBools(strings.Split(request.QueryParameter("inhibits"), ","))
+	aggregations := strings.Split(request.QueryParameter("aggregations"), ",")
+	noDataBehaviors := strings.Split(request.QueryParameter("no_data_behaviors"), ",")
+	recoveryThresholds := strings.Split(request.QueryParameter("recovery_thresholds"), ",")
+	consecutiveRecoveryCounts := parseUint32s(strings.Split(request.QueryParameter("consecutive_recovery_counts"), ","))
 	policyIds := strings.Split(request.QueryParameter("policy_ids"), ",")
 	metricIds := strings.Split(request.QueryParameter("metric_ids"), ",")
 
@@ -925,23 +942,27 @@ func DescribeRules(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.DescribeRulesRequest{
-		RuleId:           ruleIds,
-		RuleName:         ruleNames,
-		Disabled:         disables,
-		MonitorPeriods:   monitorPeriods,
-		Severity:         severities,
-		MetricsType:      metricsTypes,
-		ConditionType:    conditionTypes,
-		Thresholds:       thresholds,
-		Unit:             uints,
-		ConsecutiveCount: consecutiveCounts,
-		Inhibit:          inhibits,
-		PolicyId:         policyIds,
-		MetricId:         metricIds,
-		SortKey:          sortKey,
-		Reverse:          reverse,
-		Offset:           offset,
-		Limit:            limit,
+		RuleId:                   ruleIds,
+		RuleName:                 ruleNames,
+		Disabled:                 disables,
+		MonitorPeriods:           monitorPeriods,
+		Severity:                 severities,
+		MetricsType:              metricsTypes,
+		ConditionType:            conditionTypes,
+		Thresholds:               thresholds,
+		Unit:                     uints,
+		ConsecutiveCount:         consecutiveCounts,
+		Inhibit:                  inhibits,
+		Aggregation:              aggregations,
+		NoDataBehavior:           noDataBehaviors,
+		RecoveryThresholds:       recoveryThresholds,
+		ConsecutiveRecoveryCount: consecutiveRecoveryCounts,
+		PolicyId:                 policyIds,
+		MetricId:                 metricIds,
+		SortKey:                  sortKey,
+		Reverse:                  reverse,
+		Offset:                   offset,
+		Limit:                    limit,
 	}
 
 	resp, err := client.DescribeRules(ctx, req)
@@ -956,8 +977,21 @@ func DescribeRules(request *restful.Request, response *restful.Response) {
 	response.WriteAsJson(resp)
 }
 
+//ruleModification is the rule to modify, fields of pointers are modified only if present
+type ruleModification struct {
+	models.Rule
+	ConsecutiveRecoveryCount *uint32 `json:"consecutive_recovery_count"`
+	EvaluationInterval       *uint32 `json:"evaluation_interval"`
+	RecoveryThresholds       *string `json:"recovery_thresholds"`
+	Levels                   *string `json:"levels"`
+	GroupCondition           *string `json:"group_condition"`
+	MetricExpression         *string `json:"metric_expression"`
+	ThresholdSchedule        *string `json:"threshold_schedule"`
+	Script                   *string `json:"script"`
+}
+
 func ModifyRule(request *restful.Request, response *restful.Response) {
-	rule := new(models.Rule)
+	rule := new(ruleModification)
 
 	err := request.ReadEntity(&rule)
 	if err != nil {
@@ -988,6 +1022,34 @@ func ModifyRule(request *restful.Request, response *restful.Response) {
 		Unit:             rule.Unit,
 		ConsecutiveCount: rule.ConsecutiveCount,
 		Inhibit:          rule.Inhibit,
+		Aggregation:      rule.Aggregation,
+		NoDataBehavior:   rule.NoDataBehavior,
+		ForecastHorizon:  rule.ForecastHorizon,
+		OffsetWindow:     rule.OffsetWindow,
+	}
+	if rule.ConsecutiveRecoveryCount != nil {
+		req.ConsecutiveRecoveryCount = pbutil.ToProtoUInt32(*rule.ConsecutiveRecoveryCount)
+	}
+	if rule.EvaluationInterval != nil {
+		req.EvaluationInterval = pbutil.ToProtoUInt32(*rule.EvaluationInterval)
+	}
+	if rule.RecoveryThresholds != nil {
+		req.RecoveryThresholds = pbutil.ToProtoString(*rule.RecoveryThresholds)
+	}
+	if rule.Levels != nil {
+		req.Levels = pbutil.ToProtoString(*rule.Levels)
+	}
+	if rule.GroupCondition != nil {
+		req.GroupCondition = pbutil.ToProtoString(*rule.GroupCondition)
+	}
+	if rule.MetricExpression != nil {
+		req.MetricExpression = pbutil.ToProtoString(*rule.MetricExpression)
+	}
+	if rule.ThresholdSchedule != nil {
+		req.ThresholdSchedule = pbutil.ToProtoString(*rule.ThresholdSchedule)
+	}
+	if rule.Script != nil {
+		req.Script = pbutil.ToProtoString(*rule.Script)
 	}
 
 	resp, err := client.ModifyRule(ctx, req)
@@ -1395,18 +1457,30 @@ func createAlertInfo(resourceMap map[string]string, request *restful.Request, re
 	createRulesSuccess := true
 	for _, rule := range alertInfo.Rules {
 		var reqRule = &pb.CreateRuleRequest{
-			RuleName:         rule.RuleName,
-			Disabled:         rule.Disabled,
-			MonitorPeriods:   rule.MonitorPeriods,
-			Severity:         rule.Severity,
-			MetricsType:      rule.MetricsType,
-			ConditionType:    rule.ConditionType,
-			Thresholds:       rule.Thresholds,
-			Unit:             rule.Unit,
-			ConsecutiveCount: rule.ConsecutiveCount,
-			Inhibit:          rule.Inhibit,
-			PolicyId:         policyId,
-			MetricId:         rule.MetricId,
+			RuleName:                 rule.RuleName,
+			Disabled:                 rule.Disabled,
+			MonitorPeriods:           rule.MonitorPeriods,
+			Severity:                 rule.Severity,
+			MetricsType:              rule.MetricsType,
+			ConditionType:            rule.ConditionType,
+			Thresholds:               rule.Thresholds,
+			Unit:                     rule.Unit,
+			ConsecutiveCount:         rule.ConsecutiveCount,
+			Inhibit:                  rule.Inhibit,
+			Aggregation:              rule.Aggregation,
+			NoDataBehavior:           rule.NoDataBehavior,
+			RecoveryThresholds:       rule.RecoveryThresholds,
+			ConsecutiveRecoveryCount: rule.ConsecutiveRecoveryCount,
+			Levels:                   rule.Levels,
+			ForecastHorizon:          rule.ForecastHorizon,
+			EvaluationInterval:       rule.EvaluationInterval,
+			GroupCondition:           rule.GroupCondition,
+			MetricExpression:         rule.MetricExpression,
+			ThresholdSchedule:        rule.ThresholdSchedule,
+			OffsetWindow:             rule.OffsetWindow,
+			Script:                   rule.Script,
+			PolicyId:                 policyId,
+			MetricId:                 rule.MetricId,
 		}
 
 		_, err := client.CreateRule(ctx, reqRule)
@@ -2414,6 +2488,311 @@ func DeleteActions(request *restful.Request, response *restful.Response) {
 	response.WriteAsJson(resp)
 }
 
+func CreateRuleOverride(request *restful.Request, response *restful.Response) {
+	ruleOverride := new(models.RuleOverride)
+
+	err := request.ReadEntity(&ruleOverride)
+	if err != nil {
+		logger.Debug(nil, "CreateRuleOverride request data error %+v.", err)
+		response.WriteAsJson(&pb.CreateRuleOverrideResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.CreateRuleOverrideResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.CreateRuleOverrideRequest{
+		RuleId:             ruleOverride.RuleId,
+		ResourceName:       ruleOverride.ResourceName,
+		Thresholds:         ruleOverride.Thresholds,
+		RecoveryThresholds: ruleOverride.RecoveryThresholds,
+	}
+
+	resp, err := client.CreateRuleOverride(ctx, req)
+	if err != nil {
+		logger.Error(nil, "CreateRuleOverride failed: %+v", err)
+		response.WriteAsJson(&pb.CreateRuleOverrideResponse{})
+		return
+	}
+
+	logger.Debug(nil, "CreateRuleOverride success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DescribeRuleOverrides(request *restful.Request, response *restful.Response) {
+	overrideIds := strings.Split(request.QueryParameter("override_ids"), ",")
+	ruleIds := strings.Split(request.QueryParameter("rule_ids"), ",")
+	resourceNames := strings.Split(request.QueryParameter("resource_names"), ",")
+
+	sortKey := request.QueryParameter("sort_key")
+	reverse := parseBool(request.QueryParameter("reverse"))
+	offset, _ := parseUint32(request.QueryParameter("offset"))
+	limit, _ := parseUint32(request.QueryParameter("limit"))
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DescribeRuleOverridesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DescribeRuleOverridesRequest{
+		OverrideId:   overrideIds,
+		RuleId:       ruleIds,
+		ResourceName: resourceNames,
+		SortKey:      sortKey,
+		Reverse:      reverse,
+		Offset:       offset,
+		Limit:        limit,
+	}
+
+	resp, err := client.DescribeRuleOverrides(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DescribeRuleOverrides failed: %+v", err)
+		response.WriteAsJson(&pb.DescribeRuleOverridesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DescribeRuleOverrides success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func ModifyRuleOverride(request *restful.Request, response *restful.Response) {
+	ruleOverride := new(models.RuleOverride)
+
+	err := request.ReadEntity(&ruleOverride)
+	if err != nil {
+		logger.Debug(nil, "ModifyRuleOverride request data error %+v.", err)
+		response.WriteAsJson(&pb.ModifyRuleOverrideResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.ModifyRuleOverrideResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.ModifyRuleOverrideRequest{
+		OverrideId:         ruleOverride.OverrideId,
+		Thresholds:         ruleOverride.Thresholds,
+		RecoveryThresholds: ruleOverride.RecoveryThresholds,
+	}
+
+	resp, err := client.ModifyRuleOverride(ctx, req)
+	if err != nil {
+		logger.Error(nil, "ModifyRuleOverride failed: %+v", err)
+		response.WriteAsJson(&pb.ModifyRuleOverrideResponse{})
+		return
+	}
+
+	logger.Debug(nil, "ModifyRuleOverride success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DeleteRuleOverrides(request *restful.Request, response *restful.Response) {
+	overrideIds := strings.Split(request.QueryParameter("override_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DeleteRuleOverridesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DeleteRuleOverridesRequest{
+		OverrideId: overrideIds,
+	}
+
+	resp, err := client.DeleteRuleOverrides(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DeleteRuleOverrides failed: %+v", err)
+		response.WriteAsJson(&pb.DeleteRuleOverridesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DeleteRuleOverrides success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func CreateSlo(request *restful.Request, response *restful.Response) {
+	slo := new(models.Slo)
+
+	err := request.ReadEntity(&slo)
+	if err != nil {
+		logger.Debug(nil, "CreateSlo request data error %+v.", err)
+		response.WriteAsJson(&pb.CreateSloResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.CreateSloResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.CreateSloRequest{
+		SloName:       slo.SloName,
+		Target:        slo.Target,
+		WindowDays:    slo.WindowDays,
+		GoodMetricId:  slo.GoodMetricId,
+		TotalMetricId: slo.TotalMetricId,
+		BurnWindows:   slo.BurnWindows,
+		Disabled:      slo.Disabled,
+		PolicyId:      slo.PolicyId,
+	}
+
+	resp, err := client.CreateSlo(ctx, req)
+	if err != nil {
+		logger.Error(nil, "CreateSlo failed: %+v", err)
+		response.WriteAsJson(&pb.CreateSloResponse{})
+		return
+	}
+
+	logger.Debug(nil, "CreateSlo success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DescribeSlos(request *restful.Request, response *restful.Response) {
+	sloIds := strings.Split(request.QueryParameter("slo_ids"), ",")
+	sloNames := strings.Split(request.QueryParameter("slo_names"), ",")
+	policyIds := strings.Split(request.QueryParameter("policy_ids"), ",")
+
+	sortKey := request.QueryParameter("sort_key")
+	reverse := parseBool(request.QueryParameter("reverse"))
+	offset, _ := parseUint32(request.QueryParameter("offset"))
+	limit, _ := parseUint32(request.QueryParameter("limit"))
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DescribeSlosResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DescribeSlosRequest{
+		SloId:    sloIds,
+		SloName:  sloNames,
+		PolicyId: policyIds,
+		SortKey:  sortKey,
+		Reverse:  reverse,
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	resp, err := client.DescribeSlos(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DescribeSlos failed: %+v", err)
+		response.WriteAsJson(&pb.DescribeSlosResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DescribeSlos success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func ModifySlo(request *restful.Request, response *restful.Response) {
+	slo := new(models.Slo)
+
+	err := request.ReadEntity(&slo)
+	if err != nil {
+		logger.Debug(nil, "ModifySlo request data error %+v.", err)
+		response.WriteAsJson(&pb.ModifySloResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.ModifySloResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.ModifySloRequest{
+		SloId:         slo.SloId,
+		SloName:       slo.SloName,
+		Target:        slo.Target,
+		WindowDays:    slo.WindowDays,
+		GoodMetricId:  slo.GoodMetricId,
+		TotalMetricId: slo.TotalMetricId,
+		BurnWindows:   slo.BurnWindows,
+		Disabled:      slo.Disabled,
+	}
+
+	resp, err := client.ModifySlo(ctx, req)
+	if err != nil {
+		logger.Error(nil, "ModifySlo failed: %+v", err)
+		response.WriteAsJson(&pb.ModifySloResponse{})
+		return
+	}
+
+	logger.Debug(nil, "ModifySlo success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DeleteSlos(request *restful.Request, response *restful.Response) {
+	sloIds := strings.Split(request.QueryParameter("slo_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DeleteSlosResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DeleteSlosRequest{
+		SloId: sloIds,
+	}
+
+	resp, err := client.DeleteSlos(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DeleteSlos failed: %+v", err)
+		response.WriteAsJson(&pb.DeleteSlosResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DeleteSlos success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
 func DescribeResourcesCluster(request *restful.Request, response *restful.Response) {
 }
 
@@ -1245,6 +1245,94 @@ func WebService() *restful.WebService {
 		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
 		Produces(restful.MIME_JSON)
 
+	tags = []string{"RuleOverride"}
+
+	ws.Route(ws.POST("/rule_override").To(CreateRuleOverride).
+		Doc("Create Rule Override").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.RuleOverride{}).
+		Writes(pb.CreateRuleOverrideResponse{}).
+		Returns(http.StatusOK, RespOK, pb.CreateRuleOverrideResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/rule_override").To(DescribeRuleOverrides).
+		Doc("Describe Rule Overrides").
+		Param(ws.QueryParameter("override_ids", "Specify rule override ids to query, comma-separated, eg. ro-Dp7Z7VjvKnYL,ro-zyyGZZ640Op9.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("rule_ids", "Specify rule ids to query, comma-separated, eg. rl-3X3lKyWzx9Bv,rl-RzDX5N4lOwQy.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("resource_names", "Specify resource names to query, comma-separated, eg. node1,node2.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("sort_key", "Sort key. One of override_id, rule_id, resource_name, thresholds, recovery_thresholds, create_time, update_time.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("reverse", "Sort order, true-desc, false-asc.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("offset", "Beginning index of result to return. Use this option together with limit.").DataType("uint32").Required(false)).
+		Param(ws.QueryParameter("limit", "Size of result to return.").DataType("uint32").Required(false)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DescribeRuleOverridesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DescribeRuleOverridesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.PATCH("/rule_override").To(ModifyRuleOverride).
+		Doc("Modify Rule Override").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.RuleOverride{}).
+		Writes(pb.ModifyRuleOverrideResponse{}).
+		Returns(http.StatusOK, RespOK, pb.ModifyRuleOverrideResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.DELETE("/rule_override").To(DeleteRuleOverrides).
+		Doc("Delete Rule Overrides").
+		Param(ws.QueryParameter("override_ids", "Specify rule override ids to delete, comma-separated, eg. ro-Dp7Z7VjvKnYL,ro-zyyGZZ640Op9.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DeleteRuleOverridesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DeleteRuleOverridesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	tags = []string{"Slo"}
+
+	ws.Route(ws.POST("/slo").To(CreateSlo).
+		Doc("Create Slo").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Slo{}).
+		Writes(pb.CreateSloResponse{}).
+		Returns(http.StatusOK, RespOK, pb.CreateSloResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/slo").To(DescribeSlos).
+		Doc("Describe Slos").
+		Param(ws.QueryParameter("slo_ids", "Specify slo ids to query, comma-separated, eg. slo-Dp7Z7VjvKnYL,slo-zyyGZZ640Op9.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("slo_names", "Specify slo names to query, comma-separated, eg. api-availability,web-availability.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("policy_ids", "Specify policy ids to query, comma-separated, eg. pl-3X3lKyWzx9Bv,pl-RzDX5N4lOwQy.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("sort_key", "Sort key. One of slo_id, slo_name, target, window_days, good_metric_id, total_metric_id, burn_windows, disabled, policy_id, create_time, update_time.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("reverse", "Sort order, true-desc, false-asc.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("offset", "Beginning index of result to return. Use this option together with limit.").DataType("uint32").Required(false)).
+		Param(ws.QueryParameter("limit", "Size of result to return.").DataType("uint32").Required(false)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DescribeSlosResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DescribeSlosResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.PATCH("/slo").To(ModifySlo).
+		Doc("Modify Slo").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Slo{}).
+		Writes(pb.ModifySloResponse{}).
+		Returns(http.StatusOK, RespOK, pb.ModifySloResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.DELETE("/slo").To(DeleteSlos).
+		Doc("Delete Slos").
+		Param(ws.QueryParameter("slo_ids", "Specify slo ids to delete, comma-separated, eg. slo-Dp7Z7VjvKnYL,slo-zyyGZZ640Op9.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DeleteSlosResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DeleteSlosResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
 	tags = []string{"Resource"}
 
 	ws.Route(ws.GET("/clusters/resource").To(DescribeResourcesCluster).
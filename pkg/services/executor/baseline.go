@@ -0,0 +1,80 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	BaselineSize       = 60
+	BaselineMinSamples = 10
+	//Spread is at least this ratio of the center, so that nearly constant values do not make any change infinitely deviated
+	BaselineMinRelativeSpread = 0.01
+)
+
+//Baseline keeps the latest values of one rule resource, it is persisted with the alert status
+type Baseline struct {
+	Values   []float64 `json:"values"`
+	LastTime int64     `json:"last_time"`
+}
+
+//Absolute deviation of v from the baseline in number of deviations, not ready until enough values are recorded,
+//or while the baseline stays at 0 with no spread at all
+func (b *Baseline) deviation(method string, v float64) (float64, bool) {
+	if len(b.Values) < BaselineMinSamples {
+		return 0, false
+	}
+
+	center, spread := 0.0, 0.0
+	switch method {
+	case models.ConditionTypeMAD:
+		center, spread = metric.MedianMAD(b.Values)
+	default:
+		center, spread = metric.MeanStddev(b.Values)
+	}
+
+	spread = math.Max(spread, BaselineMinRelativeSpread*math.Abs(center))
+	if spread == 0 {
+		return 0, false
+	}
+	return math.Abs(v-center) / spread, true
+}
+
+func (b *Baseline) add(v float64, t int64) {
+	b.Values = append(b.Values, v)
+	if len(b.Values) > BaselineSize {
+		b.Values = b.Values[len(b.Values)-BaselineSize:]
+	}
+	b.LastTime = t
+}
+
+//Get the deviation of v from the baseline of the rule resource, then add v to the baseline once per sample time
+func (ar *AlertRunner) checkBaseline(ruleId string, resourceName string, v float64, tvs []metric.TV, scale float64) (float64, bool) {
+	ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+	lastTime := lastSampleTime(tvs, scale)
+
+	ar.AlertStatus.Lock()
+	defer ar.AlertStatus.Unlock()
+
+	baseline, ok := ar.AlertStatus.Baselines[ruleResourceKey]
+	if !ok {
+		baseline = &Baseline{}
+		ar.AlertStatus.Baselines[ruleResourceKey] = baseline
+	}
+
+	deviation, ready := baseline.deviation(ar.AlertConfig.Rules[ruleId].Baseline, v)
+	if lastTime == 0 || lastTime > baseline.LastTime {
+		baseline.add(v, lastTime)
+	}
+
+	logger.Debug(nil, "checkBaseline Rule[%s] Resource[%s] value %v deviation %v ready %v", ruleId, resourceName, v, deviation, ready)
+
+	return deviation, ready
+}
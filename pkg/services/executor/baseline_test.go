@@ -0,0 +1,72 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math"
+	"testing"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func repeatValues(values []float64, times int) []float64 {
+	repeated := []float64{}
+	for i := 0; i < times; i++ {
+		repeated = append(repeated, values...)
+	}
+	return repeated
+}
+
+func TestBaselineDeviation(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		values          []float64
+		v               float64
+		expectDeviation float64
+		expectReady     bool
+	}{
+		{"not enough samples", models.ConditionTypeZScore, repeatValues([]float64{1, 3}, 4), 10, 0, false},
+		{"zscore", models.ConditionTypeZScore, repeatValues([]float64{1, 3}, 5), 5, 3, true},
+		{"mad", models.ConditionTypeMAD, repeatValues([]float64{1, 2, 3, 100}, 3), 6, 3.5, true},
+		{"constant values use relative spread", models.ConditionTypeZScore, repeatValues([]float64{50}, 10), 51, 2, true},
+		{"constant values unchanged", models.ConditionTypeMAD, repeatValues([]float64{50}, 10), 50, 0, true},
+		{"constant zero values are not ready", models.ConditionTypeZScore, repeatValues([]float64{0}, 10), 1, 0, false},
+	}
+
+	for _, test := range tests {
+		baseline := &Baseline{Values: test.values}
+		deviation, ready := baseline.deviation(test.method, test.v)
+		if ready != test.expectReady || math.Abs(deviation-test.expectDeviation) > 1e-9 {
+			t.Errorf("%s: expect deviation %v ready %v, got %v %v", test.name, test.expectDeviation, test.expectReady, deviation, ready)
+		}
+	}
+}
+
+func TestCheckBaseline(t *testing.T) {
+	runner := &AlertRunner{}
+	runner.AlertConfig.Rules = map[string]RuleInfo{
+		"rl-cpu": {Baseline: models.ConditionTypeZScore},
+	}
+	runner.resetAlertStatus()
+
+	for i := 0; i < BaselineMinSamples; i++ {
+		tvs := []metric.TV{{T: int64(1600000000 + 60*i), V: "1"}}
+		runner.checkBaseline("rl-cpu", "node1", 1, tvs, 1)
+		//The same sample time is only added once
+		runner.checkBaseline("rl-cpu", "node1", 1, tvs, 1)
+	}
+
+	baseline := runner.AlertStatus.Baselines[getRuleResourceKey("rl-cpu", "node1")]
+	if len(baseline.Values) != BaselineMinSamples {
+		t.Fatalf("expect %d values, got %v", BaselineMinSamples, baseline.Values)
+	}
+
+	deviation, ready := runner.checkBaseline("rl-cpu", "node1", 1.5, []metric.TV{{T: 1600001000, V: "1.5"}}, 1)
+	if !ready || math.IsInf(deviation, 1) || math.Abs(deviation-50) > 1e-9 {
+		t.Errorf("expect finite deviation 50 ready, got %v %v", deviation, ready)
+	}
+}
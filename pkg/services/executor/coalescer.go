@@ -0,0 +1,189 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+)
+
+//CoalescedSource shares the results of each metric among all runners of the executor asking for the same resources,
+//a metric requested while in flight waits for the running request, a returned metric is cached for the ttl.
+//Requests are shared so they are made with a context of their own timeout rather than the context of any runner
+type CoalescedSource struct {
+	Source  MetricSource
+	TTL     time.Duration
+	Timeout time.Duration
+
+	mutex     sync.Mutex
+	calls     map[string]*metricCall
+	sweepTime time.Time
+}
+
+//metricCall is the request of one metric, done is closed when the result or error is set
+type metricCall struct {
+	done           chan struct{}
+	resourceMetric map[string][]metric.TV
+	err            error
+	expireTime     time.Time
+}
+
+func NewCoalescedSource(source MetricSource, ttl time.Duration, timeout time.Duration) *CoalescedSource {
+	return &CoalescedSource{
+		Source:  source,
+		TTL:     ttl,
+		Timeout: timeout,
+		calls:   make(map[string]*metricCall),
+	}
+}
+
+//Key of the metric of the param, rules asking for the metric are not part of the key
+func getMetricCallKey(metricParam metric.MetricParam, metricName string) string {
+	metricParam.Metrics = []string{metricName}
+	metricParam.MetricToRule = nil
+	key, _ := json.Marshal(metricParam)
+	return string(key)
+}
+
+//Remove the calls expired, at most once a ttl
+func (cs *CoalescedSource) sweep(now time.Time) {
+	if now.Sub(cs.sweepTime) < cs.TTL {
+		return
+	}
+	cs.sweepTime = now
+
+	for key, call := range cs.calls {
+		select {
+		case <-call.done:
+			if !now.Before(call.expireTime) {
+				delete(cs.calls, key)
+			}
+		default:
+		}
+	}
+}
+
+//Get the call of each metric of the param, metrics without a running or cached call are returned to be requested
+func (cs *CoalescedSource) getCalls(metricParam metric.MetricParam) (map[string]*metricCall, []string) {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	now := time.Now()
+	cs.sweep(now)
+
+	calls := make(map[string]*metricCall)
+	missingMetrics := []string{}
+	for _, metricName := range metricParam.Metrics {
+		if _, ok := calls[metricName]; ok {
+			continue
+		}
+
+		key := getMetricCallKey(metricParam, metricName)
+		call, ok := cs.calls[key]
+		if ok {
+			select {
+			case <-call.done:
+				ok = now.Before(call.expireTime)
+			default:
+			}
+		}
+		if !ok {
+			call = &metricCall{done: make(chan struct{})}
+			cs.calls[key] = call
+			missingMetrics = append(missingMetrics, metricName)
+		}
+		calls[metricName] = call
+	}
+
+	return calls, missingMetrics
+}
+
+//Request the missing metrics in one request and set the calls of them,
+//each metric is asked for by a rule named after it to tell the results apart
+func (cs *CoalescedSource) requestMissing(metricParam metric.MetricParam, missingMetrics []string, calls map[string]*metricCall) {
+	ctx, cancel := context.WithTimeout(context.Background(), cs.Timeout)
+	defer cancel()
+
+	metricToRule := make(map[string][]string)
+	for _, metricName := range missingMetrics {
+		metricToRule[metricName] = []string{metricName}
+	}
+	metricParam.Metrics = missingMetrics
+	metricParam.MetricToRule = metricToRule
+
+	resourceMetricsList, err := cs.Source.GetMetrics(ctx, metricParam)
+
+	results := make(map[string]map[string][]metric.TV)
+	for _, resourceMetrics := range resourceMetricsList {
+		if _, ok := results[resourceMetrics.MetricName]; !ok {
+			results[resourceMetrics.MetricName] = resourceMetrics.ResourceMetric
+		}
+	}
+
+	cs.mutex.Lock()
+	expireTime := time.Now().Add(cs.TTL)
+	for _, metricName := range missingMetrics {
+		call := calls[metricName]
+		call.resourceMetric, call.err = results[metricName], err
+		call.expireTime = expireTime
+		//Failed requests are not cached
+		if err != nil {
+			key := getMetricCallKey(metricParam, metricName)
+			if cs.calls[key] == call {
+				delete(cs.calls, key)
+			}
+		}
+		close(call.done)
+	}
+	cs.mutex.Unlock()
+}
+
+//GetMetrics returns the shared results of the metrics for every rule asking for them,
+//the missing metrics are requested in the background so a canceled runner does not fail the other runners waiting
+func (cs *CoalescedSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	calls, missingMetrics := cs.getCalls(metricParam)
+	if len(missingMetrics) > 0 {
+		go cs.requestMissing(metricParam, missingMetrics, calls)
+	}
+	logger.Debug(nil, "CoalescedSource requested %d of %d metrics", len(missingMetrics), len(calls))
+
+	resourceMetricsList := []metric.ResourceMetrics{}
+	returned := make(map[string]bool)
+	for _, metricName := range metricParam.Metrics {
+		if returned[metricName] {
+			continue
+		}
+		returned[metricName] = true
+
+		call := calls[metricName]
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if call.err != nil {
+			return nil, call.err
+		}
+		//Metrics not returned by the source are not returned either
+		if call.resourceMetric == nil {
+			continue
+		}
+
+		for _, ruleId := range metricParam.MetricToRule[metricName] {
+			resourceMetricsList = append(resourceMetricsList, metric.ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: call.resourceMetric,
+			})
+		}
+	}
+
+	return resourceMetricsList, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+type countingSource struct {
+	mutex    sync.Mutex
+	requests [][]string
+	release  chan struct{}
+	err      error
+}
+
+func (cs *countingSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	cs.mutex.Lock()
+	cs.requests = append(cs.requests, metricParam.Metrics)
+	cs.mutex.Unlock()
+
+	if cs.release != nil {
+		<-cs.release
+	}
+	if cs.err != nil {
+		return nil, cs.err
+	}
+
+	resourceMetricsList := []metric.ResourceMetrics{}
+	for _, metricName := range metricParam.Metrics {
+		for _, ruleId := range metricParam.MetricToRule[metricName] {
+			resourceMetricsList = append(resourceMetricsList, metric.ResourceMetrics{
+				RuleId:         ruleId,
+				MetricName:     metricName,
+				ResourceMetric: map[string][]metric.TV{"node1": {{T: 60, V: metricName}}},
+			})
+		}
+	}
+	return resourceMetricsList, nil
+}
+
+func (cs *countingSource) count() int {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+	return len(cs.requests)
+}
+
+func newNodeParam(ruleId string, metrics ...string) metric.MetricParam {
+	metricToRule := make(map[string][]string)
+	for _, metricName := range metrics {
+		metricToRule[metricName] = []string{ruleId}
+	}
+	return metric.MetricParam{
+		RsTypeName:    "node",
+		RsFilterParam: `{"node_id":"node1"}`,
+		Metrics:       metrics,
+		MetricToRule:  metricToRule,
+	}
+}
+
+func TestCoalescedSourceInFlight(t *testing.T) {
+	source := &countingSource{release: make(chan struct{})}
+	coalesced := NewCoalescedSource(source, time.Minute, time.Minute)
+
+	const runners = 20
+	wg := sync.WaitGroup{}
+	results := make([][]metric.ResourceMetrics, runners)
+	errs := make([]error, runners)
+	for i := 0; i < runners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = coalesced.GetMetrics(context.Background(), newNodeParam(fmt.Sprintf("rl-%d", i), "cpu"))
+		}(i)
+	}
+
+	//Wait until the first request is in flight before releasing it
+	for source.count() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(source.release)
+	wg.Wait()
+
+	if source.count() != 1 {
+		t.Errorf("source got %d requests, expect 1", source.count())
+	}
+	for i := 0; i < runners; i++ {
+		if errs[i] != nil {
+			t.Fatalf("runner %d got error %v", i, errs[i])
+		}
+		if len(results[i]) != 1 || results[i][0].RuleId != fmt.Sprintf("rl-%d", i) || results[i][0].ResourceMetric["node1"][0].V != "cpu" {
+			t.Errorf("runner %d got %v", i, results[i])
+		}
+	}
+
+	//Cached metrics are not requested again, only the missing ones are
+	if _, err := coalesced.GetMetrics(context.Background(), newNodeParam("rl-x", "cpu", "memory")); err != nil {
+		t.Fatal(err)
+	}
+	if source.count() != 2 || len(source.requests[1]) != 1 || source.requests[1][0] != "memory" {
+		t.Errorf("source got requests %v, expect memory requested only", source.requests)
+	}
+
+	//Other resources are not shared
+	otherParam := newNodeParam("rl-y", "cpu")
+	otherParam.RsFilterParam = `{"node_id":"node2"}`
+	if _, err := coalesced.GetMetrics(context.Background(), otherParam); err != nil {
+		t.Fatal(err)
+	}
+	if source.count() != 3 {
+		t.Errorf("source got %d requests, expect 3", source.count())
+	}
+}
+
+//Source blocking until the context of the request is done
+type blockingSource struct{}
+
+func (bs *blockingSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestCoalescedSourceContext(t *testing.T) {
+	source := &countingSource{release: make(chan struct{})}
+	coalesced := NewCoalescedSource(source, time.Minute, time.Minute)
+
+	//The runner making the request is canceled while the others are waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	canceledErr := make(chan error)
+	go func() {
+		_, err := coalesced.GetMetrics(ctx, newNodeParam("rl-1", "cpu"))
+		canceledErr <- err
+	}()
+	for source.count() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	waitingErr := make(chan error)
+	go func() {
+		_, err := coalesced.GetMetrics(context.Background(), newNodeParam("rl-2", "cpu"))
+		waitingErr <- err
+	}()
+
+	cancel()
+	select {
+	case err := <-canceledErr:
+		if err != context.Canceled {
+			t.Errorf("canceled runner got error %v, expect %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("canceled runner still waits for the request")
+	}
+
+	close(source.release)
+	if err := <-waitingErr; err != nil {
+		t.Errorf("waiting runner got error %v of the canceled runner", err)
+	}
+	if source.count() != 1 {
+		t.Errorf("source got %d requests, expect 1", source.count())
+	}
+
+	//Requests not returned in the timeout fail every runner waiting and are not cached
+	coalesced = NewCoalescedSource(&blockingSource{}, time.Minute, 10*time.Millisecond)
+	for i := 0; i < 2; i++ {
+		if _, err := coalesced.GetMetrics(context.Background(), newNodeParam("rl-1", "cpu")); err != context.DeadlineExceeded {
+			t.Errorf("GetMetrics got error %v, expect %v", err, context.DeadlineExceeded)
+		}
+	}
+}
+
+func TestCoalescedSourceExpiry(t *testing.T) {
+	source := &countingSource{err: fmt.Errorf("adapter unavailable")}
+	coalesced := NewCoalescedSource(source, time.Minute, time.Minute)
+
+	//Failed requests are not cached
+	for i := 0; i < 2; i++ {
+		if _, err := coalesced.GetMetrics(context.Background(), newNodeParam("rl-1", "cpu")); err == nil {
+			t.Errorf("GetMetrics expect error")
+		}
+	}
+	if source.count() != 2 {
+		t.Errorf("source got %d requests, expect 2", source.count())
+	}
+
+	source.err = nil
+	coalesced.TTL = 0
+	for i := 0; i < 2; i++ {
+		if _, err := coalesced.GetMetrics(context.Background(), newNodeParam("rl-1", "cpu")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if source.count() != 4 {
+		t.Errorf("source without ttl got %d requests, expect 4", source.count())
+	}
+}
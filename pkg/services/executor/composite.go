@@ -0,0 +1,202 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+//RuleResult is the last evaluated result of one resource of a rule, used by composite rules
+type RuleResult struct {
+	Set    bool
+	Known  bool
+	Metric RecordedMetric
+}
+
+func (ri *RuleInfo) isComposite() bool {
+	return models.IsCompositeCondition(ri.ConditionType)
+}
+
+//Check members of composite rules and get the member rules used by enabled composite rules,
+//members share one evaluation interval so that they are checked in the same ticks
+func (ar *AlertRunner) parseCompositeRules() map[string]bool {
+	usedByComposite := make(map[string]bool)
+
+	for ruleId, ruleInfo := range ar.AlertConfig.Rules {
+		if !ruleInfo.isComposite() || ruleInfo.Disabled {
+			continue
+		}
+
+		valid := len(ruleInfo.Members) > 1
+		interval := uint32(0)
+		for i, memberId := range ruleInfo.Members {
+			member, ok := ar.AlertConfig.Rules[memberId]
+			if !ok || member.isComposite() || member.Invalid || (i > 0 && member.getEvaluationInterval() != interval) {
+				valid = false
+				break
+			}
+			interval = member.getEvaluationInterval()
+		}
+
+		if !valid {
+			logger.Error(nil, "Alert[%s] Rule[%s] has illegal members %v, rule will be disabled", ar.AlertConfig.AlertId, ruleId, ruleInfo.Members)
+			ruleInfo.Disabled = true
+			ar.AlertConfig.Rules[ruleId] = ruleInfo
+			continue
+		}
+
+		for _, memberId := range ruleInfo.Members {
+			usedByComposite[memberId] = true
+		}
+	}
+
+	return usedByComposite
+}
+
+//Keep the results of the rule checked in this tick for composite rules
+func (ar *AlertRunner) recordRuleResults(ruleId string, triggeredMetrics []RecordedMetric, resumedMetrics []RecordedMetric, noDataMetrics []RecordedMetric) {
+	results := make(map[string]RuleResult)
+
+	for _, triggeredMetric := range triggeredMetrics {
+		results[triggeredMetric.ResourceName] = RuleResult{true, true, triggeredMetric}
+	}
+	for _, resumedMetric := range resumedMetrics {
+		results[resumedMetric.ResourceName] = RuleResult{false, true, resumedMetric}
+	}
+	for _, noDataMetric := range noDataMetrics {
+		results[noDataMetric.ResourceName] = RuleResult{false, false, noDataMetric}
+	}
+
+	ar.RuleResults[ruleId] = results
+}
+
+//Get resources reported by any member of the composite rule or known by the composite rule itself
+func (ar *AlertRunner) getCompositeResources(ruleId string) []string {
+	resourceNames := []string{}
+	found := make(map[string]bool)
+
+	for _, memberId := range ar.AlertConfig.Rules[ruleId].Members {
+		for resourceName := range ar.RuleResults[memberId] {
+			if !found[resourceName] {
+				found[resourceName] = true
+				resourceNames = append(resourceNames, resourceName)
+			}
+		}
+	}
+
+	ar.AlertStatus.RLock()
+	defer ar.AlertStatus.RUnlock()
+
+	for k := range ar.AlertStatus.ResourceStatus {
+		ruleResource := strings.SplitN(k, " ", 2)
+		if len(ruleResource) == 2 && ruleResource[0] == ruleId && !found[ruleResource[1]] {
+			found[ruleResource[1]] = true
+			resourceNames = append(resourceNames, ruleResource[1])
+		}
+	}
+
+	return resourceNames
+}
+
+//Combine the member results of one resource with AND/OR,
+//the composite metric lists the values of every contributing member
+func (ar *AlertRunner) checkCompositeResource(ruleId string, resourceName string) (RecordedMetric, bool, bool) {
+	rule := ar.AlertConfig.Rules[ruleId]
+
+	setMembers := []RecordedMetric{}
+	unsetMembers := []RecordedMetric{}
+	unknownCount := 0
+	for _, memberId := range rule.Members {
+		result, ok := ar.RuleResults[memberId][resourceName]
+		switch {
+		case !ok || !result.Known:
+			unknownCount++
+		case result.Set:
+			setMembers = append(setMembers, result.Metric)
+		default:
+			unsetMembers = append(unsetMembers, result.Metric)
+		}
+	}
+
+	compositeMetric := RecordedMetric{rule.RuleName, resourceName, 0, "", rule.Severity, false, nil, 0, "", "", nil}
+
+	switch rule.ConditionType {
+	case models.ConditionTypeAnd:
+		if len(setMembers) == len(rule.Members) {
+			compositeMetric.Members = setMembers
+			return compositeMetric, true, true
+		}
+		if len(unsetMembers) > 0 {
+			compositeMetric.Members = unsetMembers
+			return compositeMetric, false, true
+		}
+	case models.ConditionTypeOr:
+		if len(setMembers) > 0 {
+			compositeMetric.Members = setMembers
+			return compositeMetric, true, true
+		}
+		if unknownCount == 0 {
+			compositeMetric.Members = unsetMembers
+			return compositeMetric, false, true
+		}
+	}
+
+	compositeMetric.NoData = true
+	return compositeMetric, false, false
+}
+
+//Check composite rules which have all members checked in this tick, once per tick
+func (ar *AlertRunner) checkCompositeRules(checkedRules map[string]bool) bool {
+	needUpdate := false
+
+	for ruleId, ruleInfo := range ar.AlertConfig.Rules {
+		if !ruleInfo.isComposite() || ruleInfo.Disabled {
+			continue
+		}
+
+		allChecked := true
+		for _, memberId := range ruleInfo.Members {
+			allChecked = allChecked && checkedRules[memberId]
+		}
+		if !allChecked {
+			continue
+		}
+
+		triggeredMetrics := []RecordedMetric{}
+		resumedMetrics := []RecordedMetric{}
+		noDataMetrics := []RecordedMetric{}
+
+		for _, resourceName := range ar.getCompositeResources(ruleId) {
+			compositeMetric, resourceSet, known := ar.checkCompositeResource(ruleId, resourceName)
+			switch {
+			case !known:
+				ruleInfo.addNoDataMetric(compositeMetric, &triggeredMetrics, &resumedMetrics, &noDataMetrics)
+			case resourceSet:
+				triggeredMetrics = append(triggeredMetrics, compositeMetric)
+			default:
+				resumedMetrics = append(resumedMetrics, compositeMetric)
+			}
+		}
+
+		logger.Debug(nil, "checkCompositeRules Rule[%s] triggered %d resumed %d no data %d", ruleId, len(triggeredMetrics), len(resumedMetrics), len(noDataMetrics))
+
+		checkResult := ar.checkRuleResources(ruleId, triggeredMetrics, resumedMetrics, noDataMetrics)
+		needUpdate = needUpdate || checkResult
+	}
+
+	return needUpdate
+}
+
+func formatMemberValues(members []RecordedMetric) string {
+	values := []string{}
+	for _, member := range members {
+		values = append(values, fmt.Sprintf("%s: %s", member.RuleName, formatRecordedValue(member)))
+	}
+	return strings.Join(values, ", ")
+}
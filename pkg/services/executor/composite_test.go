@@ -0,0 +1,96 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func TestParseCompositeRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		members        []string
+		memInterval    uint32
+		expectDisabled bool
+	}{
+		{"valid", []string{"rl-cpu", "rl-mem"}, 60, false},
+		{"single member", []string{"rl-cpu"}, 60, true},
+		{"missing member", []string{"rl-cpu", "rl-gone"}, 60, true},
+		{"composite member", []string{"rl-cpu", "rl-and"}, 60, true},
+		{"members with different intervals", []string{"rl-cpu", "rl-mem"}, 120, true},
+	}
+
+	for _, test := range tests {
+		runner := &AlertRunner{}
+		runner.AlertConfig.Rules = map[string]RuleInfo{
+			"rl-cpu": {EvaluationInterval: 60},
+			"rl-mem": {EvaluationInterval: test.memInterval},
+			"rl-and": {ConditionType: models.ConditionTypeAnd, Members: test.members},
+		}
+
+		usedByComposite := runner.parseCompositeRules()
+
+		if runner.AlertConfig.Rules["rl-and"].Disabled != test.expectDisabled {
+			t.Errorf("%s: expect disabled %v, got %v", test.name, test.expectDisabled, runner.AlertConfig.Rules["rl-and"].Disabled)
+		}
+		if usedByComposite["rl-cpu"] == test.expectDisabled {
+			t.Errorf("%s: expect rl-cpu used by composite %v, got %v", test.name, !test.expectDisabled, usedByComposite["rl-cpu"])
+		}
+	}
+}
+
+func cpuMetrics(ruleId string, value string) metric.ResourceMetrics {
+	return metric.ResourceMetrics{
+		RuleId:         ruleId,
+		MetricName:     "node_cpu_utilisation",
+		ResourceMetric: map[string][]metric.TV{"node1": {{T: time.Now().Unix(), V: value}}},
+	}
+}
+
+func TestCheckCompositeRules(t *testing.T) {
+	runner, _, history, _ := newReplayRunner(t, "testdata/replay.json")
+	member := runner.AlertConfig.Rules["rl-cpu"]
+	member.RuleName = "cpu very high"
+	member.Thresholds = 0.95
+	runner.AlertConfig.Rules["rl-cpu-very"] = member
+	runner.AlertConfig.Rules["rl-and"] = RuleInfo{
+		RuleName:                 "cpu high and very high",
+		Severity:                 "critical",
+		ConditionType:            models.ConditionTypeAnd,
+		Members:                  []string{"rl-cpu", "rl-cpu-very"},
+		ConsecutiveCount:         1,
+		ConsecutiveRecoveryCount: 1,
+	}
+
+	ticks := []struct {
+		name            string
+		metrics         []metric.ResourceMetrics
+		expectHistories []string
+	}{
+		{"one member checked", []metric.ResourceMetrics{cpuMetrics("rl-cpu", "0.97")}, []string{"triggered rl-cpu node1", "sent_success rl-cpu node1"}},
+		//Result of rl-cpu in the last tick is not combined with rl-cpu-very in this tick
+		{"other member checked", []metric.ResourceMetrics{cpuMetrics("rl-cpu-very", "0.97")}, []string{"triggered rl-cpu-very node1", "sent_success rl-cpu-very node1"}},
+		{"all members checked", []metric.ResourceMetrics{cpuMetrics("rl-cpu", "0.97"), cpuMetrics("rl-cpu-very", "0.97")}, []string{"sent_success rl-cpu node1", "sent_success rl-cpu-very node1", "triggered rl-and node1", "sent_success rl-and node1"}},
+		{"all members checked once more", []metric.ResourceMetrics{cpuMetrics("rl-cpu", "0.92"), cpuMetrics("rl-cpu-very", "0.92")}, []string{"sent_success rl-cpu node1", "resumed rl-cpu-very node1", "sent_success rl-cpu-very node1", "resumed rl-and node1", "sent_success rl-and node1"}},
+	}
+
+	for _, tick := range ticks {
+		history.histories = nil
+
+		runner.checkMetrics(tick.metrics, nil)
+
+		if !reflect.DeepEqual(history.rows(), tick.expectHistories) {
+			t.Errorf("%s: expect histories %v, got %v", tick.name, tick.expectHistories, history.rows())
+		}
+		if len(runner.RuleResults) != len(tick.metrics) {
+			t.Errorf("%s: expect results of %d rules, got %v", tick.name, len(tick.metrics), runner.RuleResults)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strconv"
+	"strings"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+func (ar *AlertRunner) parseRuleCondition(ruleId string, ruleInfo *RuleInfo, thresholds string) {
+	//Change conditions compare the change of the window with their comparison
+	ruleInfo.Change, ruleInfo.ConditionType = models.SplitChangeCondition(ruleInfo.ConditionType)
+	//Offset conditions need the offset window to compare with
+	if models.IsOffsetChange(ruleInfo.Change) && ruleInfo.OffsetWindow == 0 {
+		logger.Error(nil, "Alert[%s] Rule[%s] condition [%s] has no offset window, rule will be disabled", ar.AlertConfig.AlertId, ruleId, ruleInfo.Change)
+		ruleInfo.Disabled = true
+		ruleInfo.Invalid = true
+	}
+	//Other conditions do not request the offset window
+	if !models.IsOffsetChange(ruleInfo.Change) {
+		ruleInfo.OffsetWindow = 0
+	}
+	//Baseline conditions compare the deviation from the baseline with thresholds
+	if models.IsBaselineCondition(ruleInfo.ConditionType) {
+		ruleInfo.Baseline = ruleInfo.ConditionType
+		ruleInfo.ConditionType = models.ConditionTypeGreater
+	}
+
+	switch ruleInfo.ConditionType {
+	case models.ConditionTypeExpression:
+		expr, err := exprutil.Parse(thresholds)
+		if err == nil {
+			err = expr.CheckVariables(models.RuleExpressionValue)
+		}
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Rule[%s] parse expression [%s] error: %v, rule will be disabled", ar.AlertConfig.AlertId, ruleId, thresholds, err)
+			ruleInfo.Disabled = true
+			ruleInfo.Invalid = true
+			return
+		}
+		ruleInfo.Expression = expr
+	case models.ConditionTypeAnd, models.ConditionTypeOr:
+		ruleInfo.Members = strings.Split(thresholds, models.CompositeRuleSeparator)
+	case models.ConditionTypeScript:
+		ruleInfo.ScriptThresholds = thresholds
+	default:
+		ruleInfo.Thresholds, _ = strconv.ParseFloat(thresholds, 64)
+	}
+}
+
+//Parse thresholds of the condition type into a number or an expression
+func parseThresholds(conditionType string, thresholds string) (float64, *exprutil.Expr, error) {
+	switch conditionType {
+	case models.ConditionTypeExpression:
+		expr, err := exprutil.Parse(thresholds)
+		if err == nil {
+			err = expr.CheckVariables(models.RuleExpressionValue)
+		}
+		return 0, expr, err
+	default:
+		threshold, err := strconv.ParseFloat(thresholds, 64)
+		return threshold, nil, err
+	}
+}
+
+//Parse recovery thresholds used instead of thresholds when the resource is alerting
+func (ar *AlertRunner) parseRuleRecovery(ruleId string, ruleInfo *RuleInfo, recoveryThresholds string) {
+	if recoveryThresholds == "" {
+		return
+	}
+
+	threshold, expr, err := parseThresholds(ruleInfo.ConditionType, recoveryThresholds)
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse recovery thresholds [%s] error: %v, thresholds will be used", ar.AlertConfig.AlertId, ruleId, recoveryThresholds, err)
+		return
+	}
+	ruleInfo.RecoveryThresholds = threshold
+	ruleInfo.RecoveryExpression = expr
+	ruleInfo.HasRecovery = true
+}
+
+//Parse the arithmetic expression of metric names evaluated instead of the metric of the rule
+func (ar *AlertRunner) parseRuleMetricExpression(ruleId string, ruleInfo *RuleInfo, metricExpression string) {
+	if metricExpression == "" {
+		return
+	}
+
+	expr, err := exprutil.Parse(metricExpression)
+	if err != nil || len(expr.Variables()) == 0 {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse metric expression [%s] error: %v, rule will be disabled", ar.AlertConfig.AlertId, ruleId, metricExpression, err)
+		ruleInfo.Disabled = true
+		ruleInfo.Invalid = true
+		return
+	}
+	ruleInfo.MetricExpression = expr
+}
+
+//Names of the metrics requested for the rule
+func (ri *RuleInfo) getMetricNames() []string {
+	if ri.Slo != nil {
+		return []string{ri.Slo.GoodMetricName, ri.Slo.TotalMetricName}
+	}
+	if ri.MetricExpression != nil {
+		return ri.MetricExpression.Variables()
+	}
+	return []string{ri.MetricName}
+}
+
+func (ri *RuleInfo) asksForMetrics(metricNames []string) bool {
+	for _, name := range ri.getMetricNames() {
+		for _, metricName := range metricNames {
+			if name == metricName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (ar *AlertRunner) parseRuleAggregation(ruleId string, ruleInfo *RuleInfo, aggregation string) {
+	agg, err := metric.ParseAggregation(aggregation)
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse aggregation [%s] error: %v, last value will be used", ar.AlertConfig.AlertId, ruleId, aggregation, err)
+		agg = metric.Aggregation{Kind: metric.AggregationLast}
+	}
+	ruleInfo.Aggregation = agg
+}
+
+//Reduce the time series of one resource to the value compared with thresholds
+func (ri *RuleInfo) getValue(tvs []metric.TV, offsetTVs []metric.TV, scale float64) (float64, error) {
+	switch ri.Change {
+	case models.ConditionChangeRatio, models.ConditionChangeDiff:
+		return ri.offsetValue(tvs, offsetTVs, scale)
+	case models.ConditionChangeDelta:
+		return metric.Change(tvs, scale, false)
+	case models.ConditionChangePercent:
+		return metric.Change(tvs, scale, true)
+	case models.ConditionChangeForecast:
+		return ri.forecastValue(tvs, scale)
+	default:
+		return ri.Aggregation.Aggregate(tvs, scale)
+	}
+}
+
+func compareValue(condition string, v float64, threshold float64) bool {
+	switch condition {
+	case models.ConditionTypeGreaterEqual:
+		return v >= threshold
+	case models.ConditionTypeGreater:
+		return v > threshold
+	case models.ConditionTypeLessEqual:
+		return v <= threshold
+	case models.ConditionTypeLess:
+		return v < threshold
+	}
+	return false
+}
+
+//Check if the scaled value v satisfies the rule condition,
+//recovery thresholds are used instead if the resource is alerting
+func (ri *RuleInfo) checkCondition(v float64, alerting bool) (bool, error) {
+	expression := ri.Expression
+	thresholds := ri.Thresholds
+	if alerting && ri.HasRecovery {
+		expression = ri.RecoveryExpression
+		thresholds = ri.RecoveryThresholds
+	}
+
+	switch ri.ConditionType {
+	case models.ConditionTypeExpression:
+		return expression.EvalBool(map[string]float64{models.RuleExpressionValue: v})
+	default:
+		return compareValue(ri.ConditionType, v, thresholds), nil
+	}
+}
+
+func (li *LevelInfo) checkCondition(conditionType string, v float64) (bool, error) {
+	switch conditionType {
+	case models.ConditionTypeExpression:
+		return li.Expression.EvalBool(map[string]float64{models.RuleExpressionValue: v})
+	default:
+		return compareValue(conditionType, v, li.Thresholds), nil
+	}
+}
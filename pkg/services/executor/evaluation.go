@@ -0,0 +1,55 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+)
+
+//Update the evaluation state of the alert with the error of requesting the metrics of this tick,
+//the alert enters evaluation failed on an error and leaves it once all due rules are requested successfully
+func (ar *AlertRunner) checkEvaluation(err error) {
+	needUpdate := false
+	transition := false
+
+	ar.AlertStatus.Lock()
+	if err != nil {
+		if !ar.AlertStatus.EvaluationFailed {
+			ar.AlertStatus.EvaluationFailed = true
+			ar.AlertStatus.EvaluationFailedTime = time.Now()
+			transition = true
+		}
+		if ar.AlertStatus.EvaluationError != err.Error() {
+			ar.AlertStatus.EvaluationError = err.Error()
+			needUpdate = true
+		}
+	} else if ar.AlertStatus.EvaluationFailed {
+		ar.AlertStatus.EvaluationFailed = false
+		ar.AlertStatus.EvaluationError = ""
+		ar.AlertStatus.EvaluationFailedTime = time.Time{}
+		transition = true
+	}
+	failed := ar.AlertStatus.EvaluationFailed
+	ar.AlertStatus.Unlock()
+
+	if !needUpdate && !transition {
+		return
+	}
+
+	//Errors of a failing source vary between attempts, only entering and leaving the failed state are written to history
+	if !transition {
+		logger.Warn(nil, "Alert[%s] evaluation still failed: %v", ar.AlertConfig.AlertId, err)
+	} else if failed {
+		logger.Error(nil, "Alert[%s] evaluation failed: %v", ar.AlertConfig.AlertId, err)
+		ar.writeHistory("", "evaluation_failed", err.Error(), "", "", "")
+	} else {
+		logger.Info(nil, "Alert[%s] evaluation recovered", ar.AlertConfig.AlertId)
+		ar.writeHistory("", "evaluation_recovered", "", "", "", "")
+	}
+
+	ar.signalUpdate()
+}
@@ -254,6 +254,9 @@ func (e *Executor) TerminateRunner(alertId string) {
 }
 
 func (e *Executor) Serve() {
+	//Create the metric source before any runner, so that object state caches are synced at startup
+	GetMetricSource()
+
 	go e.alertReceiver.Serve()
 	go e.broadcastReceiver.WatchBroadcast()
 	go e.healthChecker.HealthCheck()
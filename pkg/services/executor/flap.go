@@ -0,0 +1,72 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+)
+
+//Record one trigger or resume transition of the resource
+func (ar *AlertRunner) recordTransition(newStatus *StatusResource, ruleId string) {
+	policyConfig := ar.getPolicyConfig(ruleId, newStatus)
+	if policyConfig.FlapWindowMinutes == 0 || policyConfig.FlapTransitionLimit == 0 {
+		return
+	}
+
+	newStatus.Transitions = append(newStatus.Transitions, time.Now())
+}
+
+//Keep the flap state of the resource when its status is reset
+func keepFlapState(newStatus *StatusResource, oldStatus StatusResource) {
+	newStatus.Transitions = oldStatus.Transitions
+	newStatus.Flapping = oldStatus.Flapping
+}
+
+//Check transitions in the flap window, resource starts flapping once transitions exceed the limit,
+//and stops flapping when transitions fall back to half of the limit. Return true if flap state changed.
+func (ar *AlertRunner) checkFlapping(newStatus *StatusResource, ruleId string, resourceName string) bool {
+	policyConfig := ar.getPolicyConfig(ruleId, newStatus)
+
+	if policyConfig.FlapWindowMinutes == 0 || policyConfig.FlapTransitionLimit == 0 {
+		newStatus.Transitions = nil
+		if newStatus.Flapping {
+			newStatus.Flapping = false
+			ar.writeHistory("", "flapping_end", "flap detection disabled", "", ruleId, resourceName)
+			return true
+		}
+		return false
+	}
+
+	windowStart := time.Now().Add(-time.Duration(policyConfig.FlapWindowMinutes) * time.Minute)
+	transitions := []time.Time{}
+	for _, t := range newStatus.Transitions {
+		if t.After(windowStart) {
+			transitions = append(transitions, t)
+		}
+	}
+	newStatus.Transitions = transitions
+
+	count := uint32(len(transitions))
+	content := fmt.Sprintf("%d transitions in %d minutes", count, policyConfig.FlapWindowMinutes)
+
+	if !newStatus.Flapping && count > policyConfig.FlapTransitionLimit {
+		logger.Debug(nil, "Rule[%v] Resource[%v] starts flapping, %s", ruleId, resourceName, content)
+		newStatus.Flapping = true
+		ar.writeHistory("", "flapping_start", content, "", ruleId, resourceName)
+		return true
+	}
+
+	if newStatus.Flapping && count <= policyConfig.FlapTransitionLimit/2 {
+		logger.Debug(nil, "Rule[%v] Resource[%v] stops flapping, %s", ruleId, resourceName, content)
+		newStatus.Flapping = false
+		ar.writeHistory("", "flapping_end", content, "", ruleId, resourceName)
+		return true
+	}
+
+	return false
+}
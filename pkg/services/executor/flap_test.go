@@ -0,0 +1,113 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newFlapRunner(flapWindowMinutes uint32, flapTransitionLimit uint32) (*AlertRunner, *fakeHistoryWriter) {
+	history := &fakeHistoryWriter{}
+	runner := &AlertRunner{History: history}
+	runner.AlertConfig = ConfigAlert{
+		AlertId: "al-flap",
+		PolicyConfig: map[string]ConfigPolicy{
+			"critical": {"not-repeat", 0, 0, flapWindowMinutes, flapTransitionLimit},
+		},
+		Rules: map[string]RuleInfo{
+			"rl-cpu": {Severity: "critical"},
+		},
+	}
+	return runner, history
+}
+
+//Transitions minutes ago
+func transitionsAgo(minutes ...int) []time.Time {
+	now := time.Now()
+	transitions := []time.Time{}
+	for _, m := range minutes {
+		transitions = append(transitions, now.Add(-time.Duration(m)*time.Minute))
+	}
+	return transitions
+}
+
+func TestCheckFlapping(t *testing.T) {
+	tests := []struct {
+		name                string
+		flapWindowMinutes   uint32
+		flapTransitionLimit uint32
+		transitions         []time.Time
+		flapping            bool
+		expectFlapping      bool
+		expectChanged       bool
+		expectTransitions   int
+		expectHistories     []string
+	}{
+		{"under limit", 10, 4, transitionsAgo(1, 2, 3, 4), false, false, false, 4, []string{}},
+		{"over limit", 10, 4, transitionsAgo(1, 2, 3, 4, 5), false, true, true, 5, []string{"flapping_start rl-cpu node1"}},
+		{"transitions out of window are dropped", 10, 4, transitionsAgo(1, 2, 3, 11, 12), false, false, false, 3, []string{}},
+		{"keeps flapping above half of limit", 10, 4, transitionsAgo(1, 2, 3), true, true, false, 3, []string{}},
+		{"stops flapping at half of limit", 10, 4, transitionsAgo(1, 2, 20), true, false, true, 2, []string{"flapping_end rl-cpu node1"}},
+		{"disabled", 0, 0, transitionsAgo(1, 2, 3, 4, 5), false, false, false, 0, []string{}},
+		{"disabled while flapping", 10, 0, transitionsAgo(1, 2, 3, 4, 5), true, false, true, 0, []string{"flapping_end rl-cpu node1"}},
+	}
+
+	for _, test := range tests {
+		runner, history := newFlapRunner(test.flapWindowMinutes, test.flapTransitionLimit)
+		status := &StatusResource{Transitions: test.transitions, Flapping: test.flapping}
+
+		changed := runner.checkFlapping(status, "rl-cpu", "node1")
+		if changed != test.expectChanged || status.Flapping != test.expectFlapping {
+			t.Errorf("%s: got changed %v flapping %v, expect changed %v flapping %v", test.name, changed, status.Flapping, test.expectChanged, test.expectFlapping)
+		}
+		if len(status.Transitions) != test.expectTransitions {
+			t.Errorf("%s: got %d transitions, expect %d", test.name, len(status.Transitions), test.expectTransitions)
+		}
+		if got := history.rows(); !reflect.DeepEqual(got, test.expectHistories) {
+			t.Errorf("%s: got histories %v, expect %v", test.name, got, test.expectHistories)
+		}
+	}
+}
+
+func TestRecordTransition(t *testing.T) {
+	runner, _ := newFlapRunner(10, 4)
+	status := &StatusResource{}
+	runner.recordTransition(status, "rl-cpu")
+	if len(status.Transitions) != 1 {
+		t.Errorf("recordTransition got %d transitions, expect 1", len(status.Transitions))
+	}
+
+	//Transitions are not recorded when flap detection is disabled
+	runner, _ = newFlapRunner(0, 0)
+	status = &StatusResource{}
+	runner.recordTransition(status, "rl-cpu")
+	if len(status.Transitions) != 0 {
+		t.Errorf("recordTransition disabled got %d transitions, expect 0", len(status.Transitions))
+	}
+}
+
+func TestFlappingOfLevel(t *testing.T) {
+	runner, history := newFlapRunner(10, 4)
+	runner.AlertConfig.PolicyConfig["minor"] = ConfigPolicy{"not-repeat", 0, 0, 0, 0}
+	runner.AlertConfig.Rules["rl-cpu"] = RuleInfo{Severity: "minor", Levels: []LevelInfo{{Severity: "critical"}}}
+
+	//Flap settings follow the current level of the resource like repeat settings
+	status := &StatusResource{CurrentLevel: "critical", Transitions: transitionsAgo(1, 2, 3, 4)}
+	runner.recordTransition(status, "rl-cpu")
+	if changed := runner.checkFlapping(status, "rl-cpu", "node1"); !changed || !status.Flapping {
+		t.Errorf("checkFlapping of escalated resource got changed %v flapping %v, expect flapping", changed, status.Flapping)
+	}
+
+	status = &StatusResource{CurrentLevel: "minor", Transitions: transitionsAgo(1, 2, 3, 4)}
+	runner.recordTransition(status, "rl-cpu")
+	if changed := runner.checkFlapping(status, "rl-cpu", "node1"); changed || status.Flapping || len(status.Transitions) != 0 {
+		t.Errorf("checkFlapping of minor resource got changed %v flapping %v, expect flap detection disabled", changed, status.Flapping)
+	}
+	if got := history.rows(); !reflect.DeepEqual(got, []string{"flapping_start rl-cpu node1"}) {
+		t.Errorf("got histories %v", got)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	DefaultForecastHorizonHours = 1
+)
+
+func (ri *RuleInfo) getForecastHorizon() int64 {
+	if ri.ForecastHorizon == 0 {
+		return DefaultForecastHorizonHours * 3600
+	}
+	return int64(ri.ForecastHorizon) * 3600
+}
+
+func lastSampleTime(tvs []metric.TV, scale float64) int64 {
+	_, times := metric.ParseValues(tvs, scale)
+	if len(times) == 0 {
+		return 0
+	}
+	return times[len(times)-1]
+}
+
+//Predict the value forecast horizon after the last sample by linear regression over the window
+func (ri *RuleInfo) forecastValue(tvs []metric.TV, scale float64) (float64, error) {
+	slope, intercept, err := metric.LinearFit(tvs, scale)
+	if err != nil {
+		return 0, err
+	}
+
+	return slope*float64(lastSampleTime(tvs, scale)+ri.getForecastHorizon()) + intercept, nil
+}
+
+//Get the unix time the fitted line crosses the thresholds of the level within the forecast horizon, 0 if not predicted
+func (ri *RuleInfo) getForecastTime(tvs []metric.TV, scale float64, level string) int64 {
+	if ri.Change != models.ConditionChangeForecast {
+		return 0
+	}
+
+	slope, intercept, err := metric.LinearFit(tvs, scale)
+	if err != nil || slope == 0 {
+		return 0
+	}
+
+	threshold := ri.Thresholds
+	for _, levelInfo := range ri.Levels {
+		if levelInfo.Severity == level {
+			threshold = levelInfo.Thresholds
+		}
+	}
+
+	//Already crossed thresholds are predicted at the last sample
+	lastTime := lastSampleTime(tvs, scale)
+	crossTime := int64((threshold - intercept) / slope)
+	if crossTime < lastTime {
+		return lastTime
+	}
+	if crossTime > lastTime+ri.getForecastHorizon() {
+		return 0
+	}
+	return crossTime
+}
+
+//Format the predicted crossing time and the duration from now until then
+func formatForecastTime(recordedMetric RecordedMetric) (string, string) {
+	if recordedMetric.ForecastTime == 0 {
+		return "", ""
+	}
+
+	forecastTime := time.Unix(recordedMetric.ForecastTime, 0)
+	forecastIn := time.Until(forecastTime).Round(time.Minute)
+	if forecastIn < 0 {
+		forecastIn = 0
+	}
+
+	return forecastTime.Format("2006-01-02 15:04:05.99999"), forecastIn.String()
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/logger"
+)
+
+//Refresh the last seen time when the resource reports data,
+//status without last seen time starts the expiry from now
+func touchLastSeen(status *StatusResource, recordedMetric RecordedMetric) {
+	if !recordedMetric.NoData || status.LastSeenTime.IsZero() {
+		status.LastSeenTime = time.Now()
+	}
+}
+
+func getResourceGoneExpiry() time.Duration {
+	return time.Duration(config.GetInstance().App.ResourceGoneMinutes) * time.Minute
+}
+
+//Remove resources of the rule which have no data for longer than the expiry,
+//open alerts of these resources are resolved, return true if status changed
+func (ar *AlertRunner) collectGoneResources(ruleId string, newResourceStatus map[string]StatusResource) bool {
+	expiry := getResourceGoneExpiry()
+	if expiry <= 0 {
+		return false
+	}
+
+	rule := ar.AlertConfig.Rules[ruleId]
+	needUpdate := false
+
+	for ruleResourceKey, status := range newResourceStatus {
+		ruleResource := strings.SplitN(ruleResourceKey, " ", 2)
+		if len(ruleResource) != 2 || ruleResource[0] != ruleId {
+			continue
+		}
+		if status.LastSeenTime.IsZero() || time.Since(status.LastSeenTime) < expiry {
+			continue
+		}
+
+		resourceName := ruleResource[1]
+		goneMetric := RecordedMetric{rule.RuleName, resourceName, 0, rule.Unit, "", true, nil, 0, "", "", nil}
+
+		logger.Debug(nil, "Rule[%v] Resource[%v] is gone since %v, write to message", ruleId, resourceName, status.LastSeenTime)
+		ar.writeHistory("", "resource_gone", fmt.Sprintf("%v", goneMetric), "", ruleId, resourceName)
+
+		if status.CurrentLevel != "cleared" {
+			if !config.GetInstance().App.ResourceGoneNotify {
+				logger.Debug(nil, "Rule[%v] Resource[%v] is gone, resume notification disabled", ruleId, resourceName)
+			} else if status.Flapping || status.Inhibited {
+				logger.Debug(nil, "Rule[%v] Resource[%v] is gone, resume notification held back", ruleId, resourceName)
+			} else {
+				ar.sendResumeNotification(&status, ruleId, resourceName, goneMetric, []RecordedMetric{goneMetric})
+			}
+		}
+
+		delete(newResourceStatus, ruleResourceKey)
+		delete(ar.RuleResults[ruleId], resourceName)
+
+		ar.AlertStatus.Lock()
+		delete(ar.AlertStatus.Baselines, ruleResourceKey)
+		ar.AlertStatus.Unlock()
+
+		needUpdate = true
+	}
+
+	return needUpdate
+}
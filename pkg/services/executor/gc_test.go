@@ -0,0 +1,73 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+)
+
+func TestCollectGoneResources(t *testing.T) {
+	cfg := config.GetInstance()
+	defer func(minutes int, notify bool) {
+		cfg.App.ResourceGoneMinutes = minutes
+		cfg.App.ResourceGoneNotify = notify
+	}(cfg.App.ResourceGoneMinutes, cfg.App.ResourceGoneNotify)
+
+	tests := []struct {
+		name            string
+		goneMinutes     int
+		goneNotify      bool
+		status          StatusResource
+		expectGone      bool
+		expectHistories []string
+		expectEmails    int
+	}{
+		{"seen recently", 60, true, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-59 * time.Minute)}, false, []string{}, 0},
+		{"never seen", 60, true, StatusResource{CurrentLevel: "critical"}, false, []string{}, 0},
+		{"expiry disabled", 0, true, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour)}, false, []string{}, 0},
+		{"gone cleared", 60, true, StatusResource{CurrentLevel: "cleared", LastSeenTime: time.Now().Add(-time.Hour)}, true, []string{"resource_gone rl-cpu node1"}, 0},
+		{"gone alerting", 60, true, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour)}, true, []string{"resource_gone rl-cpu node1", "sent_success rl-cpu node1"}, 1},
+		{"gone alerting without notify", 60, false, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour)}, true, []string{"resource_gone rl-cpu node1"}, 0},
+		{"gone flapping", 60, true, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour), Flapping: true}, true, []string{"resource_gone rl-cpu node1"}, 0},
+		{"gone inhibited", 60, true, StatusResource{CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour), Inhibited: true}, true, []string{"resource_gone rl-cpu node1"}, 0},
+	}
+
+	for _, test := range tests {
+		cfg.App.ResourceGoneMinutes = test.goneMinutes
+		cfg.App.ResourceGoneNotify = test.goneNotify
+
+		runner, _, history, notifier := newReplayRunner(t, "testdata/replay.json")
+		ruleResourceKey := getRuleResourceKey("rl-cpu", "node1")
+		otherResourceKey := getRuleResourceKey("rl-mem", "node1")
+		runner.AlertStatus.Baselines[ruleResourceKey] = &Baseline{}
+		runner.RuleResults["rl-cpu"] = map[string]RuleResult{"node1": {}}
+		newResourceStatus := map[string]StatusResource{
+			ruleResourceKey:  test.status,
+			otherResourceKey: {CurrentLevel: "critical", LastSeenTime: time.Now().Add(-time.Hour)},
+		}
+
+		changed := runner.collectGoneResources("rl-cpu", newResourceStatus)
+
+		_, kept := newResourceStatus[ruleResourceKey]
+		_, baselineKept := runner.AlertStatus.Baselines[ruleResourceKey]
+		_, resultKept := runner.RuleResults["rl-cpu"]["node1"]
+		if changed != test.expectGone || kept == test.expectGone || baselineKept == test.expectGone || resultKept == test.expectGone {
+			t.Errorf("%s: expect gone %v, got changed %v status kept %v baseline kept %v result kept %v", test.name, test.expectGone, changed, kept, baselineKept, resultKept)
+		}
+		if _, ok := newResourceStatus[otherResourceKey]; !ok {
+			t.Errorf("%s: resource of other rule is removed", test.name)
+		}
+		if !reflect.DeepEqual(history.rows(), test.expectHistories) {
+			t.Errorf("%s: expect histories %v, got %v", test.name, test.expectHistories, history.rows())
+		}
+		if len(notifier.emails) != test.expectEmails {
+			t.Errorf("%s: expect %d emails, got %d", test.name, test.expectEmails, len(notifier.emails))
+		}
+	}
+}
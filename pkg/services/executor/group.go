@@ -0,0 +1,102 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	//Group rules keep one status for the whole group under this resource name
+	GroupResourceName = "*"
+)
+
+func (ri *RuleInfo) isGroup() bool {
+	return ri.Group != nil
+}
+
+func (ar *AlertRunner) parseRuleGroup(ruleId string, ruleInfo *RuleInfo, groupCondition string) {
+	group, err := models.ParseGroupCondition(groupCondition)
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse group condition [%s] error: %v, rule will be disabled", ar.AlertConfig.AlertId, ruleId, groupCondition, err)
+		ruleInfo.Disabled = true
+		ruleInfo.Invalid = true
+		return
+	}
+	ruleInfo.Group = group
+}
+
+//Replace the metrics of the resources in one batch with the metric of the whole group,
+//the group metric lists the violating resources as its members
+func (ri *RuleInfo) groupMetrics(triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric, noDataMetrics *[]RecordedMetric) {
+	violatingMetrics := []RecordedMetric{}
+	level := ri.Severity
+	for _, triggeredMetric := range *triggeredMetrics {
+		if triggeredMetric.NoData {
+			continue
+		}
+		violatingMetrics = append(violatingMetrics, triggeredMetric)
+		if ri.getLevelRank(triggeredMetric.Level) > ri.getLevelRank(level) {
+			level = triggeredMetric.Level
+		}
+	}
+
+	total := len(violatingMetrics)
+	for _, resumedMetric := range *resumedMetrics {
+		if !resumedMetric.NoData {
+			total++
+		}
+	}
+
+	*triggeredMetrics = []RecordedMetric{}
+	*resumedMetrics = []RecordedMetric{}
+	*noDataMetrics = []RecordedMetric{}
+
+	if total == 0 {
+		ri.addNoDataMetric(RecordedMetric{ri.RuleName, GroupResourceName, 0, "", ri.Severity, true, nil, 0, "", "", nil}, triggeredMetrics, resumedMetrics, noDataMetrics)
+		return
+	}
+
+	groupMetric := RecordedMetric{ri.RuleName, GroupResourceName, float64(len(violatingMetrics)), "", level, false, violatingMetrics, 0, "", "", nil}
+	if ri.Group.Ratio {
+		groupMetric.Value = groupMetric.Value * 100 / float64(total)
+		groupMetric.Unit = "%"
+	}
+
+	if compareValue(ri.Group.Comparison, groupMetric.Value, ri.Group.Threshold) {
+		*triggeredMetrics = append(*triggeredMetrics, groupMetric)
+	} else {
+		groupMetric.Level = ""
+		*resumedMetrics = append(*resumedMetrics, groupMetric)
+	}
+}
+
+//Resource name shown in notifications, group rules show the resource filter of the alert
+func (ar *AlertRunner) formatResourceName(resourceName string) string {
+	if resourceName == GroupResourceName {
+		return ar.AlertConfig.RsFilterName
+	}
+	return processResourceName(resourceName)
+}
+
+func formatGroupValue(groupMetric RecordedMetric) string {
+	resources := []string{}
+	for _, member := range groupMetric.Members {
+		resources = append(resources, fmt.Sprintf("%s: %s", processResourceName(member.ResourceName), formatRecordedValue(member)))
+	}
+
+	value := fmt.Sprintf("%.0f", groupMetric.Value)
+	if groupMetric.Unit != "" {
+		value = fmt.Sprintf("%.2f%s", groupMetric.Value, groupMetric.Unit)
+	}
+	if len(resources) == 0 {
+		return value
+	}
+	return fmt.Sprintf("%s (%s)", value, strings.Join(resources, ", "))
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"kubesphere.io/alert/pkg/models"
+)
+
+//Metrics of resources named by their index, with the level of each metric
+func batchMetrics(noData bool, levels ...string) []RecordedMetric {
+	metrics := []RecordedMetric{}
+	for i, level := range levels {
+		metrics = append(metrics, RecordedMetric{ResourceName: fmt.Sprintf("node%d", i), Value: 1, Level: level, NoData: noData})
+	}
+	return metrics
+}
+
+func TestGroupMetrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		groupCondition string
+		noDataBehavior string
+		triggered      []RecordedMetric
+		resumed        []RecordedMetric
+		expectResult   string
+		expectValue    float64
+		expectLevel    string
+		expectMembers  int
+	}{
+		{"count triggered", ">2", "", batchMetrics(false, "minor", "critical", "major"), batchMetrics(false, "", ""), "triggered", 3, "critical", 3},
+		{"count resumed", ">3", "", batchMetrics(false, "minor", "minor", "minor"), batchMetrics(false, "", ""), "resumed", 3, "", 3},
+		{"ratio triggered", ">=50%", "", batchMetrics(false, "minor", "minor", "minor"), batchMetrics(false, "", ""), "triggered", 60, "minor", 3},
+		{"ratio resumed", ">=50%", "", batchMetrics(false, "minor"), batchMetrics(false, "", "", ""), "resumed", 25, "", 1},
+		//Resources without data are neither violating nor counted in the total
+		{"ratio without no data resources", ">=50%", "", append(batchMetrics(false, "minor"), batchMetrics(true, "minor")...), append(batchMetrics(false, ""), batchMetrics(true, "")...), "triggered", 50, "minor", 1},
+		{"no data", ">2", "", batchMetrics(true, "minor"), batchMetrics(true, ""), "no data", 0, "minor", 0},
+		{"no data alerts", ">2", models.NoDataBehaviorAlert, nil, nil, "triggered", 0, "minor", 0},
+	}
+
+	for _, test := range tests {
+		group, err := models.ParseGroupCondition(test.groupCondition)
+		if err != nil {
+			t.Fatalf("%s: parse group condition error %v", test.name, err)
+		}
+		ruleInfo := &RuleInfo{
+			Severity:       "minor",
+			Levels:         []LevelInfo{{Severity: "major"}, {Severity: "critical"}},
+			NoDataBehavior: test.noDataBehavior,
+			Group:          group,
+		}
+
+		triggeredMetrics := append([]RecordedMetric{}, test.triggered...)
+		resumedMetrics := append([]RecordedMetric{}, test.resumed...)
+		noDataMetrics := []RecordedMetric{}
+		ruleInfo.groupMetrics(&triggeredMetrics, &resumedMetrics, &noDataMetrics)
+
+		results := map[string][]RecordedMetric{"triggered": triggeredMetrics, "resumed": resumedMetrics, "no data": noDataMetrics}
+		if len(triggeredMetrics)+len(resumedMetrics)+len(noDataMetrics) != 1 || len(results[test.expectResult]) != 1 {
+			t.Errorf("%s: expect one %s group metric, got triggered %v resumed %v no data %v", test.name, test.expectResult, triggeredMetrics, resumedMetrics, noDataMetrics)
+			continue
+		}
+
+		groupMetric := results[test.expectResult][0]
+		if groupMetric.ResourceName != GroupResourceName || math.Abs(groupMetric.Value-test.expectValue) > 1e-9 || groupMetric.Level != test.expectLevel || len(groupMetric.Members) != test.expectMembers {
+			t.Errorf("%s: expect value %v level %s members %d, got %+v", test.name, test.expectValue, test.expectLevel, test.expectMembers, groupMetric)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+//Keys of resource filter param which refer to parent resources
+var parentFilterKeys = map[string]string{
+	"node_id": "node",
+	"ns_name": "namespace",
+	"ws_name": "workspace",
+}
+
+type InhibitSource struct {
+	AlertId      string
+	RuleId       string
+	Severity     string
+	ResourceName string
+}
+
+//Inhibitor finds resources alerted by rules with Inhibit set in the alert status persisted by all executors,
+//the status is loaded at most once a ttl and shared by all runners of the executor
+type Inhibitor struct {
+	sync.Mutex
+	Load     func() ([]rs.InhibitRule, error)
+	TTL      time.Duration
+	Sources  map[string]map[string]InhibitSource
+	loadTime time.Time
+}
+
+var inhibitor *Inhibitor
+
+var inhibitorOnce sync.Once
+
+func NewInhibitor(load func() ([]rs.InhibitRule, error), ttl time.Duration) *Inhibitor {
+	return &Inhibitor{
+		Load: load,
+		TTL:  ttl,
+	}
+}
+
+func GetInhibitor() *Inhibitor {
+	inhibitorOnce.Do(func() {
+		inhibitor = NewInhibitor(rs.QueryInhibitRules, time.Duration(config.GetInstance().App.InhibitRefreshSeconds)*time.Second)
+	})
+	return inhibitor
+}
+
+//Names of namespaced resources keep their namespace, so resources of the same name in other namespaces are not inhibited
+func getInhibitResourceKey(rsTypeName string, resourceName string) string {
+	return rsTypeName + "/" + resourceName
+}
+
+//Sources of the resources alerted by the inhibit rules in the persisted status of their alerts
+func getInhibitSources(inhibitRules []rs.InhibitRule) map[string]map[string]InhibitSource {
+	sources := make(map[string]map[string]InhibitSource)
+
+	resourceStatuses := make(map[string]map[string]StatusResource)
+	for _, inhibitRule := range inhibitRules {
+		resourceStatus, ok := resourceStatuses[inhibitRule.AlertId]
+		if !ok {
+			alertStatus := struct {
+				ResourceStatus map[string]StatusResource
+			}{}
+			err := json.Unmarshal([]byte(inhibitRule.AlertStatus), &alertStatus)
+			if err != nil {
+				logger.Debug(nil, "Parse Alert[%s] Status for inhibition error: %v", inhibitRule.AlertId, err)
+			}
+			resourceStatus = alertStatus.ResourceStatus
+			resourceStatuses[inhibitRule.AlertId] = resourceStatus
+		}
+
+		for ruleResourceKey, status := range resourceStatus {
+			ruleId, resourceName := splitRuleResourceKey(ruleResourceKey)
+			if ruleId != inhibitRule.RuleId || status.CurrentLevel == "" || status.CurrentLevel == "cleared" {
+				continue
+			}
+
+			resourceKey := getInhibitResourceKey(inhibitRule.RsTypeName, resourceName)
+			if _, ok := sources[resourceKey]; !ok {
+				sources[resourceKey] = make(map[string]InhibitSource)
+			}
+			sources[resourceKey][inhibitRule.AlertId+" "+ruleId] = InhibitSource{inhibitRule.AlertId, ruleId, status.CurrentLevel, resourceName}
+		}
+	}
+
+	return sources
+}
+
+//Load the sources again once the ttl passed, the sources loaded last are kept if loading fails
+func (in *Inhibitor) refresh(now time.Time) {
+	if in.Sources != nil && now.Sub(in.loadTime) < in.TTL {
+		return
+	}
+	in.loadTime = now
+
+	inhibitRules, err := in.Load()
+	if err != nil {
+		logger.Error(nil, "Inhibitor load inhibit rules error: %v", err)
+		if in.Sources == nil {
+			in.Sources = make(map[string]map[string]InhibitSource)
+		}
+		return
+	}
+	in.Sources = getInhibitSources(inhibitRules)
+}
+
+//Find a source with higher severity than the given severity on any of the resource keys
+func (in *Inhibitor) Find(resourceKeys []string, alertId string, ruleId string, severity string) (InhibitSource, bool) {
+	in.Lock()
+	defer in.Unlock()
+
+	in.refresh(time.Now())
+
+	for _, resourceKey := range resourceKeys {
+		for _, source := range in.Sources[resourceKey] {
+			if source.AlertId == alertId && source.RuleId == ruleId {
+				continue
+			}
+			if models.SeverityRank[source.Severity] > models.SeverityRank[severity] {
+				return source, true
+			}
+		}
+	}
+
+	return InhibitSource{}, false
+}
+
+//Get keys of the resource itself and optionally its parent resources referred by the resource filter
+func (ar *AlertRunner) getInhibitResourceKeys(resourceName string) []string {
+	resourceKeys := []string{getInhibitResourceKey(ar.AlertConfig.RsTypeName, resourceName)}
+
+	if !config.GetInstance().App.InhibitChildResources {
+		return resourceKeys
+	}
+
+	rsFilterParam := map[string]string{}
+	err := json.Unmarshal([]byte(ar.AlertConfig.RsFilterParam), &rsFilterParam)
+	if err != nil {
+		return resourceKeys
+	}
+
+	for filterKey, rsTypeName := range parentFilterKeys {
+		if rsTypeName == ar.AlertConfig.RsTypeName {
+			continue
+		}
+		for _, parentName := range strings.Split(rsFilterParam[filterKey], "|") {
+			if parentName != "" {
+				resourceKeys = append(resourceKeys, getInhibitResourceKey(rsTypeName, parentName))
+			}
+		}
+	}
+
+	return resourceKeys
+}
+
+//Check if notifications of the resource are inhibited, write inhibited history when inhibition begins
+func (ar *AlertRunner) checkInhibited(newStatus *StatusResource, ruleId string, resourceName string) bool {
+	source, inhibited := ar.Inhibitor.Find(ar.getInhibitResourceKeys(resourceName), ar.AlertConfig.AlertId, ruleId, newStatus.CurrentLevel)
+
+	if inhibited && !newStatus.Inhibited {
+		content := fmt.Sprintf("inhibited by Alert[%s] Rule[%s] Resource[%s] with severity %s", source.AlertId, source.RuleId, source.ResourceName, source.Severity)
+		logger.Debug(nil, "Rule[%v] Resource[%v] %s", ruleId, resourceName, content)
+		ar.writeHistory("", "inhibited", content, "", ruleId, resourceName)
+	}
+	newStatus.Inhibited = inhibited
+
+	return inhibited
+}
@@ -0,0 +1,184 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+//Persisted alert status with the current level of each rule and resource
+func newPersistedStatus(t *testing.T, levels map[string]string) string {
+	alertStatus := struct {
+		ResourceStatus map[string]StatusResource
+	}{make(map[string]StatusResource)}
+	for ruleResourceKey, level := range levels {
+		alertStatus.ResourceStatus[ruleResourceKey] = StatusResource{CurrentLevel: level}
+	}
+
+	data, err := json.Marshal(alertStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+type inhibitRuleLoader struct {
+	inhibitRules []rs.InhibitRule
+	err          error
+	count        int
+}
+
+func (l *inhibitRuleLoader) load() ([]rs.InhibitRule, error) {
+	l.count++
+	return l.inhibitRules, l.err
+}
+
+func TestInhibitorFind(t *testing.T) {
+	nodeStatus := newPersistedStatus(t, map[string]string{
+		"rl-down node1":   "critical",
+		"rl-down node2":   "cleared",
+		"rl-disk node3":   "major",
+		"rl-memory node4": "critical",
+	})
+	loader := &inhibitRuleLoader{inhibitRules: []rs.InhibitRule{
+		{AlertId: "al-node", RuleId: "rl-down", RsTypeName: "node", AlertStatus: nodeStatus},
+		{AlertId: "al-node", RuleId: "rl-disk", RsTypeName: "node", AlertStatus: nodeStatus},
+		{AlertId: "al-broken", RuleId: "rl-down", RsTypeName: "node", AlertStatus: "{"},
+	}}
+	inhibitor := NewInhibitor(loader.load, time.Minute)
+
+	tests := []struct {
+		name         string
+		resourceKeys []string
+		alertId      string
+		ruleId       string
+		severity     string
+		expect       bool
+	}{
+		{"higher severity inhibits", []string{"node/node1"}, "al-cpu", "rl-cpu", "major", true},
+		{"same severity does not inhibit", []string{"node/node1"}, "al-cpu", "rl-cpu", "critical", false},
+		{"lower severity does not inhibit", []string{"node/node3"}, "al-cpu", "rl-cpu", "critical", false},
+		{"major inhibits minor", []string{"node/node3"}, "al-cpu", "rl-cpu", "minor", true},
+		{"cleared resource does not inhibit", []string{"node/node2"}, "al-cpu", "rl-cpu", "minor", false},
+		{"rule without inhibit does not inhibit", []string{"node/node4"}, "al-cpu", "rl-cpu", "minor", false},
+		{"rule does not inhibit itself", []string{"node/node1"}, "al-node", "rl-down", "minor", false},
+		{"other rule of the alert is inhibited", []string{"node/node1"}, "al-node", "rl-disk", "major", true},
+		{"resource type must match", []string{"pod/node1"}, "al-cpu", "rl-cpu", "minor", false},
+		{"any resource key matches", []string{"pod/pod1", "node/node1"}, "al-cpu", "rl-cpu", "minor", true},
+	}
+	for _, test := range tests {
+		_, inhibited := inhibitor.Find(test.resourceKeys, test.alertId, test.ruleId, test.severity)
+		if inhibited != test.expect {
+			t.Errorf("%s: Find got %v, expect %v", test.name, inhibited, test.expect)
+		}
+	}
+
+	if loader.count != 1 {
+		t.Errorf("Find loaded inhibit rules %d times within ttl, expect 1", loader.count)
+	}
+}
+
+func TestInhibitorRefresh(t *testing.T) {
+	loader := &inhibitRuleLoader{inhibitRules: []rs.InhibitRule{
+		{AlertId: "al-node", RuleId: "rl-down", RsTypeName: "node", AlertStatus: newPersistedStatus(t, map[string]string{"rl-down node1": "critical"})},
+	}}
+	inhibitor := NewInhibitor(loader.load, 0)
+
+	if _, inhibited := inhibitor.Find([]string{"node/node1"}, "al-cpu", "rl-cpu", "minor"); !inhibited {
+		t.Fatalf("Find got not inhibited, expect inhibited")
+	}
+
+	//Sources loaded last are kept while loading fails
+	loader.err = errors.New("db unavailable")
+	if _, inhibited := inhibitor.Find([]string{"node/node1"}, "al-cpu", "rl-cpu", "minor"); !inhibited {
+		t.Errorf("Find with load error got not inhibited, expect sources kept")
+	}
+
+	//Status persisted by another executor is seen once loaded again
+	loader.err = nil
+	loader.inhibitRules[0].AlertStatus = newPersistedStatus(t, map[string]string{"rl-down node1": "cleared"})
+	if _, inhibited := inhibitor.Find([]string{"node/node1"}, "al-cpu", "rl-cpu", "minor"); inhibited {
+		t.Errorf("Find after resume got inhibited, expect not inhibited")
+	}
+	if loader.count != 3 {
+		t.Errorf("Find loaded inhibit rules %d times with ttl 0, expect 3", loader.count)
+	}
+}
+
+func TestGetInhibitResourceKeys(t *testing.T) {
+	cfg := config.GetInstance()
+	defer func(inhibitChildResources bool) { cfg.App.InhibitChildResources = inhibitChildResources }(cfg.App.InhibitChildResources)
+
+	runner := &AlertRunner{}
+	runner.AlertConfig = ConfigAlert{
+		RsTypeName:    "pod",
+		RsFilterParam: `{"node_id":"node1|node2","ns_name":"ns1","pod_name":"pod1"}`,
+	}
+
+	tests := []struct {
+		inhibitChildResources bool
+		expect                []string
+	}{
+		{false, []string{"pod/ns1:pod1"}},
+		{true, []string{"namespace/ns1", "node/node1", "node/node2", "pod/ns1:pod1"}},
+	}
+	for _, test := range tests {
+		cfg.App.InhibitChildResources = test.inhibitChildResources
+		got := runner.getInhibitResourceKeys("ns1:pod1")
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("getInhibitResourceKeys InhibitChildResources %v got %v, expect %v", test.inhibitChildResources, got, test.expect)
+		}
+	}
+}
+
+func TestCheckInhibited(t *testing.T) {
+	loader := &inhibitRuleLoader{inhibitRules: []rs.InhibitRule{
+		{AlertId: "al-node", RuleId: "rl-down", RsTypeName: "node", AlertStatus: newPersistedStatus(t, map[string]string{"rl-down node1": "critical"})},
+	}}
+	history := &fakeHistoryWriter{}
+	runner := &AlertRunner{History: history, Inhibitor: NewInhibitor(loader.load, time.Minute)}
+	runner.AlertConfig = ConfigAlert{AlertId: "al-cpu", RsTypeName: "node"}
+
+	status := &StatusResource{CurrentLevel: "major"}
+	for i := 0; i < 2; i++ {
+		if !runner.checkInhibited(status, "rl-cpu", "node1") || !status.Inhibited {
+			t.Errorf("checkInhibited got not inhibited, expect inhibited")
+		}
+	}
+	//Inhibited history is written once when inhibition begins
+	if got := history.rows(); !reflect.DeepEqual(got, []string{"inhibited rl-cpu node1"}) {
+		t.Errorf("checkInhibited got histories %v", got)
+	}
+
+	status = &StatusResource{CurrentLevel: "major", Inhibited: true}
+	if runner.checkInhibited(status, "rl-cpu", "node2") || status.Inhibited {
+		t.Errorf("checkInhibited of other resource got inhibited, expect not inhibited")
+	}
+}
+
+func TestCheckInhibitedNamespaces(t *testing.T) {
+	loader := &inhibitRuleLoader{inhibitRules: []rs.InhibitRule{
+		{AlertId: "al-crash", RuleId: "rl-crash", RsTypeName: "pod", AlertStatus: newPersistedStatus(t, map[string]string{"rl-crash ns1:web": "critical"})},
+	}}
+	runner := &AlertRunner{History: &fakeHistoryWriter{}, Inhibitor: NewInhibitor(loader.load, time.Minute)}
+	runner.AlertConfig = ConfigAlert{AlertId: "al-cpu", RsTypeName: "pod"}
+
+	if !runner.checkInhibited(&StatusResource{CurrentLevel: "major"}, "rl-cpu", "ns1:web") {
+		t.Errorf("checkInhibited of the pod in the same namespace got not inhibited, expect inhibited")
+	}
+	//Pods of the same name in other namespaces are other resources
+	if runner.checkInhibited(&StatusResource{CurrentLevel: "major"}, "rl-cpu", "ns2:web") {
+		t.Errorf("checkInhibited of the pod in another namespace got inhibited, expect not inhibited")
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+type LevelInfo struct {
+	Severity   string
+	Thresholds float64
+	Expression *exprutil.Expr
+}
+
+//Parse levels above the rule severity, ordered from low to high severity
+func (ar *AlertRunner) parseRuleLevels(ruleId string, ruleInfo *RuleInfo, levels string) {
+	if levels == "" {
+		return
+	}
+
+	ruleLevels := []models.RuleLevel{}
+	err := json.Unmarshal([]byte(levels), &ruleLevels)
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse levels [%s] error: %v, levels will be ignored", ar.AlertConfig.AlertId, ruleId, levels, err)
+		return
+	}
+
+	for _, ruleLevel := range ruleLevels {
+		threshold, expr, err := parseThresholds(ruleInfo.ConditionType, ruleLevel.Thresholds)
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Rule[%s] parse level [%s] thresholds [%s] error: %v, level will be ignored", ar.AlertConfig.AlertId, ruleId, ruleLevel.Severity, ruleLevel.Thresholds, err)
+			continue
+		}
+		ruleInfo.Levels = append(ruleInfo.Levels, LevelInfo{ruleLevel.Severity, threshold, expr})
+	}
+}
+
+//Get the severity of the highest level satisfied by the scaled value v, the rule severity is the lowest level
+func (ri *RuleInfo) getLevel(v float64) string {
+	severity := ri.Severity
+	for _, level := range ri.Levels {
+		satisfied, err := level.checkCondition(ri.ConditionType, v)
+		if err == nil && satisfied {
+			severity = level.Severity
+		}
+	}
+	return severity
+}
+
+//Rank of the severity among levels of the rule, -1 if unknown
+func (ri *RuleInfo) getLevelRank(severity string) int {
+	if severity == ri.Severity {
+		return 0
+	}
+	for i, level := range ri.Levels {
+		if level.Severity == severity {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+//Get the policy config of the current level of the resource, the rule severity is used if the level has no policy config
+func (ar *AlertRunner) getPolicyConfig(ruleId string, status *StatusResource) ConfigPolicy {
+	if status != nil {
+		if policyConfig, ok := ar.AlertConfig.PolicyConfig[status.CurrentLevel]; ok {
+			return policyConfig
+		}
+	}
+	return ar.AlertConfig.PolicyConfig[ar.AlertConfig.Rules[ruleId].Severity]
+}
+
+//Move the alerting resource to another level, repeat settings of the new level are applied from now on
+func (ar *AlertRunner) changeLevel(newStatus *StatusResource, ruleId string, resourceName string, recordedMetric RecordedMetric) {
+	rule := ar.AlertConfig.Rules[ruleId]
+	oldLevel := newStatus.CurrentLevel
+
+	event := "escalated"
+	if rule.getLevelRank(recordedMetric.Level) < rule.getLevelRank(oldLevel) {
+		event = "deescalated"
+	}
+
+	newStatus.CurrentLevel = recordedMetric.Level
+	newStatus.CumulatedSendCount = 0
+	newStatus.NextResendInterval = ar.getPolicyConfig(ruleId, newStatus).RepeatIntervalInitvalue
+	newStatus.NextSendableTime = time.Now()
+
+	logger.Debug(nil, "Rule[%v] Resource[%v] %s from %s to %s", ruleId, resourceName, event, oldLevel, newStatus.CurrentLevel)
+	ar.writeHistory("", event, fmt.Sprintf("%s -> %s %v", oldLevel, newStatus.CurrentLevel, recordedMetric), "", ruleId, resourceName)
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	NoDataValue = "-"
+)
+
+//Put the no data metric into the list matching the no data behavior of the rule
+func (ri *RuleInfo) addNoDataMetric(noDataMetric RecordedMetric, triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric, noDataMetrics *[]RecordedMetric) {
+	switch ri.NoDataBehavior {
+	case models.NoDataBehaviorAlert:
+		*triggeredMetrics = append(*triggeredMetrics, noDataMetric)
+	case models.NoDataBehaviorResolve:
+		*resumedMetrics = append(*resumedMetrics, noDataMetric)
+	default:
+		*noDataMetrics = append(*noDataMetrics, noDataMetric)
+	}
+}
+
+//Get resources of the rule which have status but are absent from the metric result
+func (ar *AlertRunner) getAbsentResources(resourceMetrics metric.ResourceMetrics) []string {
+	absentResources := []string{}
+
+	ar.AlertStatus.RLock()
+	defer ar.AlertStatus.RUnlock()
+
+	for k := range ar.AlertStatus.ResourceStatus {
+		ruleResource := strings.SplitN(k, " ", 2)
+		if len(ruleResource) != 2 || ruleResource[0] != resourceMetrics.RuleId || ruleResource[1] == GroupResourceName {
+			continue
+		}
+		if _, ok := resourceMetrics.ResourceMetric[ruleResource[1]]; !ok {
+			absentResources = append(absentResources, ruleResource[1])
+		}
+	}
+
+	return absentResources
+}
+
+//Write nodata history when the resource begins to have no data, return true if status changed
+func (ar *AlertRunner) checkNoData(oldStatus StatusResource, ruleId string, recordedMetric RecordedMetric) bool {
+	if !recordedMetric.NoData || oldStatus.NoData {
+		return false
+	}
+
+	resourceName := recordedMetric.ResourceName
+	logger.Debug(nil, "Rule[%v] Resource[%v] has no data, write to message", ruleId, resourceName)
+	ar.writeHistory("", "nodata", fmt.Sprintf("%v", recordedMetric), "", ruleId, resourceName)
+	return true
+}
+
+func formatRecordedValue(recordedMetric RecordedMetric) string {
+	if recordedMetric.NoData {
+		return NoDataValue
+	}
+	if recordedMetric.ResourceName == GroupResourceName {
+		return formatGroupValue(recordedMetric)
+	}
+	if len(recordedMetric.Members) > 0 {
+		return formatMemberValues(recordedMetric.Members)
+	}
+	if recordedMetric.Message != "" {
+		return fmt.Sprintf("%.2f%s (%s)", recordedMetric.Value, recordedMetric.Unit, recordedMetric.Message)
+	}
+	return fmt.Sprintf("%.2f%s", recordedMetric.Value, recordedMetric.Unit)
+}
@@ -0,0 +1,70 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"kubesphere.io/alert/pkg/client/adapter"
+	nf "kubesphere.io/alert/pkg/client/notification"
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+//HistoryWriter stores the history rows written by the runner
+type HistoryWriter interface {
+	CreateHistory(history *models.History) error
+}
+
+//DBHistoryWriter stores history rows into the database
+type DBHistoryWriter struct {
+}
+
+func (hw *DBHistoryWriter) CreateHistory(history *models.History) error {
+	return rs.CreateHistory(nil, history)
+}
+
+//Notifier renders notifications into emails and sends them to a notification address list
+type Notifier interface {
+	FormatEmail(notificationParam notification.NotificationParam, resume bool, language string) (*notification.Email, error)
+	SendEmail(nfAddressListId string, email *notification.Email) (bool, string)
+}
+
+//AdapterNotifier renders emails by the adapter and sends them by the notification service
+type AdapterNotifier struct {
+}
+
+func (an *AdapterNotifier) FormatEmail(notificationParam notification.NotificationParam, resume bool, language string) (*notification.Email, error) {
+	notificationParamBytes, err := json.Marshal(notificationParam)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.GetInstance().App.AdapterEmailTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	emailStr, err := adapter.SendEmailRequest(ctx, string(notificationParamBytes), strconv.FormatBool(resume), language)
+	if err != nil {
+		return nil, err
+	}
+
+	email := notification.Email{}
+	err = json.Unmarshal([]byte(emailStr), &email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &email, nil
+}
+
+func (an *AdapterNotifier) SendEmail(nfAddressListId string, email *notification.Email) (bool, string) {
+	return nf.SendNotification("other", fmt.Sprintf(`["%s"]`, nfAddressListId), email.Title, email.Content)
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+)
+
+//Merge all metrics of the rule returned in this tick into the metric checked for the rule,
+//metric expressions are evaluated and the offset window is attached
+func (ar *AlertRunner) mergeResourceMetrics(ruleId string, resourceMetricsList []metric.ResourceMetrics) metric.ResourceMetrics {
+	rule := ar.AlertConfig.Rules[ruleId]
+
+	currentList := []metric.ResourceMetrics{}
+	offsetList := []metric.ResourceMetrics{}
+	for _, resourceMetrics := range resourceMetricsList {
+		if resourceMetrics.OffsetSeconds != 0 {
+			offsetList = append(offsetList, resourceMetrics)
+		} else {
+			currentList = append(currentList, resourceMetrics)
+		}
+	}
+
+	merge := func(list []metric.ResourceMetrics) metric.ResourceMetrics {
+		if rule.MetricExpression != nil {
+			return metric.CombineResourceMetrics(ruleId, rule.MetricExpression, list)
+		}
+		if len(list) == 0 {
+			return metric.ResourceMetrics{RuleId: ruleId}
+		}
+		return list[0]
+	}
+
+	current := merge(currentList)
+	if rule.OffsetWindow != 0 {
+		current.OffsetMetric = merge(offsetList).ResourceMetric
+	}
+	return current
+}
+
+//Compare the aggregated value of the window with the value of the offset window as a ratio or a difference
+func (ri *RuleInfo) offsetValue(tvs []metric.TV, offsetTVs []metric.TV, scale float64) (float64, error) {
+	if len(offsetTVs) == 0 {
+		return 0, fmt.Errorf("no data in offset window")
+	}
+
+	v, err := ri.Aggregation.Aggregate(tvs, scale)
+	if err != nil {
+		return 0, err
+	}
+	offsetValue, err := ri.Aggregation.Aggregate(offsetTVs, scale)
+	if err != nil {
+		return 0, err
+	}
+
+	if ri.Change == models.ConditionChangeDiff {
+		return v - offsetValue, nil
+	}
+	if offsetValue == 0 {
+		return 0, fmt.Errorf("zero value in offset window")
+	}
+	return v / offsetValue, nil
+}
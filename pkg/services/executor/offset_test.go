@@ -0,0 +1,145 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+func tvs(values ...string) []metric.TV {
+	result := []metric.TV{}
+	for i, v := range values {
+		result = append(result, metric.TV{T: int64(1600000000 + 60*i), V: v})
+	}
+	return result
+}
+
+func TestOffsetValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		change      string
+		tvs         []metric.TV
+		offsetTVs   []metric.TV
+		expectValue float64
+		expectErr   bool
+	}{
+		{"ratio", models.ConditionChangeRatio, tvs("3", "6"), tvs("2", "4"), 1.5, false},
+		{"diff", models.ConditionChangeDiff, tvs("3", "6"), tvs("2", "4"), 1.5, false},
+		{"negative diff", models.ConditionChangeDiff, tvs("1"), tvs("4"), -3, false},
+		{"ratio with zero offset value", models.ConditionChangeRatio, tvs("3"), tvs("0"), 0, true},
+		{"diff with zero offset value", models.ConditionChangeDiff, tvs("3"), tvs("0"), 3, false},
+		{"no data in offset window", models.ConditionChangeRatio, tvs("3"), nil, 0, true},
+		{"no data in window", models.ConditionChangeRatio, nil, tvs("3"), 0, true},
+	}
+
+	for _, test := range tests {
+		ruleInfo := &RuleInfo{Change: test.change, Aggregation: metric.Aggregation{Kind: metric.AggregationAvg}}
+		v, err := ruleInfo.offsetValue(test.tvs, test.offsetTVs, 1)
+		if (err != nil) != test.expectErr || math.Abs(v-test.expectValue) > 1e-9 {
+			t.Errorf("%s: expect value %v error %v, got %v %v", test.name, test.expectValue, test.expectErr, v, err)
+		}
+	}
+}
+
+func TestMergeResourceMetrics(t *testing.T) {
+	expr, err := exprutil.Parse("used / total")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runner := &AlertRunner{}
+	runner.AlertConfig.Rules = map[string]RuleInfo{
+		"rl-cpu":   {OffsetWindow: 0},
+		"rl-ratio": {OffsetWindow: 3600},
+		"rl-usage": {OffsetWindow: 3600, MetricExpression: expr},
+	}
+
+	current := metric.ResourceMetrics{RuleId: "rl-ratio", MetricName: "used", ResourceMetric: map[string][]metric.TV{"node1": tvs("2")}}
+	offset := metric.ResourceMetrics{RuleId: "rl-ratio", MetricName: "used", ResourceMetric: map[string][]metric.TV{"node1": tvs("1")}, OffsetSeconds: 3600}
+
+	merged := runner.mergeResourceMetrics("rl-ratio", []metric.ResourceMetrics{offset, current})
+	if !reflect.DeepEqual(merged.ResourceMetric, current.ResourceMetric) || !reflect.DeepEqual(merged.OffsetMetric, offset.ResourceMetric) {
+		t.Errorf("expect current %v offset %v, got %+v", current.ResourceMetric, offset.ResourceMetric, merged)
+	}
+
+	merged = runner.mergeResourceMetrics("rl-ratio", []metric.ResourceMetrics{current})
+	if len(merged.OffsetMetric) != 0 || !reflect.DeepEqual(merged.ResourceMetric, current.ResourceMetric) {
+		t.Errorf("expect no offset metric without offset results, got %+v", merged)
+	}
+
+	merged = runner.mergeResourceMetrics("rl-ratio", []metric.ResourceMetrics{offset})
+	if merged.RuleId != "rl-ratio" || len(merged.ResourceMetric) != 0 || !reflect.DeepEqual(merged.OffsetMetric, offset.ResourceMetric) {
+		t.Errorf("expect empty current metric without current results, got %+v", merged)
+	}
+
+	merged = runner.mergeResourceMetrics("rl-cpu", []metric.ResourceMetrics{current})
+	if merged.OffsetMetric != nil {
+		t.Errorf("expect no offset metric for rule without offset window, got %+v", merged)
+	}
+
+	//Expressions are evaluated separately for the window and the offset window
+	merged = runner.mergeResourceMetrics("rl-usage", []metric.ResourceMetrics{
+		{RuleId: "rl-usage", MetricName: "used", ResourceMetric: map[string][]metric.TV{"node1": tvs("2"), "node2": tvs("1")}},
+		{RuleId: "rl-usage", MetricName: "total", ResourceMetric: map[string][]metric.TV{"node1": tvs("4")}},
+		{RuleId: "rl-usage", MetricName: "used", ResourceMetric: map[string][]metric.TV{"node1": tvs("1")}, OffsetSeconds: 3600},
+		{RuleId: "rl-usage", MetricName: "total", ResourceMetric: map[string][]metric.TV{"node1": tvs("4")}, OffsetSeconds: 3600},
+	})
+	if len(merged.ResourceMetric) != 1 || len(merged.OffsetMetric) != 1 {
+		t.Fatalf("expect node1 in window and offset window, got %+v", merged)
+	}
+	ruleInfo := &RuleInfo{Change: models.ConditionChangeRatio, Aggregation: metric.Aggregation{Kind: metric.AggregationLast}}
+	v, err := ruleInfo.offsetValue(merged.ResourceMetric["node1"], merged.OffsetMetric["node1"], 1)
+	if err != nil || math.Abs(v-2) > 1e-9 {
+		t.Errorf("expect ratio 2 of expression values, got %v %v", v, err)
+	}
+}
+
+//Source failing requests of offset windows
+type offsetFailingSource struct {
+	source MetricSource
+}
+
+func (fs *offsetFailingSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	if metricParam.ExtraQueryParams != "" {
+		return nil, fmt.Errorf("offset window unavailable")
+	}
+	return fs.source.GetMetrics(ctx, metricParam)
+}
+
+func TestGetOneMetricOffsetFailed(t *testing.T) {
+	runner, source, _, _ := newReplayRunner(t, "testdata/replay.json")
+	runner.Source = &offsetFailingSource{source}
+	ratioRule := runner.AlertConfig.Rules["rl-cpu"]
+	ratioRule.Change = models.ConditionChangeRatio
+	ratioRule.OffsetWindow = 3600
+	runner.AlertConfig.Rules["rl-ratio"] = ratioRule
+	runner.AlertConfig.Scheduler.addRule("rl-ratio", 60, time.Now())
+
+	ch := make(chan metric.ResourceMetrics, 10)
+	err := runner.getOneMetric(context.Background(), 60, ch)
+	close(ch)
+
+	if err == nil {
+		t.Errorf("expect error of the offset window, got nil")
+	}
+	ruleIds := []string{}
+	for rm := range ch {
+		ruleIds = append(ruleIds, rm.RuleId)
+	}
+	sort.Strings(ruleIds)
+	if !reflect.DeepEqual(ruleIds, []string{"rl-cpu"}) {
+		t.Errorf("expect only metrics of rules without failed offset window, got %v", ruleIds)
+	}
+}
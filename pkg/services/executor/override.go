@@ -0,0 +1,79 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"kubesphere.io/alert/pkg/logger"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+	"kubesphere.io/alert/pkg/util/exprutil"
+)
+
+//OverrideInfo replaces thresholds of a rule for one resource
+type OverrideInfo struct {
+	Thresholds         float64
+	Expression         *exprutil.Expr
+	HasRecovery        bool
+	RecoveryThresholds float64
+	RecoveryExpression *exprutil.Expr
+}
+
+func (ar *AlertRunner) parseRuleOverrides(overrideDetails []rs.RuleOverrideDetail) {
+	for _, overrideDetail := range overrideDetails {
+		ruleInfo, ok := ar.AlertConfig.Rules[overrideDetail.RuleId]
+		if !ok || ruleInfo.isComposite() {
+			continue
+		}
+		if len(ruleInfo.Levels) > 0 {
+			logger.Error(nil, "Alert[%s] Rule[%s] with levels can not be overridden for Resource[%s], override will be ignored", ar.AlertConfig.AlertId, overrideDetail.RuleId, overrideDetail.ResourceName)
+			continue
+		}
+
+		overrideInfo := OverrideInfo{}
+		threshold, expr, err := parseThresholds(ruleInfo.ConditionType, overrideDetail.Thresholds)
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Rule[%s] parse override thresholds [%s] of Resource[%s] error: %v, override will be ignored", ar.AlertConfig.AlertId, overrideDetail.RuleId, overrideDetail.Thresholds, overrideDetail.ResourceName, err)
+			continue
+		}
+		overrideInfo.Thresholds = threshold
+		overrideInfo.Expression = expr
+
+		if overrideDetail.RecoveryThresholds != "" {
+			threshold, expr, err := parseThresholds(ruleInfo.ConditionType, overrideDetail.RecoveryThresholds)
+			if err != nil {
+				logger.Error(nil, "Alert[%s] Rule[%s] parse override recovery thresholds [%s] of Resource[%s] error: %v, thresholds will be used", ar.AlertConfig.AlertId, overrideDetail.RuleId, overrideDetail.RecoveryThresholds, overrideDetail.ResourceName, err)
+			} else {
+				overrideInfo.RecoveryThresholds = threshold
+				overrideInfo.RecoveryExpression = expr
+				overrideInfo.HasRecovery = true
+			}
+		}
+
+		if ruleInfo.Overrides == nil {
+			ruleInfo.Overrides = make(map[string]OverrideInfo)
+		}
+		ruleInfo.Overrides[overrideDetail.ResourceName] = overrideInfo
+		ar.AlertConfig.Rules[overrideDetail.RuleId] = ruleInfo
+	}
+}
+
+//Get the rule with thresholds overridden for the resource
+func (ri RuleInfo) forResource(resourceName string) RuleInfo {
+	overrideInfo, ok := ri.Overrides[resourceName]
+	if !ok {
+		return ri
+	}
+
+	return ri.withThresholds(overrideInfo)
+}
+
+//Get the rule with thresholds replaced
+func (ri RuleInfo) withThresholds(overrideInfo OverrideInfo) RuleInfo {
+	ri.Thresholds = overrideInfo.Thresholds
+	ri.Expression = overrideInfo.Expression
+	ri.HasRecovery = overrideInfo.HasRecovery
+	ri.RecoveryThresholds = overrideInfo.RecoveryThresholds
+	ri.RecoveryExpression = overrideInfo.RecoveryExpression
+	return ri
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"kubesphere.io/alert/pkg/models"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+func overrideDetail(ruleId string, resourceName string, thresholds string, recoveryThresholds string) rs.RuleOverrideDetail {
+	return rs.RuleOverrideDetail{RuleId: ruleId, ResourceName: resourceName, Thresholds: thresholds, RecoveryThresholds: recoveryThresholds}
+}
+
+func TestParseRuleOverrides(t *testing.T) {
+	tests := []struct {
+		name           string
+		overrideDetail rs.RuleOverrideDetail
+		expectOverride bool
+		expectRecovery bool
+	}{
+		{"thresholds", overrideDetail("rl-cpu", "node1", "0.5", ""), true, false},
+		{"thresholds and recovery", overrideDetail("rl-cpu", "node1", "0.5", "0.4"), true, true},
+		{"illegal recovery uses thresholds", overrideDetail("rl-cpu", "node1", "0.5", "low"), true, false},
+		{"illegal thresholds are ignored", overrideDetail("rl-cpu", "node1", "high", "0.4"), false, false},
+		{"unknown rule is ignored", overrideDetail("rl-gone", "node1", "0.5", ""), false, false},
+		{"composite rule is ignored", overrideDetail("rl-and", "node1", "0.5", ""), false, false},
+		{"rule with levels is ignored", overrideDetail("rl-levels", "node1", "0.5", ""), false, false},
+	}
+
+	for _, test := range tests {
+		runner := &AlertRunner{}
+		runner.AlertConfig.Rules = map[string]RuleInfo{
+			"rl-cpu":    {ConditionType: models.ConditionTypeGreater, Thresholds: 0.9},
+			"rl-and":    {ConditionType: models.ConditionTypeAnd, Members: []string{"rl-cpu", "rl-mem"}},
+			"rl-levels": {ConditionType: models.ConditionTypeGreater, Thresholds: 0.8, Levels: []LevelInfo{{Thresholds: 0.9, Severity: models.SeverityCritical}}},
+		}
+
+		runner.parseRuleOverrides([]rs.RuleOverrideDetail{test.overrideDetail})
+
+		overrideInfo, ok := runner.AlertConfig.Rules[test.overrideDetail.RuleId].Overrides[test.overrideDetail.ResourceName]
+		if ok != test.expectOverride || overrideInfo.HasRecovery != test.expectRecovery {
+			t.Errorf("%s: expect override %v recovery %v, got %v %+v", test.name, test.expectOverride, test.expectRecovery, ok, overrideInfo)
+		}
+		if _, ok := runner.AlertConfig.Rules["rl-gone"]; ok {
+			t.Errorf("%s: unknown rule is added", test.name)
+		}
+	}
+}
+
+func TestRuleForResource(t *testing.T) {
+	runner := &AlertRunner{}
+	runner.AlertConfig.Rules = map[string]RuleInfo{
+		"rl-cpu": {ConditionType: models.ConditionTypeGreater, Thresholds: 0.9, HasRecovery: true, RecoveryThresholds: 0.8},
+	}
+	runner.parseRuleOverrides([]rs.RuleOverrideDetail{
+		overrideDetail("rl-cpu", "node1", "0.5", "0.4"),
+		overrideDetail("rl-cpu", "node2", "0.5", ""),
+	})
+	rule := runner.AlertConfig.Rules["rl-cpu"]
+
+	tests := []struct {
+		name         string
+		resourceName string
+		v            float64
+		alerting     bool
+		expectSet    bool
+	}{
+		{"rule thresholds", "node3", 0.6, false, false},
+		{"rule recovery thresholds", "node3", 0.85, true, true},
+		{"override thresholds", "node1", 0.6, false, true},
+		{"override recovery thresholds", "node1", 0.45, true, true},
+		{"override recovered", "node1", 0.35, true, false},
+		//Override without recovery thresholds does not keep the recovery thresholds of the rule
+		{"override without recovery", "node2", 0.45, true, false},
+	}
+
+	for _, test := range tests {
+		resourceRule := rule.forResource(test.resourceName)
+		set, err := resourceRule.checkCondition(test.v, test.alerting)
+		if err != nil || set != test.expectSet {
+			t.Errorf("%s: expect set %v, got %v %v", test.name, test.expectSet, set, err)
+		}
+	}
+}
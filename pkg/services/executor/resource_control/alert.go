@@ -27,6 +27,14 @@ type AlertDetail struct {
 	NfAddressListId    string `gorm:"column:nf_address_list_id" json:"nf_address_list_id"`
 }
 
+//InhibitRule is an enabled rule with inhibit set of a running alert, with the alert status persisted by its executor
+type InhibitRule struct {
+	AlertId     string `gorm:"column:alert_id" json:"alert_id"`
+	RuleId      string `gorm:"column:rule_id" json:"rule_id"`
+	RsTypeName  string `gorm:"column:rs_type_name" json:"rs_type_name"`
+	AlertStatus string `gorm:"column:alert_status" json:"alert_status"`
+}
+
 type RunnerInfo struct {
 	AlertId     string
 	AlertStatus string
@@ -67,6 +75,26 @@ func QueryAlertDetail(alertId string) (AlertDetail, error) {
 	return ad, nil
 }
 
+//QueryInhibitRules returns the inhibit rules of the running alerts of all executors
+func QueryInhibitRules() ([]InhibitRule, error) {
+	var inhibitRules []InhibitRule
+
+	err := global.GetInstance().GetDB().Table("alert t1").
+		Select("t1.alert_id, t2.rule_id, t4.rs_type_name, t1.alert_status").
+		Joins("join rule t2 on t2.policy_id=t1.policy_id").
+		Joins("left join resource_filter t3 on t3.rs_filter_id=t1.rs_filter_id").
+		Joins("left join resource_type t4 on t4.rs_type_id=t3.rs_type_id").
+		Where("t1.disabled = ? AND t1.running_status = ? AND t2.disabled = ? AND t2.inhibit = ?", false, "running", false, true).
+		Scan(&inhibitRules).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryInhibitRules, error: %+v.", err)
+		return nil, err
+	}
+
+	return inhibitRules, nil
+}
+
 func GetAlertInfo(alertId string) models.Alert {
 	db := global.GetInstance().GetDB()
 	var alert models.Alert
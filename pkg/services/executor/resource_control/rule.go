@@ -7,24 +7,36 @@ import (
 )
 
 type RuleDetail struct {
-	RuleId           string `gorm:"column:rule_id" json:"rule_id"`
-	RuleName         string `gorm:"column:rule_name" json:"rule_name"`
-	Disabled         bool   `gorm:"column:disabled" json:"disabled"`
-	MonitorPeriods   uint32 `gorm:"column:monitor_periods" json:"monitor_periods"`
-	Severity         string `gorm:"column:severity" json:"severity"`
-	MetricsType      string `gorm:"column:metrics_type" json:"metrics_type"`
-	ConditionType    string `gorm:"column:condition_type" json:"condition_type"`
-	Thresholds       string `gorm:"column:thresholds" json:"thresholds"`
-	Unit             string `gorm:"column:unit" json:"unit"`
-	ConsecutiveCount uint32 `gorm:"column:consecutive_count" json:"consecutive_count"`
-	Inhibit          bool   `gorm:"column:inhibit" json:"inhibit"`
-	MetricName       string `gorm:"column:metric_name" json:"metric_name"`
-	MetricParam      string `gorm:"column:metric_param" json:"metric_param"`
+	RuleId                   string `gorm:"column:rule_id" json:"rule_id"`
+	RuleName                 string `gorm:"column:rule_name" json:"rule_name"`
+	Disabled                 bool   `gorm:"column:disabled" json:"disabled"`
+	MonitorPeriods           uint32 `gorm:"column:monitor_periods" json:"monitor_periods"`
+	Severity                 string `gorm:"column:severity" json:"severity"`
+	MetricsType              string `gorm:"column:metrics_type" json:"metrics_type"`
+	ConditionType            string `gorm:"column:condition_type" json:"condition_type"`
+	Thresholds               string `gorm:"column:thresholds" json:"thresholds"`
+	Unit                     string `gorm:"column:unit" json:"unit"`
+	ConsecutiveCount         uint32 `gorm:"column:consecutive_count" json:"consecutive_count"`
+	Inhibit                  bool   `gorm:"column:inhibit" json:"inhibit"`
+	Aggregation              string `gorm:"column:aggregation" json:"aggregation"`
+	NoDataBehavior           string `gorm:"column:no_data_behavior" json:"no_data_behavior"`
+	RecoveryThresholds       string `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	ConsecutiveRecoveryCount uint32 `gorm:"column:consecutive_recovery_count" json:"consecutive_recovery_count"`
+	Levels                   string `gorm:"column:levels" json:"levels"`
+	ForecastHorizon          uint32 `gorm:"column:forecast_horizon" json:"forecast_horizon"`
+	EvaluationInterval       uint32 `gorm:"column:evaluation_interval" json:"evaluation_interval"`
+	GroupCondition           string `gorm:"column:group_condition" json:"group_condition"`
+	MetricExpression         string `gorm:"column:metric_expression" json:"metric_expression"`
+	ThresholdSchedule        string `gorm:"column:threshold_schedule" json:"threshold_schedule"`
+	OffsetWindow             uint32 `gorm:"column:offset_window" json:"offset_window"`
+	Script                   string `gorm:"column:script" json:"script"`
+	MetricName               string `gorm:"column:metric_name" json:"metric_name"`
+	MetricParam              string `gorm:"column:metric_param" json:"metric_param"`
 }
 
 func QueryRuleDetails(alertId string) []RuleDetail {
 	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("rule t1").
-		Select("t1.rule_id,t1.rule_name,t1.disabled,t1.monitor_periods,t1.severity,t1.metrics_type,t1.condition_type,t1.thresholds,t1.unit,t1.consecutive_count,t1.inhibit,t1.policy_id,t2.metric_name,t2.metric_param").
+		Select("t1.rule_id,t1.rule_name,t1.disabled,t1.monitor_periods,t1.severity,t1.metrics_type,t1.condition_type,t1.thresholds,t1.unit,t1.consecutive_count,t1.inhibit,t1.aggregation,t1.no_data_behavior,t1.recovery_thresholds,t1.consecutive_recovery_count,t1.levels,t1.forecast_horizon,t1.evaluation_interval,t1.group_condition,t1.metric_expression,t1.threshold_schedule,t1.offset_window,t1.script,t1.policy_id,t2.metric_name,t2.metric_param").
 		Joins("left join metric t2 on t2.metric_id=t1.metric_id"))
 
 	dbChain.DB = dbChain.DB.Where("t1.policy_id in (select policy_id from alert where alert_id = ?)", alertId)
@@ -41,3 +53,30 @@ func QueryRuleDetails(alertId string) []RuleDetail {
 
 	return rds
 }
+
+type RuleOverrideDetail struct {
+	RuleId             string `gorm:"column:rule_id" json:"rule_id"`
+	ResourceName       string `gorm:"column:resource_name" json:"resource_name"`
+	Thresholds         string `gorm:"column:thresholds" json:"thresholds"`
+	RecoveryThresholds string `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+}
+
+func QueryRuleOverrides(alertId string) []RuleOverrideDetail {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("rule_override t1").
+		Select("t1.rule_id,t1.resource_name,t1.thresholds,t1.recovery_thresholds").
+		Joins("left join rule t2 on t2.rule_id=t1.rule_id"))
+
+	dbChain.DB = dbChain.DB.Where("t2.policy_id in (select policy_id from alert where alert_id = ?)", alertId)
+
+	var rods []RuleOverrideDetail
+
+	err := dbChain.
+		Scan(&rods).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryRuleOverrides [%v], error: %+v.", alertId, err)
+		return nil
+	}
+
+	return rods
+}
@@ -0,0 +1,39 @@
+package resource_control
+
+import (
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+)
+
+type SloDetail struct {
+	SloId           string `gorm:"column:slo_id" json:"slo_id"`
+	SloName         string `gorm:"column:slo_name" json:"slo_name"`
+	Target          string `gorm:"column:target" json:"target"`
+	WindowDays      uint32 `gorm:"column:window_days" json:"window_days"`
+	BurnWindows     string `gorm:"column:burn_windows" json:"burn_windows"`
+	Disabled        bool   `gorm:"column:disabled" json:"disabled"`
+	GoodMetricName  string `gorm:"column:good_metric_name" json:"good_metric_name"`
+	TotalMetricName string `gorm:"column:total_metric_name" json:"total_metric_name"`
+}
+
+func QuerySloDetails(alertId string) []SloDetail {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("slo t1").
+		Select("t1.slo_id,t1.slo_name,t1.target,t1.window_days,t1.burn_windows,t1.disabled,t2.metric_name as good_metric_name,t3.metric_name as total_metric_name").
+		Joins("left join metric t2 on t2.metric_id=t1.good_metric_id").
+		Joins("left join metric t3 on t3.metric_id=t1.total_metric_id"))
+
+	dbChain.DB = dbChain.DB.Where("t1.policy_id in (select policy_id from alert where alert_id = ?)", alertId)
+
+	var sds []SloDetail
+
+	err := dbChain.
+		Scan(&sds).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QuerySloDetails [%v], error: %+v.", alertId, err)
+		return nil
+	}
+
+	return sds
+}
@@ -9,20 +9,30 @@ import (
 	"sync"
 	"time"
 
-	"kubesphere.io/alert/pkg/client/adapter"
 	nf "kubesphere.io/alert/pkg/client/notification"
+	"kubesphere.io/alert/pkg/config"
 	"kubesphere.io/alert/pkg/logger"
 	"kubesphere.io/alert/pkg/metric"
 	"kubesphere.io/alert/pkg/models"
 	"kubesphere.io/alert/pkg/notification"
 	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+	"kubesphere.io/alert/pkg/util/exprutil"
+	"kubesphere.io/alert/pkg/util/scriptutil"
 )
 
 type AlertRunner struct {
 	AlertConfig ConfigAlert
 	AlertStatus StatusAlert
+	RuleResults map[string]map[string]RuleResult
 	SignalCh    chan string
 	UpdateCh    chan string
+	Source      MetricSource
+	History     HistoryWriter
+	Notifier    Notifier
+	Inhibitor   *Inhibitor
+	SloBudgets  SloBudgetCache
+	//Timeout of each metric request, 0 for no timeout
+	MetricTimeout time.Duration
 }
 
 type ConfigAlert struct {
@@ -38,7 +48,7 @@ type ConfigAlert struct {
 	AvailableEndTime   string
 	Language           string
 	Rules              map[string]RuleInfo
-	Requests           MonitoringRequest
+	Scheduler          EvaluationScheduler
 	NfAddressListId    string
 }
 
@@ -46,27 +56,56 @@ type ConfigPolicy struct {
 	RepeatType              string `json:"repeat_type"`
 	RepeatIntervalInitvalue uint32 `json:"repeat_interval_initvalue"`
 	MaxSendCount            uint32 `json:"max_send_count"`
+	FlapWindowMinutes       uint32 `json:"flap_window_minutes"`
+	FlapTransitionLimit     uint32 `json:"flap_transition_limit"`
 }
 
 type RuleInfo struct {
-	RuleName         string
-	Disabled         bool
-	MonitorPeriods   uint32
-	Severity         string
-	MetricsType      string
-	ConditionType    string
-	Thresholds       float64
-	Scale            float64
-	Unit             string
-	ConsecutiveCount uint32
-	Inhibit          bool
-	MetricName       string
+	RuleName                 string
+	Disabled                 bool
+	Invalid                  bool
+	MonitorPeriods           uint32
+	EvaluationInterval       uint32
+	Severity                 string
+	MetricsType              string
+	ConditionType            string
+	Change                   string
+	Baseline                 string
+	Thresholds               float64
+	Expression               *exprutil.Expr
+	HasRecovery              bool
+	RecoveryThresholds       float64
+	RecoveryExpression       *exprutil.Expr
+	Levels                   []LevelInfo
+	Overrides                map[string]OverrideInfo
+	Schedule                 *ScheduleInfo
+	ForecastHorizon          uint32
+	OffsetWindow             uint32
+	Scale                    float64
+	Unit                     string
+	ConsecutiveCount         uint32
+	ConsecutiveRecoveryCount uint32
+	Inhibit                  bool
+	Aggregation              metric.Aggregation
+	NoDataBehavior           string
+	Members                  []string
+	Group                    *models.GroupCondition
+	MetricName               string
+	MetricExpression         *exprutil.Expr
+	Script                   *scriptutil.Script
+	ScriptThresholds         string
+	Slo                      *SloInfo
 }
 
 type StatusAlert struct {
 	sync.RWMutex
 	ResourceStatus map[string]StatusResource `json:resource_status`
+	Baselines      map[string]*Baseline      `json:"baselines"`
 	UpdateTime     time.Time
+	//Metrics of the alert could not be requested since the failed time
+	EvaluationFailed     bool      `json:"evaluation_failed"`
+	EvaluationError      string    `json:"evaluation_error"`
+	EvaluationFailedTime time.Time `json:"evaluation_failed_time"`
 }
 
 type StatusResource struct {
@@ -76,6 +115,12 @@ type StatusResource struct {
 	NextResendInterval uint32          `json:next_resend_interval`
 	NextSendableTime   time.Time       `json:next_sendable_time`
 	AggregatedAlerts   AggregatedAlert `json:aggregated_alerts`
+	NoData             bool            `json:"no_data"`
+	NegativeCount      uint32          `json:"negative_count"`
+	Transitions        []time.Time     `json:"transitions"`
+	Flapping           bool            `json:"flapping"`
+	Inhibited          bool            `json:"inhibited"`
+	LastSeenTime       time.Time       `json:"last_seen_time"`
 }
 
 type AggregatedAlert struct {
@@ -85,22 +130,20 @@ type AggregatedAlert struct {
 	LastAlertValues []RecordedMetric `json:"last_alert_values"`
 }
 
-type MonitoringRequest struct {
-	RulesSamePeriod map[uint32][]string
-	TickCount       map[uint32]uint32
-	TickUpperBound  map[uint32]uint32
-}
-
 type RecordedMetric struct {
 	RuleName     string
 	ResourceName string
+	Value        float64
+	Unit         string
+	Level        string
+	NoData       bool
+	Members      []RecordedMetric
+	ForecastTime int64
+	Schedule     string
+	Message      string
 	tvs          []metric.TV
 }
 
-const (
-	TickPeriodSecond = 10
-)
-
 func NewAlertRunner(alertId string, updateCh chan string) *AlertRunner {
 	runner := &AlertRunner{}
 
@@ -108,6 +151,11 @@ func NewAlertRunner(alertId string, updateCh chan string) *AlertRunner {
 	runner.AlertStatus.UpdateTime = time.Now()
 	runner.SignalCh = make(chan string, 10)
 	runner.UpdateCh = updateCh
+	runner.Source = GetMetricSource()
+	runner.History = &DBHistoryWriter{}
+	runner.Notifier = &AdapterNotifier{}
+	runner.Inhibitor = GetInhibitor()
+	runner.MetricTimeout = time.Duration(config.GetInstance().App.MetricTimeoutSeconds) * time.Second
 
 	return runner
 }
@@ -122,9 +170,9 @@ func (ar *AlertRunner) parsePolicyConfig(alertDetail rs.AlertDetail) {
 		//Fill a default config for policy
 		ar.AlertConfig.PolicyConfig = make(map[string]ConfigPolicy)
 
-		ar.AlertConfig.PolicyConfig["minor"] = ConfigPolicy{"not-repeat", 3, 3}
-		ar.AlertConfig.PolicyConfig["major"] = ConfigPolicy{"exp-minutes", 2, 5}
-		ar.AlertConfig.PolicyConfig["critical"] = ConfigPolicy{"fixed-minutes", 1, 8}
+		ar.AlertConfig.PolicyConfig["minor"] = ConfigPolicy{"not-repeat", 3, 3, 0, 0}
+		ar.AlertConfig.PolicyConfig["major"] = ConfigPolicy{"exp-minutes", 2, 5, 0, 0}
+		ar.AlertConfig.PolicyConfig["critical"] = ConfigPolicy{"fixed-minutes", 1, 8, 0, 0}
 	}
 
 	ar.AlertConfig.AvailableStartTime = alertDetail.AvailableStartTime
@@ -139,42 +187,56 @@ func (ar *AlertRunner) parseRules() {
 	mapRules := make(map[string]RuleInfo)
 
 	for _, ruleDetail := range ruleDetails {
-		threshold, _ := strconv.ParseFloat(ruleDetail.Thresholds, 64)
 		scale, _ := strconv.ParseFloat(ruleDetail.MetricParam, 64)
 		ruleInfo := RuleInfo{
-			RuleName:         ruleDetail.RuleName,
-			Disabled:         ruleDetail.Disabled,
-			MonitorPeriods:   ruleDetail.MonitorPeriods,
-			Severity:         ruleDetail.Severity,
-			MetricsType:      ruleDetail.MetricsType,
-			ConditionType:    ruleDetail.ConditionType,
-			Thresholds:       threshold,
-			Scale:            scale,
-			Unit:             ruleDetail.Unit,
-			ConsecutiveCount: ruleDetail.ConsecutiveCount,
-			Inhibit:          ruleDetail.Inhibit,
+			RuleName:                 ruleDetail.RuleName,
+			Disabled:                 ruleDetail.Disabled,
+			MonitorPeriods:           ruleDetail.MonitorPeriods,
+			EvaluationInterval:       ruleDetail.EvaluationInterval,
+			Severity:                 ruleDetail.Severity,
+			MetricsType:              ruleDetail.MetricsType,
+			ConditionType:            ruleDetail.ConditionType,
+			Scale:                    scale,
+			Unit:                     ruleDetail.Unit,
+			ConsecutiveCount:         ruleDetail.ConsecutiveCount,
+			ConsecutiveRecoveryCount: ruleDetail.ConsecutiveRecoveryCount,
+			Inhibit:                  ruleDetail.Inhibit,
+			NoDataBehavior:           ruleDetail.NoDataBehavior,
+			ForecastHorizon:          ruleDetail.ForecastHorizon,
+			OffsetWindow:             ruleDetail.OffsetWindow,
 		}
 
 		ruleInfo.MetricName = ruleDetail.MetricName
+		ar.parseRuleCondition(ruleDetail.RuleId, &ruleInfo, ruleDetail.Thresholds)
+		ar.parseRuleScript(ruleDetail.RuleId, &ruleInfo, ruleDetail.Script)
+		ar.parseRuleRecovery(ruleDetail.RuleId, &ruleInfo, ruleDetail.RecoveryThresholds)
+		ar.parseRuleLevels(ruleDetail.RuleId, &ruleInfo, ruleDetail.Levels)
+		ar.parseRuleThresholdSchedule(ruleDetail.RuleId, &ruleInfo, ruleDetail.ThresholdSchedule)
+		ar.parseRuleAggregation(ruleDetail.RuleId, &ruleInfo, ruleDetail.Aggregation)
+		ar.parseRuleGroup(ruleDetail.RuleId, &ruleInfo, ruleDetail.GroupCondition)
+		ar.parseRuleMetricExpression(ruleDetail.RuleId, &ruleInfo, ruleDetail.MetricExpression)
 		mapRules[ruleDetail.RuleId] = ruleInfo
 	}
 	ar.AlertConfig.Rules = mapRules
+	ar.parseRuleOverrides(rs.QueryRuleOverrides(ar.AlertConfig.AlertId))
+	ar.parseSlos()
+	usedByComposite := ar.parseCompositeRules()
+	ar.RuleResults = make(map[string]map[string]RuleResult)
+	ar.SloBudgets.reset()
 
-	//Put rules with same period into same MonitoringRequest group
-	rulesSamePeriod := make(map[uint32][]string)
-	tickCount := make(map[uint32]uint32)
-	tickUpperBound := make(map[uint32]uint32)
+	//Put rules with same evaluation interval into same scheduler group
+	scheduler := NewEvaluationScheduler()
+	now := time.Now()
 
 	for ruleId, ruleInfo := range ar.AlertConfig.Rules {
-		if ruleInfo.Disabled {
+		//Composite rules have no metric, disabled rules are still requested for enabled composite rules
+		if ruleInfo.isComposite() || (ruleInfo.Disabled && !usedByComposite[ruleId]) {
 			continue
 		}
-		rulesSamePeriod[ruleInfo.MonitorPeriods] = append(rulesSamePeriod[ruleInfo.MonitorPeriods], ruleId)
-		tickUpperBound[ruleInfo.MonitorPeriods] = ruleInfo.MonitorPeriods * 60 / TickPeriodSecond
-		tickCount[ruleInfo.MonitorPeriods] = tickUpperBound[ruleInfo.MonitorPeriods] - 1
+		scheduler.addRule(ruleId, ruleInfo.getEvaluationInterval(), now)
 	}
 
-	ar.AlertConfig.Requests = MonitoringRequest{rulesSamePeriod, tickCount, tickUpperBound}
+	ar.AlertConfig.Scheduler = scheduler
 }
 
 func (ar *AlertRunner) getResetResourceStatus(ruleId string) StatusResource {
@@ -193,6 +255,10 @@ func (ar *AlertRunner) getResetResourceStatus(ruleId string) StatusResource {
 
 func (ar *AlertRunner) resetAlertStatus() {
 	ar.AlertStatus.ResourceStatus = make(map[string]StatusResource)
+	ar.AlertStatus.Baselines = make(map[string]*Baseline)
+	ar.AlertStatus.EvaluationFailed = false
+	ar.AlertStatus.EvaluationError = ""
+	ar.AlertStatus.EvaluationFailedTime = time.Time{}
 }
 
 func (ar *AlertRunner) parseAlertConfigStatus(alertDetail rs.AlertDetail) {
@@ -204,6 +270,9 @@ func (ar *AlertRunner) parseAlertConfigStatus(alertDetail rs.AlertDetail) {
 		logger.Debug(nil, "Parse Alert Status error: %v", err)
 		ar.resetAlertStatus()
 	}
+	if ar.AlertStatus.Baselines == nil {
+		ar.AlertStatus.Baselines = make(map[string]*Baseline)
+	}
 	ar.AlertStatus.Unlock()
 }
 
@@ -239,16 +308,16 @@ func (ar *AlertRunner) loadAlertInfo() {
 	logger.Debug(nil, "loadAlertInfo alert: %v", ar)
 }
 
-func (ar *AlertRunner) getOneMetric(period uint32, ch chan metric.ResourceMetrics) {
-	extraQueryParams := ""
-
+func (ar *AlertRunner) requestMetrics(ctx context.Context, ruleIds []string, extraQueryParams string) ([]metric.ResourceMetrics, error) {
 	metrics := []string{}
 	metricToRule := make(map[string][]string)
 
-	for _, ruleId := range ar.AlertConfig.Requests.RulesSamePeriod[period] {
-		metricName := ar.AlertConfig.Rules[ruleId].MetricName
-		metrics = append(metrics, metricName)
-		metricToRule[metricName] = append(metricToRule[metricName], ruleId)
+	for _, ruleId := range ruleIds {
+		rule := ar.AlertConfig.Rules[ruleId]
+		for _, metricName := range rule.getMetricNames() {
+			metrics = append(metrics, metricName)
+			metricToRule[metricName] = append(metricToRule[metricName], ruleId)
+		}
 	}
 
 	metricParam := metric.MetricParam{
@@ -261,102 +330,203 @@ func (ar *AlertRunner) getOneMetric(period uint32, ch chan metric.ResourceMetric
 		MetricToRule:     metricToRule,
 	}
 
-	metricParamBytes, err := json.Marshal(metricParam)
+	//Requests of a tick run one after another, so each of them has its own timeout
+	if ar.MetricTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ar.MetricTimeout)
+		defer cancel()
+	}
+
+	//Results of the other metrics are returned along with the error of the object state metrics
+	resourceMetrics, err := ar.Source.GetMetrics(ctx, metricParam)
 	if err != nil {
-		logger.Error(nil, "Marshal Metric Param error: %v", err)
-		return
+		logger.Debug(nil, "Get Metric Result error: %v", err)
+		if _, ok := err.(*StateMetricsError); !ok {
+			return nil, err
+		}
 	}
 
-	resourceMetricsStr := adapter.SendMetricRequest(string(metricParamBytes))
+	return resourceMetrics, err
+}
 
-	resourceMetrics := []metric.ResourceMetrics{}
+func (ar *AlertRunner) getOneMetric(ctx context.Context, interval uint32, ch chan metric.ResourceMetrics) error {
+	ruleIds := ar.AlertConfig.Scheduler.RulesSameInterval[interval]
 
-	err = json.Unmarshal([]byte(resourceMetricsStr), &resourceMetrics)
+	//Slo rules only ask for the metrics of their burn windows
+	metricRuleIds := []string{}
+	for _, ruleId := range ruleIds {
+		if ar.AlertConfig.Rules[ruleId].Slo == nil {
+			metricRuleIds = append(metricRuleIds, ruleId)
+		}
+	}
+	if len(metricRuleIds) == 0 {
+		return ar.requestSloMetrics(ctx, ruleIds, ch)
+	}
 
-	if err != nil {
-		logger.Debug(nil, "Unmarshal Metric Result error: %v", err)
-		return
+	//Rules asking for failed object state metrics are not checked in this tick like the rules whose offset window failed
+	failedRules := make(map[string]bool)
+	resourceMetrics, requestErr := ar.requestMetrics(ctx, metricRuleIds, "")
+	if stateErr, ok := requestErr.(*StateMetricsError); ok {
+		for _, ruleId := range metricRuleIds {
+			rule := ar.AlertConfig.Rules[ruleId]
+			if rule.asksForMetrics(stateErr.Metrics) {
+				failedRules[ruleId] = true
+			}
+		}
+	} else if requestErr != nil {
+		return requestErr
+	}
+
+	//Rules comparing with an offset window also ask for the metrics of the offset window,
+	//rules whose offset window failed are not checked in this tick and the error fails the evaluation
+	offsetRules := make(map[uint32][]string)
+	for _, ruleId := range ruleIds {
+		offsetWindow := ar.AlertConfig.Rules[ruleId].OffsetWindow
+		if offsetWindow != 0 {
+			offsetRules[offsetWindow] = append(offsetRules[offsetWindow], ruleId)
+		}
+	}
+
+	offsetMetrics := []metric.ResourceMetrics{}
+	for offsetWindow, offsetRuleIds := range offsetRules {
+		windowMetrics, err := ar.requestMetrics(ctx, offsetRuleIds, metric.OffsetQueryParams(offsetWindow))
+		if err != nil {
+			logger.Debug(nil, "getOneMetric offset window %d of rules %v failed: %v", offsetWindow, offsetRuleIds, err)
+			requestErr = err
+			for _, ruleId := range offsetRuleIds {
+				failedRules[ruleId] = true
+			}
+			continue
+		}
+
+		for _, rm := range windowMetrics {
+			rm.OffsetSeconds = offsetWindow
+			offsetMetrics = append(offsetMetrics, rm)
+		}
 	}
 
 	for _, rm := range resourceMetrics {
+		if failedRules[rm.RuleId] {
+			continue
+		}
 		logger.Debug(nil, "getOneMetric %v", rm)
 		ch <- rm
 	}
+	for _, rm := range offsetMetrics {
+		if failedRules[rm.RuleId] {
+			continue
+		}
+		logger.Debug(nil, "getOneMetric offset %v", rm)
+		ch <- rm
+	}
+
+	ar.requestSloMetrics(ctx, ruleIds, ch)
+
+	return requestErr
 }
 
-func (ar *AlertRunner) getResourceMetrics(ch chan metric.ResourceMetrics) {
+//Return the rules whose metrics are requested successfully in this tick and the error of the groups failed
+func (ar *AlertRunner) getResourceMetrics(ch chan metric.ResourceMetrics) ([]string, error) {
 	wg := sync.WaitGroup{}
-	ctx := context.Background()
-	ctx, _ = context.WithTimeout(ctx, time.Second*3)
-
-	for period, _ := range ar.AlertConfig.Requests.RulesSamePeriod {
-		ar.AlertConfig.Requests.TickCount[period] = (ar.AlertConfig.Requests.TickCount[period] + 1) % ar.AlertConfig.Requests.TickUpperBound[period]
-
-		if ar.AlertConfig.Requests.TickCount[period] == 0 {
-			wg.Add(1)
-			go func(period uint32) {
-				for {
-					select {
-					case <-ctx.Done():
-						logger.Debug(nil, "getResourceMetrics canceled")
-						wg.Done()
-						return
-					default:
-						ar.getOneMetric(period, ch)
-						wg.Done()
-						return
-					}
-				}
-			}(period)
-		}
+	mutex := sync.Mutex{}
+	requestedRules := []string{}
+	var requestErr error
+
+	for _, interval := range ar.AlertConfig.Scheduler.dueIntervals(time.Now()) {
+		wg.Add(1)
+		go func(interval uint32) {
+			defer wg.Done()
+			err := ar.getOneMetric(context.Background(), interval, ch)
+			mutex.Lock()
+			if err == nil {
+				requestedRules = append(requestedRules, ar.AlertConfig.Scheduler.RulesSameInterval[interval]...)
+			} else {
+				requestErr = err
+			}
+			mutex.Unlock()
+		}(interval)
 	}
 
 	wg.Wait()
+
+	return requestedRules, requestErr
 }
 
-func (ar *AlertRunner) readRuleResourceMetric(resourceMetrics metric.ResourceMetrics, triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric) string {
-	rule := ar.AlertConfig.Rules[resourceMetrics.RuleId]
-	condition := rule.ConditionType
-	threshold := rule.Thresholds
+func (ar *AlertRunner) readRuleResourceMetric(resourceMetrics metric.ResourceMetrics, triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric, noDataMetrics *[]RecordedMetric) string {
+	//Thresholds of the schedule window active now apply, overrides of resources still take precedence
+	rule, schedule := ar.AlertConfig.Rules[resourceMetrics.RuleId].atTime(time.Now())
 	scale := rule.Scale
 
 	for resourceName, timeValue := range resourceMetrics.ResourceMetric {
 		logger.Debug(nil, "ResourceMetric %v, %v", resourceName, timeValue)
-		if len(timeValue) < int(1) {
+		rule := rule.forResource(resourceName)
+		//Aggregate the time values of the monitor period
+		v, err := rule.getValue(timeValue, resourceMetrics.OffsetMetric[resourceName], scale)
+		if err != nil {
+			logger.Debug(nil, "readRuleResourceMetric Rule[%s] Resource[%s] has no data: %v", resourceMetrics.RuleId, resourceName, err)
+			rule.addNoDataMetric(RecordedMetric{rule.RuleName, resourceName, 0, rule.Unit, rule.Severity, true, nil, 0, schedule, "", timeValue}, triggeredMetrics, resumedMetrics, noDataMetrics)
 			continue
 		}
-		//Fetch last time value
-		v, err := strconv.ParseFloat(timeValue[(len(timeValue)-int(1))].V, 64)
+		//Baseline rules compare the deviation of the value instead of the value
+		cv := v
+		if rule.Baseline != "" {
+			deviation, ready := ar.checkBaseline(resourceMetrics.RuleId, resourceName, v, timeValue, scale)
+			if !ready {
+				*resumedMetrics = append(*resumedMetrics, RecordedMetric{rule.RuleName, resourceName, v, rule.Unit, "", false, nil, 0, schedule, "", timeValue})
+				continue
+			}
+			cv = deviation
+		}
+		//Script rules decide by their script with the message of the script
+		resourceSet, message := false, ""
+		if rule.Script != nil {
+			resourceSet, message, err = ar.checkScript(resourceMetrics.RuleId, resourceName, rule, timeValue, scale)
+		} else {
+			resourceSet, err = rule.checkCondition(cv, ar.isResourceAlerting(resourceMetrics.RuleId, resourceName))
+		}
 		if err != nil {
-			logger.Error(nil, "readRuleResourceMetric error %v, value will be ignored!", err)
+			logger.Error(nil, "readRuleResourceMetric check condition error %v, value will be ignored!", err)
 			continue
 		}
-		resourceSet := false
-		switch condition {
-		case ">=":
-			resourceSet = (v*scale >= threshold)
-		case ">":
-			resourceSet = (v*scale > threshold)
-		case "<=":
-			resourceSet = (v*scale <= threshold)
-		case "<":
-			resourceSet = (v*scale < threshold)
-		}
 
 		if resourceSet {
-			*triggeredMetrics = append(*triggeredMetrics, RecordedMetric{rule.RuleName, resourceName, timeValue})
+			level := rule.getLevel(cv)
+			forecastTime := rule.getForecastTime(timeValue, scale, level)
+			*triggeredMetrics = append(*triggeredMetrics, RecordedMetric{rule.RuleName, resourceName, v, rule.Unit, level, false, nil, forecastTime, schedule, message, timeValue})
 		} else {
-			*resumedMetrics = append(*resumedMetrics, RecordedMetric{rule.RuleName, resourceName, timeValue})
+			*resumedMetrics = append(*resumedMetrics, RecordedMetric{rule.RuleName, resourceName, v, rule.Unit, "", false, nil, 0, schedule, message, timeValue})
 		}
 	}
 
+	//Resources known before but absent from the result have no data either
+	for _, resourceName := range ar.getAbsentResources(resourceMetrics) {
+		logger.Debug(nil, "readRuleResourceMetric Rule[%s] Resource[%s] is absent", resourceMetrics.RuleId, resourceName)
+		rule.addNoDataMetric(RecordedMetric{rule.RuleName, resourceName, 0, rule.Unit, rule.Severity, true, nil, 0, schedule, "", nil}, triggeredMetrics, resumedMetrics, noDataMetrics)
+	}
+
 	return resourceMetrics.RuleId
 }
 
+func (ar *AlertRunner) isResourceAlerting(ruleId string, resourceName string) bool {
+	ar.AlertStatus.RLock()
+	defer ar.AlertStatus.RUnlock()
+
+	status, ok := ar.AlertStatus.ResourceStatus[getRuleResourceKey(ruleId, resourceName)]
+	return ok && status.CurrentLevel != "cleared"
+}
+
 func getRuleResourceKey(ruleId string, resourceName string) string {
 	return ruleId + " " + resourceName
 }
 
+func splitRuleResourceKey(ruleResourceKey string) (string, string) {
+	parts := strings.SplitN(ruleResourceKey, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
 func (ar *AlertRunner) signalUpdate() {
 	ar.UpdateCh <- "update"
 }
@@ -364,9 +534,27 @@ func (ar *AlertRunner) signalUpdate() {
 func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bool {
 	triggeredMetrics := []RecordedMetric{}
 	resumedMetrics := []RecordedMetric{}
+	noDataMetrics := []RecordedMetric{}
 
-	ruleId := ar.readRuleResourceMetric(resourceMetrics, &triggeredMetrics, &resumedMetrics)
+	ruleId := ar.readRuleResourceMetric(resourceMetrics, &triggeredMetrics, &resumedMetrics, &noDataMetrics)
+	ar.recordRuleResults(ruleId, triggeredMetrics, resumedMetrics, noDataMetrics)
 
+	//Disabled rules are only evaluated for composite rules
+	rule := ar.AlertConfig.Rules[ruleId]
+	if rule.Disabled {
+		return false
+	}
+
+	//Group rules fire once for the whole group
+	if rule.isGroup() {
+		rule.groupMetrics(&triggeredMetrics, &resumedMetrics, &noDataMetrics)
+	}
+
+	return ar.checkRuleResources(ruleId, triggeredMetrics, resumedMetrics, noDataMetrics)
+}
+
+//Update status of resources of the rule with the triggered, resumed and no data metrics
+func (ar *AlertRunner) checkRuleResources(ruleId string, triggeredMetrics []RecordedMetric, resumedMetrics []RecordedMetric, noDataMetrics []RecordedMetric) bool {
 	oldResourceStatus := ar.AlertStatus.ResourceStatus
 	newResourceStatus := make(map[string]StatusResource)
 
@@ -383,21 +571,37 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			newStatus = ar.getResetResourceStatus(ruleId)
 		}
 
+		if ar.checkNoData(newStatus, ruleId, triggeredMetric) {
+			needUpdate = true
+		}
+		newStatus.NoData = triggeredMetric.NoData
+		newStatus.NegativeCount = 0
+		touchLastSeen(&newStatus, triggeredMetric)
+
 		operation := ""
 		resourceIsAlert := false
 		newStatus.PositiveCount = newStatus.PositiveCount + 1
 		if newStatus.PositiveCount >= ar.AlertConfig.Rules[ruleId].ConsecutiveCount {
 			resourceIsAlert = true
 			if newStatus.CurrentLevel == "cleared" {
-				newStatus.CurrentLevel = ar.AlertConfig.Rules[ruleId].Severity
+				newStatus.CurrentLevel = triggeredMetric.Level
+				newStatus.NextResendInterval = ar.getPolicyConfig(ruleId, &newStatus).RepeatIntervalInitvalue
 				newStatus.NextSendableTime = time.Now()
 				operation = "trigger"
+			} else if newStatus.CurrentLevel != triggeredMetric.Level {
+				ar.changeLevel(&newStatus, ruleId, resourceName, triggeredMetric)
+				needUpdate = true
 			}
 		}
 
 		if operation == "trigger" {
 			logger.Debug(nil, "Rule[%v] Resource[%v] %v triggered, write to message", ruleId, resourceName, triggeredMetric)
 			ar.writeHistory("", "triggered", fmt.Sprintf("%v", triggeredMetric), "", ruleId, resourceName)
+			ar.recordTransition(&newStatus, ruleId)
+			needUpdate = true
+		}
+
+		if ar.checkFlapping(&newStatus, ruleId, resourceName) {
 			needUpdate = true
 		}
 
@@ -419,11 +623,28 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			newStatus = ar.getResetResourceStatus(ruleId)
 		}
 
+		if ar.checkNoData(newStatus, ruleId, resumedMetric) {
+			needUpdate = true
+		}
+
 		operation := ""
-		newStatus.PositiveCount = 0
 		if newStatus.CurrentLevel != "cleared" {
-			newStatus = ar.getResetResourceStatus(ruleId)
-			operation = "resume"
+			//Resume only after sustained recovery
+			newStatus.NegativeCount = newStatus.NegativeCount + 1
+			if newStatus.NegativeCount >= ar.AlertConfig.Rules[ruleId].ConsecutiveRecoveryCount {
+				oldStatus := newStatus
+				newStatus = ar.getResetResourceStatus(ruleId)
+				keepFlapState(&newStatus, oldStatus)
+				newStatus.LastSeenTime = oldStatus.LastSeenTime
+				ar.recordTransition(&newStatus, ruleId)
+				operation = "resume"
+			}
+		} else {
+			newStatus.PositiveCount = 0
+		}
+
+		if ar.checkFlapping(&newStatus, ruleId, resourceName) {
+			needUpdate = true
 		}
 
 		if operation == "resume" {
@@ -436,13 +657,44 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			} else {
 				resumeStatus = ar.getResetResourceStatus(ruleId)
 			}
-			ar.sendResumeNotification(&resumeStatus, ruleId, resourceName, resumedMetric, resumedMetrics)
+			if newStatus.Flapping {
+				logger.Debug(nil, "Rule[%v] Resource[%v] is flapping, resume notification held back", ruleId, resourceName)
+			} else if resumeStatus.Inhibited {
+				logger.Debug(nil, "Rule[%v] Resource[%v] is inhibited, resume notification held back", ruleId, resourceName)
+			} else {
+				ar.sendResumeNotification(&resumeStatus, ruleId, resourceName, resumedMetric, resumedMetrics)
+			}
 			needUpdate = true
 		}
 
+		newStatus.NoData = resumedMetric.NoData
+		touchLastSeen(&newStatus, resumedMetric)
 		newResourceStatus[ruleResourceKey] = newStatus
 	}
 
+	//Resources without data keep their last state
+	for _, noDataMetric := range noDataMetrics {
+		resourceName := noDataMetric.ResourceName
+		ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+		newStatus := StatusResource{}
+		if _, ok := oldResourceStatus[ruleResourceKey]; ok {
+			newStatus = oldResourceStatus[ruleResourceKey]
+		} else {
+			newStatus = ar.getResetResourceStatus(ruleId)
+		}
+
+		if ar.checkNoData(newStatus, ruleId, noDataMetric) {
+			needUpdate = true
+		}
+		newStatus.NoData = true
+		touchLastSeen(&newStatus, noDataMetric)
+		newResourceStatus[ruleResourceKey] = newStatus
+	}
+
+	if ar.collectGoneResources(ruleId, newResourceStatus) {
+		needUpdate = true
+	}
+
 	ar.AlertStatus.Lock()
 	for k, v := range oldResourceStatus {
 		oldRuleId := strings.Split(k, " ")[0]
@@ -456,17 +708,58 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 	return needUpdate
 }
 
-func (ar *AlertRunner) checkMetrics(ch chan metric.ResourceMetrics) {
+func (ar *AlertRunner) checkMetrics(resourceMetricsList []metric.ResourceMetrics, requestedRules []string) {
 	needUpdate := false
+	checkedRules := make(map[string]bool)
+	pendingMetrics := make(map[string][]metric.ResourceMetrics)
 
-	for resourceMetrics := range ch {
+	//Composite rules only combine member results of this tick
+	ar.RuleResults = make(map[string]map[string]RuleResult)
+
+	for _, resourceMetrics := range resourceMetricsList {
 		logger.Debug(nil, "checkMetrics %v", resourceMetrics)
 
+		//Rules with metric expression, offset window or slo are checked after all of their metrics arrive
+		rule := ar.AlertConfig.Rules[resourceMetrics.RuleId]
+		if rule.MetricExpression != nil || rule.OffsetWindow != 0 || rule.Slo != nil {
+			pendingMetrics[resourceMetrics.RuleId] = append(pendingMetrics[resourceMetrics.RuleId], resourceMetrics)
+			continue
+		}
+
 		checkResult := ar.checkOneMetric(resourceMetrics)
+		checkedRules[resourceMetrics.RuleId] = true
+
+		needUpdate = needUpdate || checkResult
+	}
+
+	for ruleId, resourceMetricsList := range pendingMetrics {
+		var checkResult bool
+		if ar.AlertConfig.Rules[ruleId].Slo != nil {
+			checkResult = ar.checkSloMetrics(ruleId, resourceMetricsList)
+		} else {
+			checkResult = ar.checkOneMetric(ar.mergeResourceMetrics(ruleId, resourceMetricsList))
+		}
+		checkedRules[ruleId] = true
 
 		needUpdate = needUpdate || checkResult
 	}
 
+	//Rules requested but not returned at all have no data for every known resource
+	for _, ruleId := range requestedRules {
+		if checkedRules[ruleId] {
+			continue
+		}
+		checkedRules[ruleId] = true
+
+		checkResult := ar.checkOneMetric(metric.ResourceMetrics{RuleId: ruleId})
+
+		needUpdate = needUpdate || checkResult
+	}
+
+	//Composite rules are evaluated after all metrics of this tick arrive
+	checkResult := ar.checkCompositeRules(checkedRules)
+	needUpdate = needUpdate || checkResult
+
 	if needUpdate {
 		ar.signalUpdate()
 	}
@@ -483,7 +776,7 @@ func (ar *AlertRunner) writeHistory(historyName string, status string, content s
 		resourceName,
 	)
 
-	err := rs.CreateHistory(nil, history)
+	err := ar.History.CreateHistory(history)
 	if err != nil {
 		logger.Error(nil, "writeHistory Alert[%s] %s in DB error, [%+v].", ar.AlertConfig.AlertId, status, err)
 		return
@@ -502,7 +795,10 @@ func (ar *AlertRunner) pushAggregatedAlerts(newStatus *StatusResource, ruleId st
 	aggregatedAlerts.CumulatedCount = aggregatedAlerts.CumulatedCount + 1
 
 	triggeredMetric := triggeredRuleMetrics[len(triggeredRuleMetrics)-1]
-	alertTime := time.Unix(triggeredMetric.tvs[len(triggeredMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
+	alertTime := time.Now().Format("2006-01-02 15:04:05.99999")
+	if len(triggeredMetric.tvs) > 0 {
+		alertTime = time.Unix(triggeredMetric.tvs[len(triggeredMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
+	}
 	if aggregatedAlerts.FirstAlertTime == "" {
 		aggregatedAlerts.FirstAlertTime = alertTime
 	}
@@ -513,7 +809,7 @@ func (ar *AlertRunner) pushAggregatedAlerts(newStatus *StatusResource, ruleId st
 }
 
 func (ar *AlertRunner) checkSendable(newStatus *StatusResource, ruleId string, resourceName string) bool {
-	policyConfig := ar.AlertConfig.PolicyConfig[ar.AlertConfig.Rules[ruleId].Severity]
+	policyConfig := ar.getPolicyConfig(ruleId, newStatus)
 	switch policyConfig.RepeatType {
 	case "normal":
 		return true
@@ -527,7 +823,7 @@ func (ar *AlertRunner) checkSendable(newStatus *StatusResource, ruleId string, r
 		if newStatus.CumulatedSendCount >= policyConfig.MaxSendCount {
 			return false
 		}
-		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(TickPeriodSecond/3) * time.Second)) {
+		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(MinEvaluationIntervalSecond/3) * time.Second)) {
 			return true
 		} else {
 			return false
@@ -536,7 +832,7 @@ func (ar *AlertRunner) checkSendable(newStatus *StatusResource, ruleId string, r
 		if newStatus.CumulatedSendCount >= policyConfig.MaxSendCount {
 			return false
 		}
-		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(TickPeriodSecond/3) * time.Second)) {
+		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(MinEvaluationIntervalSecond/3) * time.Second)) {
 			return true
 		} else {
 			return false
@@ -553,7 +849,7 @@ func (ar *AlertRunner) processRepeat(newStatus *StatusResource, ruleId string, r
 	newStatus.CumulatedSendCount = newStatus.CumulatedSendCount + 1
 
 	//Update Next Sendable Time
-	switch ar.AlertConfig.PolicyConfig[ar.AlertConfig.Rules[ruleId].Severity].RepeatType {
+	switch ar.getPolicyConfig(ruleId, newStatus).RepeatType {
 	case "fixed-minutes":
 		newStatus.NextSendableTime = newStatus.NextSendableTime.Add(time.Duration(newStatus.NextResendInterval) * time.Minute)
 	case "exp-minutes":
@@ -573,91 +869,77 @@ func processResourceName(resourceName string) string {
 func (ar *AlertRunner) formatActiveNotificationEmail(newStatus *StatusResource, ruleId string, resourceName string, language string) *notification.Email {
 	aggregatedAlerts := newStatus.AggregatedAlerts
 	lastValue := ""
+	forecastTime, forecastIn := "", ""
 	for _, recordedRuleMetric := range aggregatedAlerts.LastAlertValues {
-		tv := recordedRuleMetric.tvs[len(recordedRuleMetric.tvs)-1]
 		if resourceName == recordedRuleMetric.ResourceName {
-			v, _ := strconv.ParseFloat(tv.V, 64)
-			lastValue = fmt.Sprintf("%.2f%s", v*ar.AlertConfig.Rules[ruleId].Scale, ar.AlertConfig.Rules[ruleId].Unit)
+			lastValue = formatRecordedValue(recordedRuleMetric)
+			forecastTime, forecastIn = formatForecastTime(recordedRuleMetric)
 			break
 		}
 	}
 
 	notificationParam := notification.NotificationParam{
-		ResourceName:   processResourceName(resourceName),
+		ResourceName:   ar.formatResourceName(resourceName),
 		RuleName:       ar.AlertConfig.Rules[ruleId].RuleName,
 		CumulatedCount: aggregatedAlerts.CumulatedCount,
 		FirstTime:      aggregatedAlerts.FirstAlertTime,
 		LastTime:       aggregatedAlerts.LastAlertTime,
 		LastValue:      lastValue,
+		ForecastTime:   forecastTime,
+		ForecastIn:     forecastIn,
 	}
 
-	notificationParamBytes, err := json.Marshal(notificationParam)
-	if err != nil {
-		logger.Error(nil, "Marshal Notification Param error: %v", err)
-		return nil
-	}
-
-	emailStr := adapter.SendEmailRequest(string(notificationParamBytes), "false", language)
-
-	if emailStr == "" {
-		return nil
-	}
-
-	email := notification.Email{}
-
-	err = json.Unmarshal([]byte(emailStr), &email)
+	email, err := ar.Notifier.FormatEmail(notificationParam, false, language)
 	if err != nil {
-		logger.Error(nil, "Unmarshal Email error: %v", err)
+		logger.Error(nil, "Format Email error: %v", err)
 		return nil
 	}
 
-	return &email
+	return email
 }
 
 func (ar *AlertRunner) formatResumeNotificationEmail(resumeStatus *StatusResource, ruleId string, resourceName string, resumedMetric RecordedMetric, language string) *notification.Email {
 	aggregatedAlerts := resumeStatus.AggregatedAlerts
 	lastValue := ""
-	tv := resumedMetric.tvs[len(resumedMetric.tvs)-1]
 	if resourceName == resumedMetric.ResourceName {
-		v, _ := strconv.ParseFloat(tv.V, 64)
-		lastValue = fmt.Sprintf("%.2f%s", v*ar.AlertConfig.Rules[ruleId].Scale, ar.AlertConfig.Rules[ruleId].Unit)
+		lastValue = formatRecordedValue(resumedMetric)
+	}
+	resumeTime := time.Now().Format("2006-01-02 15:04:05.99999")
+	if len(resumedMetric.tvs) > 0 {
+		resumeTime = time.Unix(resumedMetric.tvs[len(resumedMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
 	}
-	resumeTime := time.Unix(tv.T, 0).Format("2006-01-02 15:04:05.99999")
 
 	notificationParam := notification.NotificationParam{
-		ResourceName: processResourceName(resourceName),
+		ResourceName: ar.formatResourceName(resourceName),
 		RuleName:     ar.AlertConfig.Rules[ruleId].RuleName,
 		FirstTime:    aggregatedAlerts.FirstAlertTime,
 		LastTime:     resumeTime,
 		LastValue:    lastValue,
 	}
 
-	notificationParamBytes, err := json.Marshal(notificationParam)
-	if err != nil {
-		logger.Error(nil, "Marshal Notification Param error: %v", err)
-		return nil
-	}
-
-	emailStr := adapter.SendEmailRequest(string(notificationParamBytes), "true", language)
-
-	if emailStr == "" {
-		return nil
-	}
-
-	email := notification.Email{}
-
-	err = json.Unmarshal([]byte(emailStr), &email)
+	email, err := ar.Notifier.FormatEmail(notificationParam, true, language)
 	if err != nil {
-		logger.Error(nil, "Unmarshal Email error: %v", err)
+		logger.Error(nil, "Format Email error: %v", err)
 		return nil
 	}
 
-	return &email
+	return email
 }
 
 func (ar *AlertRunner) sendActiveNotification(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) {
 	ar.pushAggregatedAlerts(newStatus, ruleId, resourceName, triggeredRuleMetrics)
 
+	//Hold back notifications while inhibited by higher severity rules
+	if ar.checkInhibited(newStatus, ruleId, resourceName) {
+		return
+	}
+
+	//Hold back notifications while flapping, after the inhibited state is updated
+	if newStatus.Flapping {
+		logger.Debug(nil, "sendActiveNotification Rule[%v] Resource[%v] is flapping", ruleId, resourceName)
+		return
+	}
+
 	//Check Notification Sendable
 	if !nf.CheckTimeAvailable(ar.AlertConfig.AvailableStartTime, ar.AlertConfig.AvailableEndTime) {
 		logger.Debug(nil, "sendActiveNotification not in available time")
@@ -669,12 +951,11 @@ func (ar *AlertRunner) sendActiveNotification(newStatus *StatusResource, ruleId
 		return
 	}
 
-	nfAddressListId := fmt.Sprintf(`["%s"]`, ar.AlertConfig.NfAddressListId)
 	email := ar.formatActiveNotificationEmail(newStatus, ruleId, resourceName, ar.AlertConfig.Language)
 	if email == nil {
 		logger.Error(nil, "formatActiveNotificationEmail failed")
 	} else {
-		sentSuccess, notificationId := nf.SendNotification("other", nfAddressListId, email.Title, email.Content)
+		sentSuccess, notificationId := ar.Notifier.SendEmail(ar.AlertConfig.NfAddressListId, email)
 		if sentSuccess {
 			ar.writeHistory("", "sent_success", fmt.Sprintf("%v", triggeredRuleMetrics), notificationId, ruleId, resourceName)
 			//ar.clearAggregatedAlerts(newStatus, ruleId, resourceName)
@@ -694,12 +975,11 @@ func (ar *AlertRunner) sendResumeNotification(resumeStatus *StatusResource, rule
 		return
 	}
 
-	nfAddressListId := fmt.Sprintf(`["%s"]`, ar.AlertConfig.NfAddressListId)
 	email := ar.formatResumeNotificationEmail(resumeStatus, ruleId, resourceName, resumedMetric, ar.AlertConfig.Language)
 	if email == nil {
 		logger.Error(nil, "formatResumeNotificationEmail failed")
 	} else {
-		sentSuccess, notificationId := nf.SendNotification("other", nfAddressListId, email.Title, email.Content)
+		sentSuccess, notificationId := ar.Notifier.SendEmail(ar.AlertConfig.NfAddressListId, email)
 		if sentSuccess {
 			ar.writeHistory("", "sent_success", fmt.Sprintf("%v", resumedMetrics), notificationId, ruleId, resourceName)
 		} else {
@@ -739,20 +1019,33 @@ func (ar *AlertRunner) runAlertRules() {
 		return
 	}
 
+	//Metrics are drained while they are requested, so that requests never block on a full channel
 	ch := make(chan metric.ResourceMetrics, 100)
-	ar.getResourceMetrics(ch)
+	drained := make(chan []metric.ResourceMetrics)
+	go func() {
+		resourceMetricsList := []metric.ResourceMetrics{}
+		for resourceMetrics := range ch {
+			resourceMetricsList = append(resourceMetricsList, resourceMetrics)
+		}
+		drained <- resourceMetricsList
+	}()
+
+	requestedRules, err := ar.getResourceMetrics(ch)
 	close(ch)
 
-	ar.checkMetrics(ch)
+	ar.checkMetrics(<-drained, requestedRules)
+
+	ar.checkEvaluation(err)
 }
 
 func (ar *AlertRunner) Run(initStatus string) {
-	timer := time.NewTicker(time.Second * TickPeriodSecond)
-	defer timer.Stop()
-
 	ar.loadAlertInfo()
 	ar.updateAlertUpdateTime()
 
+	//Wake up when the next group of rules is due
+	timer := time.NewTimer(ar.AlertConfig.Scheduler.nextWait(time.Now()))
+	defer timer.Stop()
+
 	//If get alert from migrating, continue running with current status, only need to reset status when adding and updating
 	if initStatus == "adding" {
 		ar.AlertStatus.Lock()
@@ -766,6 +1059,7 @@ func (ar *AlertRunner) Run(initStatus string) {
 			ar.runAlertRules()
 			logger.Debug(nil, "AlertRunner alert %s run", ar.AlertConfig.AlertId)
 			ar.updateAlertUpdateTime()
+			timer.Reset(ar.AlertConfig.Scheduler.nextWait(time.Now()))
 		case operation := <-ar.SignalCh:
 			switch operation {
 			case "Stop":
@@ -781,6 +1075,7 @@ func (ar *AlertRunner) Run(initStatus string) {
 				ar.resetAlertStatus()
 				ar.AlertStatus.Unlock()
 				ar.updateAlertUpdateTime()
+				resetTimer(timer, ar.AlertConfig.Scheduler.nextWait(time.Now()))
 				logger.Debug(nil, "AlertRunner alert %s update", ar.AlertConfig.AlertId)
 			default:
 				param := strings.Split(operation, " ")
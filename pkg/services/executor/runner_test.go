@@ -0,0 +1,207 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+type fakeHistoryWriter struct {
+	histories []*models.History
+}
+
+func (hw *fakeHistoryWriter) CreateHistory(history *models.History) error {
+	hw.histories = append(hw.histories, history)
+	return nil
+}
+
+func (hw *fakeHistoryWriter) rows() []string {
+	rows := []string{}
+	for _, history := range hw.histories {
+		rows = append(rows, fmt.Sprintf("%s %s %s", history.Event, history.RuleId, history.ResourceName))
+	}
+	return rows
+}
+
+type fakeNotifier struct {
+	emails []*notification.Email
+}
+
+func (fn *fakeNotifier) FormatEmail(notificationParam notification.NotificationParam, resume bool, language string) (*notification.Email, error) {
+	return &notification.Email{
+		Title:   fmt.Sprintf("%s %s resume=%v", notificationParam.RuleName, notificationParam.ResourceName, resume),
+		Content: notificationParam.LastValue,
+	}, nil
+}
+
+func (fn *fakeNotifier) SendEmail(nfAddressListId string, email *notification.Email) (bool, string) {
+	fn.emails = append(fn.emails, email)
+	return true, fmt.Sprintf("nf-%d", len(fn.emails))
+}
+
+type failingSource struct {
+	source MetricSource
+	err    error
+}
+
+func (fs *failingSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	if fs.err != nil {
+		return nil, fs.err
+	}
+	return fs.source.GetMetrics(ctx, metricParam)
+}
+
+func newReplayRunner(t *testing.T, file string) (*AlertRunner, *metric.ReplaySource, *fakeHistoryWriter, *fakeNotifier) {
+	source, err := metric.LoadReplaySource(file, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history := &fakeHistoryWriter{}
+	notifier := &fakeNotifier{}
+
+	runner := &AlertRunner{
+		SignalCh: make(chan string, 10),
+		UpdateCh: make(chan string, 100),
+		Source:   source,
+		History:  history,
+		Notifier: notifier,
+		//No rule inhibits the replayed rules
+		Inhibitor: NewInhibitor(func() ([]rs.InhibitRule, error) { return nil, nil }, time.Hour),
+	}
+	runner.AlertConfig = ConfigAlert{
+		AlertId:     "al-replay",
+		LoadSuccess: true,
+		RsTypeName:  "node",
+		PolicyConfig: map[string]ConfigPolicy{
+			"critical": {"normal", 0, 0, 0, 0},
+		},
+		AvailableStartTime: "00:00:00",
+		AvailableEndTime:   "23:59:59",
+		Language:           "en",
+		Rules: map[string]RuleInfo{
+			"rl-cpu": {
+				RuleName:                 "cpu high",
+				MonitorPeriods:           1,
+				EvaluationInterval:       60,
+				Severity:                 "critical",
+				ConditionType:            models.ConditionTypeGreater,
+				Thresholds:               0.9,
+				Scale:                    1,
+				ConsecutiveCount:         1,
+				ConsecutiveRecoveryCount: 1,
+				Aggregation:              metric.Aggregation{Kind: metric.AggregationLast},
+				MetricName:               "node_cpu_utilisation",
+			},
+		},
+		Scheduler:       NewEvaluationScheduler(),
+		NfAddressListId: "nfal-replay",
+	}
+	runner.AlertConfig.Scheduler.addRule("rl-cpu", 60, time.Now())
+	runner.RuleResults = make(map[string]map[string]RuleResult)
+	runner.resetAlertStatus()
+
+	return runner, source, history, notifier
+}
+
+//Evaluate the rules of the runner on the current tick of the replay and move to the next tick
+func runTick(runner *AlertRunner, source *metric.ReplaySource) {
+	for interval := range runner.AlertConfig.Scheduler.NextEvaluation {
+		runner.AlertConfig.Scheduler.NextEvaluation[interval] = time.Now()
+	}
+	runner.runAlertRules()
+	source.Advance()
+}
+
+func TestAlertRunnerReplay(t *testing.T) {
+	runner, source, history, notifier := newReplayRunner(t, "testdata/replay.json")
+
+	expects := []struct {
+		histories []string
+		level     string
+	}{
+		{[]string{"triggered rl-cpu node1", "sent_success rl-cpu node1"}, "critical"},
+		//Normal repeat sends on every tick while alerting
+		{[]string{"sent_success rl-cpu node1"}, "critical"},
+		{[]string{"resumed rl-cpu node1", "sent_success rl-cpu node1"}, "cleared"},
+		{[]string{}, "cleared"},
+	}
+
+	for i, expect := range expects {
+		written := len(history.histories)
+		runTick(runner, source)
+
+		rows := history.rows()[written:]
+		if !reflect.DeepEqual(rows, expect.histories) {
+			t.Errorf("tick %d histories got %v, expect %v", i, rows, expect.histories)
+		}
+
+		status := runner.AlertStatus.ResourceStatus[getRuleResourceKey("rl-cpu", "node1")]
+		if status.CurrentLevel != expect.level {
+			t.Errorf("tick %d node1 level got %s, expect %s", i, status.CurrentLevel, expect.level)
+		}
+		if level := runner.AlertStatus.ResourceStatus[getRuleResourceKey("rl-cpu", "node2")].CurrentLevel; level != "cleared" {
+			t.Errorf("tick %d node2 level got %s, expect cleared", i, level)
+		}
+	}
+
+	titles := []string{}
+	for _, email := range notifier.emails {
+		titles = append(titles, email.Title)
+	}
+	expectTitles := []string{"cpu high node1 resume=false", "cpu high node1 resume=false", "cpu high node1 resume=true"}
+	if !reflect.DeepEqual(titles, expectTitles) {
+		t.Errorf("notifications got %v, expect %v", titles, expectTitles)
+	}
+
+	for _, h := range history.histories {
+		if h.Event == "sent_success" && h.NotificationId == "" {
+			t.Errorf("history %v has no notification id", h)
+		}
+	}
+}
+
+func TestAlertRunnerEvaluationFailed(t *testing.T) {
+	runner, source, history, _ := newReplayRunner(t, "testdata/replay.json")
+	failing := &failingSource{source: source, err: fmt.Errorf("adapter unavailable")}
+	runner.Source = failing
+
+	runTick(runner, source)
+	//Changed errors are kept in the status without writing history again
+	failing.err = fmt.Errorf("adapter returned 502")
+	runTick(runner, source)
+	if !runner.AlertStatus.EvaluationFailed || runner.AlertStatus.EvaluationError != "adapter returned 502" {
+		t.Errorf("status got failed %v error [%s], expect evaluation failed", runner.AlertStatus.EvaluationFailed, runner.AlertStatus.EvaluationError)
+	}
+	//Rules are not evaluated without metrics
+	if len(runner.AlertStatus.ResourceStatus) != 0 {
+		t.Errorf("resource status got %v, expect none", runner.AlertStatus.ResourceStatus)
+	}
+
+	failing.err = nil
+	runTick(runner, source)
+	if runner.AlertStatus.EvaluationFailed {
+		t.Errorf("status got evaluation failed, expect recovered")
+	}
+
+	expect := []string{"evaluation_failed  ", "evaluation_recovered  "}
+	rows := []string{}
+	for _, row := range history.rows() {
+		if row == expect[0] || row == expect[1] {
+			rows = append(rows, row)
+		}
+	}
+	if !reflect.DeepEqual(rows, expect) {
+		t.Errorf("histories got %v, expect %v", history.rows(), expect)
+	}
+}
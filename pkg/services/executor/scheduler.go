@@ -0,0 +1,83 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	MinEvaluationIntervalSecond = models.MinEvaluationIntervalSecond
+)
+
+//EvaluationScheduler groups rules with the same evaluation interval and tracks when each group is due
+type EvaluationScheduler struct {
+	RulesSameInterval map[uint32][]string
+	NextEvaluation    map[uint32]time.Time
+}
+
+func NewEvaluationScheduler() EvaluationScheduler {
+	return EvaluationScheduler{
+		RulesSameInterval: make(map[uint32][]string),
+		NextEvaluation:    make(map[uint32]time.Time),
+	}
+}
+
+//Seconds between evaluations of the rule, monitor periods minutes if not set
+func (ri *RuleInfo) getEvaluationInterval() uint32 {
+	return models.GetEvaluationInterval(ri.EvaluationInterval, ri.MonitorPeriods)
+}
+
+//Add the rule into the group of its interval, a new group is first due after the minimum interval
+func (es *EvaluationScheduler) addRule(ruleId string, interval uint32, now time.Time) {
+	if _, ok := es.NextEvaluation[interval]; !ok {
+		es.NextEvaluation[interval] = now.Add(MinEvaluationIntervalSecond * time.Second)
+	}
+	es.RulesSameInterval[interval] = append(es.RulesSameInterval[interval], ruleId)
+}
+
+//Get the intervals due at now and schedule their next evaluation, missed evaluations are skipped
+func (es *EvaluationScheduler) dueIntervals(now time.Time) []uint32 {
+	intervals := []uint32{}
+	for interval, next := range es.NextEvaluation {
+		if now.Before(next) {
+			continue
+		}
+		intervals = append(intervals, interval)
+
+		period := time.Duration(interval) * time.Second
+		for !now.Before(next) {
+			next = next.Add(period)
+		}
+		es.NextEvaluation[interval] = next
+	}
+	return intervals
+}
+
+//Time to wait from now until the next group is due
+func (es *EvaluationScheduler) nextWait(now time.Time) time.Duration {
+	wait := time.Duration(MinEvaluationIntervalSecond) * time.Second
+	for _, next := range es.NextEvaluation {
+		if next.Sub(now) < wait {
+			wait = next.Sub(now)
+		}
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+func resetTimer(timer *time.Timer, wait time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(wait)
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestGetEvaluationInterval(t *testing.T) {
+	tests := []struct {
+		name               string
+		evaluationInterval uint32
+		monitorPeriods     uint32
+		expectInterval     uint32
+	}{
+		{"evaluation interval", 30, 5, 30},
+		{"default to monitor periods", 0, 5, 300},
+		{"minimum interval", 5, 5, MinEvaluationIntervalSecond},
+		{"no interval and monitor periods", 0, 0, MinEvaluationIntervalSecond},
+	}
+
+	for _, test := range tests {
+		ruleInfo := RuleInfo{EvaluationInterval: test.evaluationInterval, MonitorPeriods: test.monitorPeriods}
+		if interval := ruleInfo.getEvaluationInterval(); interval != test.expectInterval {
+			t.Errorf("%s: expect interval %d, got %d", test.name, test.expectInterval, interval)
+		}
+	}
+}
+
+func TestEvaluationScheduler(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	scheduler := NewEvaluationScheduler()
+	scheduler.addRule("rl-cpu", 30, start)
+	scheduler.addRule("rl-mem", 60, start)
+	scheduler.addRule("rl-disk", 30, start)
+	//Rules without evaluation interval and monitor periods are evaluated at the minimum interval
+	scheduler.addRule("rl-net", (&RuleInfo{}).getEvaluationInterval(), start)
+
+	if !reflect.DeepEqual(scheduler.RulesSameInterval[30], []string{"rl-cpu", "rl-disk"}) {
+		t.Errorf("expect rules of the same interval in one group, got %v", scheduler.RulesSameInterval)
+	}
+
+	ticks := []struct {
+		name            string
+		seconds         int
+		expectIntervals []uint32
+		expectWait      time.Duration
+	}{
+		{"nothing due before the first evaluation", 5, []uint32{}, 5 * time.Second},
+		{"all groups are first due after the minimum interval", 10, []uint32{10, 30, 60}, 10 * time.Second},
+		{"minimum interval group", 20, []uint32{10}, 10 * time.Second},
+		{"mixed intervals", 40, []uint32{10, 30}, 10 * time.Second},
+		//Missed evaluations are skipped instead of run one by one
+		{"missed evaluations", 135, []uint32{10, 30, 60}, 5 * time.Second},
+		{"after missed evaluations", 140, []uint32{10}, 10 * time.Second},
+	}
+
+	for _, tick := range ticks {
+		now := start.Add(time.Duration(tick.seconds) * time.Second)
+		intervals := scheduler.dueIntervals(now)
+		sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+
+		if !reflect.DeepEqual(intervals, tick.expectIntervals) {
+			t.Errorf("%s: expect due intervals %v, got %v", tick.name, tick.expectIntervals, intervals)
+		}
+		if wait := scheduler.nextWait(now); wait != tick.expectWait {
+			t.Errorf("%s: expect wait %v, got %v", tick.name, tick.expectWait, wait)
+		}
+	}
+}
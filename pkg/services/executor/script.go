@@ -0,0 +1,79 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/util/scriptutil"
+)
+
+func getScriptLimits() scriptutil.Limits {
+	cfg := config.GetInstance()
+	return scriptutil.Limits{
+		MaxSteps: uint64(cfg.App.ScriptMaxSteps),
+		Timeout:  time.Duration(cfg.App.ScriptTimeoutMilliseconds) * time.Millisecond,
+	}
+}
+
+func (ar *AlertRunner) parseRuleScript(ruleId string, ruleInfo *RuleInfo, script string) {
+	if ruleInfo.ConditionType != models.ConditionTypeScript {
+		return
+	}
+
+	compiled, err := scriptutil.Compile(script, getScriptLimits())
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] compile script error: %v, rule will be disabled", ar.AlertConfig.AlertId, ruleId, err)
+		ruleInfo.Disabled = true
+		ruleInfo.Invalid = true
+		return
+	}
+	ruleInfo.Script = compiled
+}
+
+//Status of the resource passed to the script, a reset status if the resource has no status yet
+func (ar *AlertRunner) getScriptStatus(ruleId string, resourceName string) map[string]interface{} {
+	ar.AlertStatus.RLock()
+	status, ok := ar.AlertStatus.ResourceStatus[getRuleResourceKey(ruleId, resourceName)]
+	ar.AlertStatus.RUnlock()
+	if !ok {
+		status = ar.getResetResourceStatus(ruleId)
+	}
+
+	lastSeenTime := int64(0)
+	if !status.LastSeenTime.IsZero() {
+		lastSeenTime = status.LastSeenTime.Unix()
+	}
+
+	return map[string]interface{}{
+		"current_level":        status.CurrentLevel,
+		"positive_count":       status.PositiveCount,
+		"negative_count":       status.NegativeCount,
+		"cumulated_send_count": status.CumulatedSendCount,
+		"no_data":              status.NoData,
+		"flapping":             status.Flapping,
+		"inhibited":            status.Inhibited,
+		"last_seen_time":       lastSeenTime,
+	}
+}
+
+//Run the script of the rule on the scaled time values of the resource, return fire and the message of the script
+func (ar *AlertRunner) checkScript(ruleId string, resourceName string, rule RuleInfo, tvs []metric.TV, scale float64) (bool, string, error) {
+	values, times := metric.ParseValues(tvs, scale)
+	samples := make([]scriptutil.Sample, len(values))
+	for i := range values {
+		samples[i] = scriptutil.Sample{T: times[i], V: values[i]}
+	}
+
+	result, err := rule.Script.Check(samples, ar.getScriptStatus(ruleId, resourceName), rule.ScriptThresholds, getScriptLimits())
+	if err != nil {
+		return false, "", err
+	}
+	return result.Fire, result.Message, nil
+}
@@ -0,0 +1,305 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+const (
+	SloEvaluationIntervalSecond = 60
+	SloBudgetRefreshSecond      = 3600
+	SloBurnRateUnit             = "x"
+)
+
+//SloInfo is one burn window of a slo, evaluated as a rule firing when the burn rate exceeds the thresholds over both windows
+type SloInfo struct {
+	SloId           string
+	Target          float64
+	WindowDays      uint32
+	GoodMetricName  string
+	TotalMetricName string
+	LongWindow      uint32
+	ShortWindow     uint32
+}
+
+//SloBudgetCache keeps the metrics of the budget windows, which cover the whole slo window and change slowly
+type SloBudgetCache struct {
+	sync.Mutex
+	Budgets map[uint32]SloBudget
+}
+
+type SloBudget struct {
+	ResourceMetrics []metric.ResourceMetrics
+	FetchTime       time.Time
+}
+
+func (sbc *SloBudgetCache) reset() {
+	sbc.Lock()
+	sbc.Budgets = make(map[uint32]SloBudget)
+	sbc.Unlock()
+}
+
+//Rule id of the burn window of the slo, status and history of the burn window are kept under it
+func getSloRuleId(sloId string, index int) string {
+	return fmt.Sprintf("%s/%d", sloId, index)
+}
+
+func formatWindow(seconds uint32) string {
+	d := time.Duration(seconds) * time.Second
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+//Add a rule for each burn window of the slos of the policy
+func (ar *AlertRunner) parseSlos() {
+	for _, sloDetail := range rs.QuerySloDetails(ar.AlertConfig.AlertId) {
+		if sloDetail.Disabled {
+			continue
+		}
+
+		target, err := models.ParseSloTarget(sloDetail.Target)
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Slo[%s] parse target [%s] error: %v, slo will be ignored", ar.AlertConfig.AlertId, sloDetail.SloId, sloDetail.Target, err)
+			continue
+		}
+		burnWindows, err := models.ParseBurnWindows(sloDetail.BurnWindows)
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Slo[%s] parse burn windows [%s] error: %v, slo will be ignored", ar.AlertConfig.AlertId, sloDetail.SloId, sloDetail.BurnWindows, err)
+			continue
+		}
+		if sloDetail.GoodMetricName == "" || sloDetail.TotalMetricName == "" {
+			logger.Error(nil, "Alert[%s] Slo[%s] metrics do not exist, slo will be ignored", ar.AlertConfig.AlertId, sloDetail.SloId)
+			continue
+		}
+
+		windowDays := sloDetail.WindowDays
+		if windowDays == 0 {
+			windowDays = models.DefaultSloWindowDays
+		}
+
+		for i, burnWindow := range burnWindows {
+			ruleInfo := RuleInfo{
+				RuleName:                 fmt.Sprintf("%s burn rate %s/%s", sloDetail.SloName, formatWindow(burnWindow.LongWindow), formatWindow(burnWindow.ShortWindow)),
+				MonitorPeriods:           1,
+				EvaluationInterval:       SloEvaluationIntervalSecond,
+				Severity:                 burnWindow.Severity,
+				ConditionType:            models.ConditionTypeGreater,
+				Thresholds:               burnWindow.BurnRate,
+				Scale:                    1,
+				Unit:                     SloBurnRateUnit,
+				ConsecutiveCount:         1,
+				ConsecutiveRecoveryCount: 1,
+				NoDataBehavior:           models.NoDataBehaviorKeep,
+				Slo: &SloInfo{
+					SloId:           sloDetail.SloId,
+					Target:          target,
+					WindowDays:      windowDays,
+					GoodMetricName:  sloDetail.GoodMetricName,
+					TotalMetricName: sloDetail.TotalMetricName,
+					LongWindow:      burnWindow.LongWindow,
+					ShortWindow:     burnWindow.ShortWindow,
+				},
+			}
+			ar.AlertConfig.Rules[getSloRuleId(sloDetail.SloId, i)] = ruleInfo
+		}
+	}
+}
+
+func (si *SloInfo) getBudgetWindow() uint32 {
+	return si.WindowDays * 24 * 3600
+}
+
+//Windows whose metrics are requested for the burn window in every evaluation
+func (si *SloInfo) getRanges() []uint32 {
+	return []uint32{si.LongWindow, si.ShortWindow}
+}
+
+//Error budget burn rate over the window, 1 means the budget is used up exactly at the end of the slo window
+func (si *SloInfo) burnRate(metricTVs map[string][]metric.TV) (float64, error) {
+	errorRatio, err := metric.ErrorRatio(metricTVs[si.GoodMetricName], metricTVs[si.TotalMetricName])
+	if err != nil {
+		return 0, err
+	}
+	return errorRatio / (1 - si.Target/100), nil
+}
+
+//Burn rates over the long and the short window
+func (si *SloInfo) burnRates(longTVs map[string][]metric.TV, shortTVs map[string][]metric.TV) (float64, float64, error) {
+	longBurnRate, err := si.burnRate(longTVs)
+	if err != nil {
+		return 0, 0, err
+	}
+	shortBurnRate, err := si.burnRate(shortTVs)
+	if err != nil {
+		return 0, 0, err
+	}
+	return longBurnRate, shortBurnRate, nil
+}
+
+//Describe the burn rates of both windows and the error budget remaining in the slo window if known
+func (si *SloInfo) formatMessage(longBurnRate float64, shortBurnRate float64, budgetTVs map[string][]metric.TV) string {
+	message := fmt.Sprintf("%s %.2f%s, %s %.2f%s", formatWindow(si.LongWindow), longBurnRate, SloBurnRateUnit, formatWindow(si.ShortWindow), shortBurnRate, SloBurnRateUnit)
+
+	budgetBurnRate, err := si.burnRate(budgetTVs)
+	if err != nil {
+		return message
+	}
+	return fmt.Sprintf("%s, error budget remaining %.2f%%", message, (1-budgetBurnRate)*100)
+}
+
+//Get the metrics of the budget window, requested again only after the refresh interval, the last metrics are kept on error
+func (ar *AlertRunner) getSloBudgetMetrics(ctx context.Context, budgetWindow uint32, ruleIds []string) []metric.ResourceMetrics {
+	ar.SloBudgets.Lock()
+	defer ar.SloBudgets.Unlock()
+
+	budget, ok := ar.SloBudgets.Budgets[budgetWindow]
+	if ok && time.Since(budget.FetchTime) < SloBudgetRefreshSecond*time.Second {
+		return budget.ResourceMetrics
+	}
+
+	budgetMetrics, err := ar.requestMetrics(ctx, ruleIds, metric.RangeQueryParams(budgetWindow))
+	if err != nil {
+		logger.Debug(nil, "getSloBudgetMetrics budget window %d of rules %v failed: %v", budgetWindow, ruleIds, err)
+		return budget.ResourceMetrics
+	}
+	for i := range budgetMetrics {
+		budgetMetrics[i].RangeSeconds = budgetWindow
+	}
+
+	if ar.SloBudgets.Budgets == nil {
+		ar.SloBudgets.Budgets = make(map[uint32]SloBudget)
+	}
+	ar.SloBudgets.Budgets[budgetWindow] = SloBudget{budgetMetrics, time.Now()}
+	return budgetMetrics
+}
+
+//Slo rules ask for their metrics over each window of the burn window, return the error if no request succeeds,
+//the budget window only describes the remaining budget and is taken from the cache
+func (ar *AlertRunner) requestSloMetrics(ctx context.Context, ruleIds []string, ch chan metric.ResourceMetrics) error {
+	rangeRules := make(map[uint32][]string)
+	budgetRules := make(map[uint32][]string)
+	for _, ruleId := range ruleIds {
+		slo := ar.AlertConfig.Rules[ruleId].Slo
+		if slo == nil {
+			continue
+		}
+		budgetWindow := slo.getBudgetWindow()
+		for _, rangeSeconds := range slo.getRanges() {
+			rangeRules[rangeSeconds] = append(rangeRules[rangeSeconds], ruleId)
+			if rangeSeconds == budgetWindow {
+				budgetWindow = 0
+			}
+		}
+		//Budget window equal to a burn window is requested with the burn window
+		if budgetWindow != 0 {
+			budgetRules[budgetWindow] = append(budgetRules[budgetWindow], ruleId)
+		}
+	}
+
+	var requestErr error
+	requested := false
+	for rangeSeconds, rangeRuleIds := range rangeRules {
+		rangeMetrics, err := ar.requestMetrics(ctx, rangeRuleIds, metric.RangeQueryParams(rangeSeconds))
+		if err != nil {
+			logger.Debug(nil, "requestSloMetrics range %d of rules %v failed", rangeSeconds, rangeRuleIds)
+			requestErr = err
+			continue
+		}
+		requested = true
+
+		for _, rm := range rangeMetrics {
+			rm.RangeSeconds = rangeSeconds
+			logger.Debug(nil, "requestSloMetrics range %v", rm)
+			ch <- rm
+		}
+	}
+
+	for budgetWindow, budgetRuleIds := range budgetRules {
+		for _, rm := range ar.getSloBudgetMetrics(ctx, budgetWindow, budgetRuleIds) {
+			logger.Debug(nil, "requestSloMetrics budget %v", rm)
+			ch <- rm
+		}
+	}
+
+	if requested {
+		return nil
+	}
+	return requestErr
+}
+
+//Compute the burn rates of each resource from all metrics of the slo rule returned in this tick
+func (ar *AlertRunner) checkSloMetrics(ruleId string, resourceMetricsList []metric.ResourceMetrics) bool {
+	rule := ar.AlertConfig.Rules[ruleId]
+	slo := rule.Slo
+
+	//window -> resource -> metric name -> time values
+	windowMetrics := make(map[uint32]map[string]map[string][]metric.TV)
+	for _, resourceMetrics := range resourceMetricsList {
+		if _, ok := windowMetrics[resourceMetrics.RangeSeconds]; !ok {
+			windowMetrics[resourceMetrics.RangeSeconds] = make(map[string]map[string][]metric.TV)
+		}
+		resourceTVs := windowMetrics[resourceMetrics.RangeSeconds]
+		for resourceName, tvs := range resourceMetrics.ResourceMetric {
+			if _, ok := resourceTVs[resourceName]; !ok {
+				resourceTVs[resourceName] = make(map[string][]metric.TV)
+			}
+			resourceTVs[resourceName][resourceMetrics.MetricName] = tvs
+		}
+	}
+
+	triggeredMetrics := []RecordedMetric{}
+	resumedMetrics := []RecordedMetric{}
+	noDataMetrics := []RecordedMetric{}
+	seenResources := metric.ResourceMetrics{RuleId: ruleId, ResourceMetric: make(map[string][]metric.TV)}
+
+	for resourceName, metricTVs := range windowMetrics[slo.LongWindow] {
+		seenResources.ResourceMetric[resourceName] = nil
+
+		longBurnRate, shortBurnRate, err := slo.burnRates(metricTVs, windowMetrics[slo.ShortWindow][resourceName])
+		if err != nil {
+			logger.Debug(nil, "checkSloMetrics Rule[%s] Resource[%s] has no data: %v", ruleId, resourceName, err)
+			rule.addNoDataMetric(RecordedMetric{rule.RuleName, resourceName, 0, rule.Unit, rule.Severity, true, nil, 0, "", "", nil}, &triggeredMetrics, &resumedMetrics, &noDataMetrics)
+			continue
+		}
+		message := slo.formatMessage(longBurnRate, shortBurnRate, windowMetrics[slo.getBudgetWindow()][resourceName])
+
+		//Both windows exceed the burn rate exactly when the lower one does
+		burnRate := longBurnRate
+		if shortBurnRate < burnRate {
+			burnRate = shortBurnRate
+		}
+
+		if compareValue(rule.ConditionType, burnRate, rule.Thresholds) {
+			triggeredMetrics = append(triggeredMetrics, RecordedMetric{rule.RuleName, resourceName, burnRate, rule.Unit, rule.Severity, false, nil, 0, "", message, nil})
+		} else {
+			resumedMetrics = append(resumedMetrics, RecordedMetric{rule.RuleName, resourceName, burnRate, rule.Unit, "", false, nil, 0, "", message, nil})
+		}
+	}
+
+	//Resources known before but absent from the long window have no data either
+	for _, resourceName := range ar.getAbsentResources(seenResources) {
+		logger.Debug(nil, "checkSloMetrics Rule[%s] Resource[%s] is absent", ruleId, resourceName)
+		rule.addNoDataMetric(RecordedMetric{rule.RuleName, resourceName, 0, rule.Unit, rule.Severity, true, nil, 0, "", "", nil}, &triggeredMetrics, &resumedMetrics, &noDataMetrics)
+	}
+
+	ar.recordRuleResults(ruleId, triggeredMetrics, resumedMetrics, noDataMetrics)
+
+	return ar.checkRuleResources(ruleId, triggeredMetrics, resumedMetrics, noDataMetrics)
+}
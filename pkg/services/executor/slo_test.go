@@ -0,0 +1,114 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+)
+
+func newSloRunner(source MetricSource) *AlertRunner {
+	runner := &AlertRunner{Source: source}
+	runner.AlertConfig.Rules = map[string]RuleInfo{
+		"sl-api/0": {Slo: &SloInfo{SloId: "sl-api", Target: 99, WindowDays: 30, GoodMetricName: "good", TotalMetricName: "total", LongWindow: 3600, ShortWindow: 300}},
+		"sl-api/1": {Slo: &SloInfo{SloId: "sl-api", Target: 99, WindowDays: 30, GoodMetricName: "good", TotalMetricName: "total", LongWindow: 21600, ShortWindow: 1800}},
+	}
+	runner.SloBudgets.reset()
+	return runner
+}
+
+//Windows of the metrics sent for the slo rules
+func requestSloRanges(t *testing.T, runner *AlertRunner) []uint32 {
+	ch := make(chan metric.ResourceMetrics, 100)
+	err := runner.requestSloMetrics(context.Background(), []string{"sl-api/0", "sl-api/1"}, ch)
+	close(ch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := make(map[uint32]bool)
+	for rm := range ch {
+		if rm.MetricName != "good" && rm.MetricName != "total" {
+			t.Errorf("requestSloMetrics got metric %s, expect good and total", rm.MetricName)
+		}
+		found[rm.RangeSeconds] = true
+	}
+	ranges := []uint32{}
+	for rangeSeconds := range found {
+		ranges = append(ranges, rangeSeconds)
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i] < ranges[j] })
+	return ranges
+}
+
+func TestRequestSloMetricsBudgetCache(t *testing.T) {
+	source := &countingSource{}
+	runner := newSloRunner(source)
+	expectRanges := []uint32{300, 1800, 3600, 21600, 30 * 24 * 3600}
+
+	if ranges := requestSloRanges(t, runner); !reflect.DeepEqual(ranges, expectRanges) {
+		t.Errorf("first requestSloMetrics got ranges %v, expect %v", ranges, expectRanges)
+	}
+	//4 burn windows and the budget window shared by both burn windows
+	if source.count() != 5 {
+		t.Errorf("first requestSloMetrics requested %d times, expect 5", source.count())
+	}
+
+	//Budget window is taken from the cache until the refresh interval passes
+	if ranges := requestSloRanges(t, runner); !reflect.DeepEqual(ranges, expectRanges) {
+		t.Errorf("cached requestSloMetrics got ranges %v, expect %v", ranges, expectRanges)
+	}
+	if source.count() != 9 {
+		t.Errorf("cached requestSloMetrics requested %d times, expect 9", source.count())
+	}
+
+	budget := runner.SloBudgets.Budgets[30*24*3600]
+	budget.FetchTime = time.Now().Add(-SloBudgetRefreshSecond * time.Second)
+	runner.SloBudgets.Budgets[30*24*3600] = budget
+	requestSloRanges(t, runner)
+	if source.count() != 14 {
+		t.Errorf("expired requestSloMetrics requested %d times, expect 14", source.count())
+	}
+
+	//Failed refresh keeps the last budget
+	source.err = fmt.Errorf("source unavailable")
+	budget.FetchTime = time.Now().Add(-SloBudgetRefreshSecond * time.Second)
+	runner.SloBudgets.Budgets[30*24*3600] = budget
+	if budgetMetrics := runner.getSloBudgetMetrics(context.Background(), 30*24*3600, []string{"sl-api/0"}); len(budgetMetrics) == 0 {
+		t.Errorf("failed getSloBudgetMetrics got no metrics, expect the last budget")
+	}
+}
+
+func TestRunAlertRulesManyMetrics(t *testing.T) {
+	runner, _, _, _ := newReplayRunner(t, "testdata/replay.json")
+	runner.Source = &countingSource{}
+	runner.AlertConfig.Scheduler = NewEvaluationScheduler()
+	rules := make(map[string]RuleInfo)
+	//More results than the buffer of the metric channel
+	for i := 0; i < 150; i++ {
+		ruleId := fmt.Sprintf("rl-%d", i)
+		rules[ruleId] = RuleInfo{MetricName: ruleId, MonitorPeriods: 1, ConditionType: ">", Thresholds: 1, Scale: 1, ConsecutiveCount: 1}
+		runner.AlertConfig.Scheduler.addRule(ruleId, 60, time.Now().Add(-time.Minute))
+	}
+	runner.AlertConfig.Rules = rules
+
+	done := make(chan struct{})
+	go func() {
+		runner.runAlertRules()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("runAlertRules blocked with more metrics than the channel buffer")
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"kubesphere.io/alert/pkg/client/adapter"
+	k8sclient "kubesphere.io/alert/pkg/client/kubernetes"
+	"kubesphere.io/alert/pkg/client/prometheus"
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+)
+
+const (
+	MetricSourceAdapter    = "adapter"
+	MetricSourcePrometheus = "prometheus"
+	MetricSourceReplay     = "replay"
+)
+
+//MetricSource returns the time series of the metrics of the resources selected by the metric param,
+//one result for each rule asking for each metric
+type MetricSource interface {
+	GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error)
+}
+
+//AdapterSource asks the sidecar adapter for the metrics
+type AdapterSource struct {
+}
+
+func (as *AdapterSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	metricParamBytes, err := json.Marshal(metricParam)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceMetricsStr, err := adapter.SendMetricRequest(ctx, string(metricParamBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceMetrics := []metric.ResourceMetrics{}
+	err = json.Unmarshal([]byte(resourceMetricsStr), &resourceMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceMetrics, nil
+}
+
+var metricSource MetricSource
+
+var metricSourceOnce sync.Once
+
+//Get the metric source of the config shared by all runners of the executor
+func GetMetricSource() MetricSource {
+	metricSourceOnce.Do(func() {
+		metricSource = NewMetricSource(config.GetInstance())
+	})
+	return metricSource
+}
+
+//StateMetricSource serves the object state metrics from the caches of informers running until Stop
+type StateMetricSource interface {
+	MetricSource
+	WaitForCacheSync(stopCh <-chan struct{}) bool
+	Stop()
+}
+
+//RoutedSource asks the state source for the object state metrics and the source for the other metrics
+type RoutedSource struct {
+	Source      MetricSource
+	StateSource StateMetricSource
+}
+
+//StateMetricsError is returned with the results of the other metrics when only the object state metrics failed
+type StateMetricsError struct {
+	Metrics []string
+	Err     error
+}
+
+func (e *StateMetricsError) Error() string {
+	return fmt.Sprintf("object state metrics %v failed: %v", e.Metrics, e.Err)
+}
+
+//Params asking for the metrics selected only
+func selectMetrics(metricParam metric.MetricParam, selected func(metricName string) bool) metric.MetricParam {
+	metrics := []string{}
+	metricToRule := make(map[string][]string)
+	for _, metricName := range metricParam.Metrics {
+		if selected(metricName) {
+			metrics = append(metrics, metricName)
+			metricToRule[metricName] = metricParam.MetricToRule[metricName]
+		}
+	}
+	metricParam.Metrics = metrics
+	metricParam.MetricToRule = metricToRule
+	return metricParam
+}
+
+//Failed state metrics do not fail the other metrics, they are reported by StateMetricsError along with the other results
+func (rs *RoutedSource) GetMetrics(ctx context.Context, metricParam metric.MetricParam) ([]metric.ResourceMetrics, error) {
+	resourceMetricsList := []metric.ResourceMetrics{}
+
+	var stateErr error
+	stateParam := selectMetrics(metricParam, k8sclient.IsStateMetric)
+	if len(stateParam.Metrics) > 0 {
+		resourceMetrics, err := rs.StateSource.GetMetrics(ctx, stateParam)
+		if err != nil {
+			stateErr = &StateMetricsError{Metrics: stateParam.Metrics, Err: err}
+		} else {
+			resourceMetricsList = append(resourceMetricsList, resourceMetrics...)
+		}
+	}
+
+	param := selectMetrics(metricParam, func(metricName string) bool { return !k8sclient.IsStateMetric(metricName) })
+	if len(param.Metrics) > 0 {
+		resourceMetrics, err := rs.Source.GetMetrics(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		resourceMetricsList = append(resourceMetricsList, resourceMetrics...)
+	}
+
+	return resourceMetricsList, stateErr
+}
+
+//Start the informers of object states of the in-cluster clientset and wait for their caches,
+//nil if the clientset is not available
+func newStateSource(cfg *config.Config) StateMetricSource {
+	clientset := k8sclient.NewK8sClient()
+	if clientset == nil {
+		logger.Error(nil, "Kubernetes client is not available, object state metrics will not be served")
+		return nil
+	}
+
+	stateSource := k8sclient.NewStateSource(clientset, time.Duration(cfg.Kubernetes.ResyncSeconds)*time.Second)
+	stateSource.Start()
+	waitStateSource(stateSource, time.Duration(cfg.Kubernetes.SyncTimeoutSeconds)*time.Second)
+	return stateSource
+}
+
+//Object state metrics fail until the caches are synced, so the executor waits for them before running rules
+func waitStateSource(stateSource StateMetricSource, timeout time.Duration) bool {
+	stopCh := make(chan struct{})
+	timer := time.AfterFunc(timeout, func() { close(stopCh) })
+	defer timer.Stop()
+
+	if !stateSource.WaitForCacheSync(stopCh) {
+		logger.Error(nil, "Object state caches are not synced in %v, object state metrics fail until they are", timeout)
+		return false
+	}
+	logger.Info(nil, "Object state caches are synced")
+	return true
+}
+
+func NewMetricSource(cfg *config.Config) MetricSource {
+	source := newQuerySource(cfg)
+	if !cfg.Kubernetes.ObjectState {
+		return source
+	}
+
+	stateSource := newStateSource(cfg)
+	if stateSource == nil {
+		return source
+	}
+	return &RoutedSource{Source: source, StateSource: stateSource}
+}
+
+//Source of the metrics queried from the metric backend of the config
+func newQuerySource(cfg *config.Config) MetricSource {
+	switch cfg.App.MetricSource {
+	case MetricSourcePrometheus:
+		queries, err := loadPrometheusQueries(cfg.Prometheus.QueryFile)
+		if err == nil {
+			step := time.Duration(cfg.Prometheus.StepSeconds) * time.Second
+			rangeDuration := time.Duration(cfg.Prometheus.RangeSeconds) * time.Second
+			return coalesceMetricSource(cfg, prometheus.NewSource(cfg.Prometheus.Url, step, rangeDuration, queries))
+		}
+		logger.Error(nil, "Load prometheus query file error: %v, adapter will be used", err)
+	//Replay results may be recorded for single rules, so they are not shared
+	case MetricSourceReplay:
+		interval := time.Duration(cfg.Replay.IntervalSeconds) * time.Second
+		replaySource, err := metric.LoadReplaySource(cfg.Replay.File, interval, cfg.Replay.Loop)
+		if err == nil {
+			return replaySource
+		}
+		logger.Error(nil, "Load replay metric source error: %v, adapter will be used", err)
+	case MetricSourceAdapter, "":
+	default:
+		logger.Error(nil, "Unknown metric source [%s], adapter will be used", cfg.App.MetricSource)
+	}
+	return coalesceMetricSource(cfg, &AdapterSource{})
+}
+
+//Without the query file only PromQL expressions can be queried, metrics of plain names fail
+func loadPrometheusQueries(file string) (map[string]string, error) {
+	if file == "" {
+		logger.Warn(nil, "No prometheus query file, metrics need to be PromQL expressions")
+		return map[string]string{}, nil
+	}
+	return prometheus.LoadQueries(file)
+}
+
+//Stop the informers of the metric source of the executor
+func StopMetricSource() {
+	if routedSource, ok := GetMetricSource().(*RoutedSource); ok {
+		routedSource.StateSource.Stop()
+	}
+}
+
+//Share the results of the source among runners if coalescing is enabled
+func coalesceMetricSource(cfg *config.Config, source MetricSource) MetricSource {
+	if !cfg.App.MetricCoalesce {
+		return source
+	}
+	return NewCoalescedSource(source, time.Duration(cfg.App.MetricCacheSeconds)*time.Second, time.Duration(cfg.App.MetricTimeoutSeconds)*time.Second)
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	k8sclient "kubesphere.io/alert/pkg/client/kubernetes"
+	"kubesphere.io/alert/pkg/metric"
+)
+
+//State source of the fake clientset with node1 ready and node2 not ready
+func newFakeStateSource(t *testing.T, start bool) *k8sclient.StateSource {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+			Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+			Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}},
+		},
+	)
+
+	stateSource := k8sclient.NewStateSource(clientset, 0)
+	if start {
+		stateSource.Start()
+		if !waitStateSource(stateSource, 5*time.Second) {
+			t.Fatal("state source caches are not synced")
+		}
+	}
+	return stateSource
+}
+
+func TestRoutedSource(t *testing.T) {
+	stateSource := newFakeStateSource(t, true)
+	defer stateSource.Stop()
+
+	source := &countingSource{}
+	routed := &RoutedSource{Source: source, StateSource: stateSource}
+	metricParam := metric.MetricParam{
+		RsTypeName:   "node",
+		Metrics:      []string{k8sclient.StateNodeNotReady, "node_cpu_utilisation"},
+		MetricToRule: map[string][]string{k8sclient.StateNodeNotReady: {"rl-state"}, "node_cpu_utilisation": {"rl-cpu"}},
+	}
+
+	resourceMetricsList, err := routed.GetMetrics(context.Background(), metricParam)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//Only the other metrics are asked from the source
+	if len(source.requests) != 1 || !reflect.DeepEqual(source.requests[0], []string{"node_cpu_utilisation"}) {
+		t.Errorf("source got requests %v, expect node_cpu_utilisation only", source.requests)
+	}
+	results := make(map[string]metric.ResourceMetrics)
+	for _, resourceMetrics := range resourceMetricsList {
+		results[resourceMetrics.RuleId] = resourceMetrics
+	}
+	if len(results) != 2 || len(results["rl-cpu"].ResourceMetric) != 1 {
+		t.Fatalf("GetMetrics got %v, expect results of rl-state and rl-cpu", resourceMetricsList)
+	}
+	states := results["rl-state"].ResourceMetric
+	if len(states) != 2 || states["node1"][0].V != "0" || states["node2"][0].V != "1" {
+		t.Errorf("GetMetrics got states %v, expect node2 not ready", states)
+	}
+
+	//Failed state metrics are reported separately without failing the other metrics
+	routed.StateSource = newFakeStateSource(t, false)
+	resourceMetricsList, err = routed.GetMetrics(context.Background(), metricParam)
+	stateErr, ok := err.(*StateMetricsError)
+	if !ok || !reflect.DeepEqual(stateErr.Metrics, []string{k8sclient.StateNodeNotReady}) {
+		t.Errorf("GetMetrics of unsynced state source got error %v, expect state metrics error", err)
+	}
+	if len(resourceMetricsList) != 1 || resourceMetricsList[0].RuleId != "rl-cpu" {
+		t.Errorf("GetMetrics of unsynced state source got %v, expect results of rl-cpu", resourceMetricsList)
+	}
+
+	//Failed other metrics fail the request
+	source.err = fmt.Errorf("adapter unavailable")
+	if _, err := routed.GetMetrics(context.Background(), metricParam); err != source.err {
+		t.Errorf("GetMetrics of failed source got error %v, expect %v", err, source.err)
+	}
+}
+
+func TestGetOneMetricStateFailed(t *testing.T) {
+	runner, source, _, _ := newReplayRunner(t, "testdata/replay.json")
+	runner.Source = &RoutedSource{Source: source, StateSource: newFakeStateSource(t, false)}
+	stateRule := runner.AlertConfig.Rules["rl-cpu"]
+	stateRule.MetricName = k8sclient.StateNodeNotReady
+	runner.AlertConfig.Rules["rl-state"] = stateRule
+	runner.AlertConfig.Scheduler.addRule("rl-state", 60, time.Now())
+
+	ch := make(chan metric.ResourceMetrics, 10)
+	err := runner.getOneMetric(context.Background(), 60, ch)
+	close(ch)
+
+	if _, ok := err.(*StateMetricsError); !ok {
+		t.Errorf("expect error of the state metrics, got %v", err)
+	}
+	ruleIds := []string{}
+	for rm := range ch {
+		ruleIds = append(ruleIds, rm.RuleId)
+	}
+	sort.Strings(ruleIds)
+	if !reflect.DeepEqual(ruleIds, []string{"rl-cpu"}) {
+		t.Errorf("expect only metrics of rules without failed state metrics, got %v", ruleIds)
+	}
+}
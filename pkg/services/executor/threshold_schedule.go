@@ -0,0 +1,124 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"time"
+
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+const (
+	//Name recorded for the rule thresholds applied outside all windows of the schedule
+	DefaultScheduleName = "default"
+)
+
+type ScheduleInfo struct {
+	Location *time.Location
+	Windows  []WindowInfo
+}
+
+//WindowInfo replaces thresholds of the rule between start and end minutes of the day on its weekdays
+type WindowInfo struct {
+	Name        string
+	Weekdays    [7]bool
+	StartMinute int
+	EndMinute   int
+	Thresholds  OverrideInfo
+}
+
+func (ar *AlertRunner) parseRuleThresholdSchedule(ruleId string, ruleInfo *RuleInfo, thresholdSchedule string) {
+	schedule, location, err := models.ParseThresholdSchedule(thresholdSchedule)
+	if err != nil {
+		logger.Error(nil, "Alert[%s] Rule[%s] parse threshold schedule [%s] error: %v, rule thresholds will be used", ar.AlertConfig.AlertId, ruleId, thresholdSchedule, err)
+		return
+	}
+	if schedule == nil {
+		return
+	}
+	if len(ruleInfo.Levels) > 0 {
+		logger.Error(nil, "Alert[%s] Rule[%s] with levels can not have threshold schedule [%s], rule thresholds will be used", ar.AlertConfig.AlertId, ruleId, thresholdSchedule)
+		return
+	}
+
+	scheduleInfo := &ScheduleInfo{Location: location}
+	for _, window := range schedule.Windows {
+		windowInfo := WindowInfo{Name: window.Name}
+		windowInfo.StartMinute, _ = models.ParseClockMinute(window.Start)
+		windowInfo.EndMinute, _ = models.ParseClockMinute(window.End)
+		if windowInfo.Name == "" {
+			windowInfo.Name = fmt.Sprintf("%v %s-%s", window.Weekdays, window.Start, window.End)
+		}
+		for weekday := range windowInfo.Weekdays {
+			windowInfo.Weekdays[weekday] = len(window.Weekdays) == 0
+		}
+		for _, weekday := range window.Weekdays {
+			windowInfo.Weekdays[weekday] = true
+		}
+
+		threshold, expr, err := parseThresholds(ruleInfo.ConditionType, window.Thresholds)
+		if err != nil {
+			logger.Error(nil, "Alert[%s] Rule[%s] parse thresholds [%s] of window [%s] error: %v, window will be ignored", ar.AlertConfig.AlertId, ruleId, window.Thresholds, windowInfo.Name, err)
+			continue
+		}
+		windowInfo.Thresholds.Thresholds = threshold
+		windowInfo.Thresholds.Expression = expr
+
+		if window.RecoveryThresholds != "" {
+			threshold, expr, err := parseThresholds(ruleInfo.ConditionType, window.RecoveryThresholds)
+			if err != nil {
+				logger.Error(nil, "Alert[%s] Rule[%s] parse recovery thresholds [%s] of window [%s] error: %v, thresholds will be used", ar.AlertConfig.AlertId, ruleId, window.RecoveryThresholds, windowInfo.Name, err)
+			} else {
+				windowInfo.Thresholds.RecoveryThresholds = threshold
+				windowInfo.Thresholds.RecoveryExpression = expr
+				windowInfo.Thresholds.HasRecovery = true
+			}
+		}
+
+		scheduleInfo.Windows = append(scheduleInfo.Windows, windowInfo)
+	}
+
+	ruleInfo.Schedule = scheduleInfo
+}
+
+//Check if the window contains the time, windows ending not after their start continue past midnight
+func (wi *WindowInfo) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	weekday := int(t.Weekday())
+
+	if wi.StartMinute < wi.EndMinute {
+		return wi.Weekdays[weekday] && minute >= wi.StartMinute && minute < wi.EndMinute
+	}
+
+	if minute >= wi.StartMinute {
+		return wi.Weekdays[weekday]
+	}
+	return minute < wi.EndMinute && wi.Weekdays[(weekday+6)%7]
+}
+
+//Get the rule with thresholds of the schedule window active at the time, and the name of the applied thresholds,
+//windows without recovery thresholds keep the recovery thresholds of the rule
+func (ri RuleInfo) atTime(t time.Time) (RuleInfo, string) {
+	if ri.Schedule == nil {
+		return ri, ""
+	}
+
+	localTime := t.In(ri.Schedule.Location)
+	for _, window := range ri.Schedule.Windows {
+		if window.contains(localTime) {
+			thresholds := window.Thresholds
+			if !thresholds.HasRecovery {
+				thresholds.HasRecovery = ri.HasRecovery
+				thresholds.RecoveryThresholds = ri.RecoveryThresholds
+				thresholds.RecoveryExpression = ri.RecoveryExpression
+			}
+			return ri.withThresholds(thresholds), window.Name
+		}
+	}
+
+	return ri, DefaultScheduleName
+}
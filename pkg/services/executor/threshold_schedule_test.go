@@ -0,0 +1,114 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/models"
+)
+
+//Window on the weekdays from start to end minute of the day
+func newWindowInfo(startMinute int, endMinute int, weekdays ...time.Weekday) WindowInfo {
+	windowInfo := WindowInfo{StartMinute: startMinute, EndMinute: endMinute}
+	for _, weekday := range weekdays {
+		windowInfo.Weekdays[weekday] = true
+	}
+	return windowInfo
+}
+
+func TestWindowInfoContains(t *testing.T) {
+	//2020-09-14 is a Monday
+	monday := func(hour int, minute int) time.Time {
+		return time.Date(2020, 9, 14, hour, minute, 0, 0, time.UTC)
+	}
+	tuesday := func(hour int, minute int) time.Time {
+		return monday(hour, minute).AddDate(0, 0, 1)
+	}
+
+	workHours := newWindowInfo(9*60, 18*60, time.Monday, time.Friday)
+	mondayNight := newWindowInfo(22*60, 6*60, time.Monday)
+	wholeDay := newWindowInfo(0, 24*60, time.Monday)
+
+	tests := []struct {
+		name       string
+		windowInfo WindowInfo
+		t          time.Time
+		expect     bool
+	}{
+		{"start is included", workHours, monday(9, 0), true},
+		{"end is excluded", workHours, monday(18, 0), false},
+		{"before start", workHours, monday(8, 59), false},
+		{"other weekday", workHours, tuesday(12, 0), false},
+		{"past midnight before end", mondayNight, monday(23, 30), true},
+		{"past midnight on start weekday", mondayNight, monday(22, 0), true},
+		//After midnight the window belongs to the previous weekday
+		{"after midnight of previous weekday", mondayNight, tuesday(5, 59), true},
+		{"after midnight end is excluded", mondayNight, tuesday(6, 0), false},
+		{"after midnight of start weekday", mondayNight, monday(3, 0), false},
+		{"between end and start", mondayNight, monday(12, 0), false},
+		{"whole day start", wholeDay, monday(0, 0), true},
+		{"whole day end", wholeDay, monday(23, 59), true},
+		{"whole day next day", wholeDay, tuesday(0, 0), false},
+	}
+
+	for _, test := range tests {
+		if got := test.windowInfo.contains(test.t); got != test.expect {
+			t.Errorf("%s: contains %v got %v, expect %v", test.name, test.t, got, test.expect)
+		}
+	}
+}
+
+func TestRuleAtTime(t *testing.T) {
+	//Night window with its own recovery thresholds, day window without
+	thresholdSchedule := `{"timezone":"UTC","windows":[` +
+		`{"name":"night","start":"22:00","end":"06:00","thresholds":"0.95","recovery_thresholds":"0.9"},` +
+		`{"name":"day","start":"09:00","end":"18:00","thresholds":"0.7"}]}`
+	runner := &AlertRunner{}
+	rule := RuleInfo{ConditionType: models.ConditionTypeGreater, Thresholds: 0.6, HasRecovery: true, RecoveryThresholds: 0.5}
+	runner.parseRuleThresholdSchedule("rl-cpu", &rule, thresholdSchedule)
+
+	at := func(hour int) time.Time {
+		return time.Date(2020, 9, 14, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		t            time.Time
+		v            float64
+		alerting     bool
+		expectSet    bool
+		expectWindow string
+	}{
+		{"rule thresholds", at(7), 0.65, false, true, DefaultScheduleName},
+		{"rule recovery thresholds", at(7), 0.55, true, true, DefaultScheduleName},
+		{"window thresholds", at(23), 0.92, false, false, "night"},
+		{"window recovery thresholds", at(23), 0.92, true, true, "night"},
+		{"window recovered", at(23), 0.85, true, false, "night"},
+		//Window without recovery thresholds keeps the recovery thresholds of the rule
+		{"window without recovery", at(12), 0.55, true, true, "day"},
+		{"window without recovery recovered", at(12), 0.45, true, false, "day"},
+	}
+
+	for _, test := range tests {
+		windowRule, window := rule.atTime(test.t)
+		set, err := windowRule.checkCondition(test.v, test.alerting)
+		if err != nil || set != test.expectSet || window != test.expectWindow {
+			t.Errorf("%s: expect set %v in [%s], got %v %v in [%s]", test.name, test.expectSet, test.expectWindow, set, err, window)
+		}
+	}
+}
+
+func TestParseRuleThresholdScheduleLevels(t *testing.T) {
+	thresholdSchedule := `{"timezone":"UTC","windows":[{"start":"22:00","end":"06:00","thresholds":"0.95"}]}`
+	runner := &AlertRunner{}
+	rule := RuleInfo{ConditionType: models.ConditionTypeGreater, Thresholds: 0.6, Levels: []LevelInfo{{Thresholds: 0.9, Severity: models.SeverityCritical}}}
+	runner.parseRuleThresholdSchedule("rl-cpu", &rule, thresholdSchedule)
+
+	if rule.Schedule != nil {
+		t.Errorf("expect schedule of rule with levels ignored, got %+v", rule.Schedule)
+	}
+}
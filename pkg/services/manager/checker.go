@@ -47,6 +47,12 @@ func (s *Server) Checker(ctx context.Context, req interface{}) error {
 			Required(models.MtColId).
 			Exec()
 	case *pb.CreateRuleRequest:
+		//Composite rules combine other rules instead of a metric
+		if models.IsCompositeCondition(r.GetConditionType()) {
+			return manager.NewChecker(ctx, r).
+				Required(models.RlColSeverity, models.RlColConditionType, models.RlColThresholds, models.RlColPolicyId).
+				Exec()
+		}
 		return manager.NewChecker(ctx, r).
 			Required(models.RlColSeverity, models.RlColConditionType, models.RlColThresholds, models.RlColPolicyId, models.RlColMetricId).
 			Exec()
@@ -74,6 +80,22 @@ func (s *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required(models.AcColId).
 			Exec()
+	case *pb.CreateRuleOverrideRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.RoColRuleId, models.RoColResourceName, models.RoColThresholds).
+			Exec()
+	case *pb.ModifyRuleOverrideRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.RoColId).
+			Exec()
+	case *pb.CreateSloRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.SlColName, models.SlColTarget, models.SlColGoodMetricId, models.SlColTotalMetricId, models.SlColPolicyId).
+			Exec()
+	case *pb.ModifySloRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.SlColId).
+			Exec()
 	}
 
 	return nil
@@ -370,10 +370,34 @@ func (s *Server) CreateRule(ctx context.Context, req *CreateRuleRequest) (*Creat
 		req.GetUnit(),
 		req.GetConsecutiveCount(),
 		req.GetInhibit(),
+		req.GetAggregation(),
+		req.GetNoDataBehavior(),
+		req.GetRecoveryThresholds(),
+		req.GetConsecutiveRecoveryCount(),
+		req.GetLevels(),
+		req.GetForecastHorizon(),
+		req.GetEvaluationInterval(),
+		req.GetGroupCondition(),
+		req.GetMetricExpression(),
+		req.GetThresholdSchedule(),
+		req.GetOffsetWindow(),
+		req.GetScript(),
 		req.GetPolicyId(),
 		req.GetMetricId(),
 	)
 
+	err = checkCompositeRule(ctx, *rule, rs.GetRulesByPolicyId(rule.PolicyId))
+	if err != nil {
+		logger.Error(ctx, "Failed to validate composite Rule [%s %s]: %+v", rule.ConditionType, rule.Thresholds, err)
+		return nil, err
+	}
+
+	err = checkMetricExpressionVariables(ctx, rule.MetricExpression, rs.GetMetricNamesByPolicyId(rule.PolicyId))
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricExpression [%s]: %+v", rule.MetricExpression, err)
+		return nil, err
+	}
+
 	err = rs.CreateRule(ctx, rule)
 	if err != nil {
 		return nil, err
@@ -405,6 +429,24 @@ func (s *Server) ModifyRule(ctx context.Context, req *ModifyRuleRequest) (*Modif
 		return nil, err
 	}
 
+	rule := rs.GetRuleByRuleId(req.GetRuleId())
+
+	if rule.RuleId == "" {
+		logger.Error(ctx, "Modify Rule rule_id [%s] does not exist.", req.GetRuleId())
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorUpdateResourceFailed, req.GetRuleId())
+	}
+
+	err = checkRuleModification(ctx, rule, rs.GetRulesByPolicyId(rule.PolicyId), rs.HasRuleOverrides(rule.RuleId), req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkMetricExpressionVariables(ctx, req.GetMetricExpression().GetValue(), rs.GetMetricNamesByPolicyId(rule.PolicyId))
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricExpression [%s]: %+v", req.GetMetricExpression().GetValue(), err)
+		return nil, err
+	}
+
 	ruleId, err := rs.ModifyRule(ctx, req)
 	if err != nil {
 		logger.Error(ctx, "Failed to Modify Rule[%s], [%+v].", ruleId, err)
@@ -660,3 +702,208 @@ func (s *Server) DeleteActions(ctx context.Context, req *DeleteActionsRequest) (
 		ActionId: actionIds,
 	}, nil
 }
+
+//10.RuleOverride
+//********************************************************************************************************
+func (s *Server) CreateRuleOverride(ctx context.Context, req *CreateRuleOverrideRequest) (*CreateRuleOverrideResponse, error) {
+	err := ValidateCreateRuleOverrideParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := rs.GetRuleByRuleId(req.GetRuleId())
+
+	if rule.RuleId == "" {
+		logger.Error(ctx, "Create RuleOverride rule_id [%s] does not exist.", req.GetRuleId())
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorCreateResourcesFailed)
+	}
+
+	err = checkRuleOverride(ctx, rule, req.GetThresholds(), req.GetRecoveryThresholds())
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RuleOverride [%s %s] of Rule[%s]: %+v", req.GetThresholds(), req.GetRecoveryThresholds(), rule.RuleId, err)
+		return nil, err
+	}
+
+	ruleOverride := models.NewRuleOverride(
+		req.GetRuleId(),
+		req.GetResourceName(),
+		req.GetThresholds(),
+		req.GetRecoveryThresholds(),
+	)
+
+	err = rs.CreateRuleOverride(ctx, ruleOverride)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(ctx, "Create RuleOverride[%s] in DB successfully.", ruleOverride.OverrideId)
+
+	return &CreateRuleOverrideResponse{OverrideId: ruleOverride.OverrideId}, nil
+}
+
+func (s *Server) DescribeRuleOverrides(ctx context.Context, req *DescribeRuleOverridesRequest) (*DescribeRuleOverridesResponse, error) {
+	ros, roCnt, err := rs.DescribeRuleOverrides(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe RuleOverrides, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	roPbSet := models.ParseRoSet2PbSet(ros)
+	res := &DescribeRuleOverridesResponse{
+		Total:           uint32(roCnt),
+		RuleOverrideSet: roPbSet,
+	}
+
+	logger.Debug(ctx, "Describe RuleOverrides successfully, RuleOverrides=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) ModifyRuleOverride(ctx context.Context, req *ModifyRuleOverrideRequest) (*ModifyRuleOverrideResponse, error) {
+	err := ValidateModifyRuleOverrideParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleOverride := rs.GetRuleOverride(req.GetOverrideId())
+	rule := rs.GetRuleByRuleId(ruleOverride.RuleId)
+
+	if rule.RuleId == "" {
+		logger.Error(ctx, "Modify RuleOverride override_id [%s] does not exist.", req.GetOverrideId())
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorUpdateResourceFailed, req.GetOverrideId())
+	}
+
+	thresholds := req.GetThresholds()
+	if thresholds == "" {
+		thresholds = ruleOverride.Thresholds
+	}
+
+	err = checkRuleOverride(ctx, rule, thresholds, req.GetRecoveryThresholds())
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RuleOverride [%s %s] of Rule[%s]: %+v", req.GetThresholds(), req.GetRecoveryThresholds(), rule.RuleId, err)
+		return nil, err
+	}
+
+	overrideId, err := rs.ModifyRuleOverride(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Modify RuleOverride[%s], [%+v].", overrideId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, overrideId)
+	}
+	logger.Debug(ctx, "Modify RuleOverride[%s] successfully.", overrideId)
+	return &ModifyRuleOverrideResponse{
+		OverrideId: overrideId,
+	}, nil
+}
+
+func (s *Server) DeleteRuleOverrides(ctx context.Context, req *DeleteRuleOverridesRequest) (*DeleteRuleOverridesResponse, error) {
+	overrideIds, err := rs.DeleteRuleOverrides(ctx, stringutil.SimplifyStringList(req.OverrideId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Delete RuleOverrides[%+v], [%+v].", overrideIds, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, overrideIds)
+	}
+	logger.Debug(ctx, "Delete RuleOverrides[%+v] successfully.", overrideIds)
+	return &DeleteRuleOverridesResponse{
+		OverrideId: overrideIds,
+	}, nil
+}
+
+//11.Slo
+//********************************************************************************************************
+func (s *Server) CreateSlo(ctx context.Context, req *CreateSloRequest) (*CreateSloResponse, error) {
+	err := ValidateCreateSloParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := rs.GetPolicyByPolicyId(req.GetPolicyId())
+	if policy.PolicyId == "" {
+		logger.Error(ctx, "Create Slo policy_id [%s] does not exist.", req.GetPolicyId())
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorCreateResourcesFailed)
+	}
+
+	for _, metricId := range []string{req.GetGoodMetricId(), req.GetTotalMetricId()} {
+		metric := rs.GetMetricByMetricId(metricId)
+		if metric.MetricId == "" {
+			logger.Error(ctx, "Create Slo metric_id [%s] does not exist.", metricId)
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorCreateResourcesFailed)
+		}
+	}
+
+	slo := models.NewSlo(
+		req.GetSloName(),
+		req.GetTarget(),
+		req.GetWindowDays(),
+		req.GetGoodMetricId(),
+		req.GetTotalMetricId(),
+		req.GetBurnWindows(),
+		req.GetDisabled(),
+		req.GetPolicyId(),
+	)
+
+	err = rs.CreateSlo(ctx, slo)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(ctx, "Create Slo[%s] in DB successfully.", slo.SloId)
+
+	return &CreateSloResponse{SloId: slo.SloId}, nil
+}
+
+func (s *Server) DescribeSlos(ctx context.Context, req *DescribeSlosRequest) (*DescribeSlosResponse, error) {
+	sls, slCnt, err := rs.DescribeSlos(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe Slos, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	slPbSet := models.ParseSlSet2PbSet(sls)
+	res := &DescribeSlosResponse{
+		Total:  uint32(slCnt),
+		SloSet: slPbSet,
+	}
+
+	logger.Debug(ctx, "Describe Slos successfully, Slos=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) ModifySlo(ctx context.Context, req *ModifySloRequest) (*ModifySloResponse, error) {
+	err := ValidateModifySloParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	slo := rs.GetSlo(req.GetSloId())
+	if slo.SloId == "" {
+		logger.Error(ctx, "Modify Slo slo_id [%s] does not exist.", req.GetSloId())
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorUpdateResourceFailed, req.GetSloId())
+	}
+
+	for _, metricId := range []string{req.GetGoodMetricId(), req.GetTotalMetricId()} {
+		if metricId == "" {
+			continue
+		}
+		metric := rs.GetMetricByMetricId(metricId)
+		if metric.MetricId == "" {
+			logger.Error(ctx, "Modify Slo metric_id [%s] does not exist.", metricId)
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, nil, gerr.ErrorUpdateResourceFailed, req.GetSloId())
+		}
+	}
+
+	sloId, err := rs.ModifySlo(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Modify Slo[%s], [%+v].", sloId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, sloId)
+	}
+	logger.Debug(ctx, "Modify Slo[%s] successfully.", sloId)
+	return &ModifySloResponse{
+		SloId: sloId,
+	}, nil
+}
+
+func (s *Server) DeleteSlos(ctx context.Context, req *DeleteSlosRequest) (*DeleteSlosResponse, error) {
+	sloIds, err := rs.DeleteSlos(ctx, stringutil.SimplifyStringList(req.SloId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Delete Slos[%+v], [%+v].", sloIds, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, sloIds)
+	}
+	logger.Debug(ctx, "Delete Slos[%+v] successfully.", sloIds)
+	return &DeleteSlosResponse{
+		SloId: sloIds,
+	}, nil
+}
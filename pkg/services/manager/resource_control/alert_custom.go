@@ -223,6 +223,10 @@ type StatusResource struct {
 	NextResendInterval uint32          `json:next_resend_interval`
 	NextSendableTime   time.Time       `json:next_sendable_time`
 	AggregatedAlerts   AggregatedAlert `json:aggregated_alerts`
+	NoData             bool            `json:"no_data"`
+	NegativeCount      uint32          `json:"negative_count"`
+	Flapping           bool            `json:"flapping"`
+	Inhibited          bool            `json:"inhibited"`
 }
 
 type AggregatedAlert struct {
@@ -568,6 +572,10 @@ func DescribeAlertStatus(ctx context.Context, req *pb.DescribeAlertStatusRequest
 					resourceStatus.NextResendInterval = v.NextResendInterval
 					resourceStatus.NextSendableTime = v.NextSendableTime.Format("2006-01-02 15:04:05.99999")
 					resourceStatus.AggregatedAlerts = fmt.Sprintf("%v", v.AggregatedAlerts)
+					resourceStatus.NoData = v.NoData
+					resourceStatus.NegativeCount = v.NegativeCount
+					resourceStatus.Flapping = v.Flapping
+					resourceStatus.Inhibited = v.Inhibited
 					als_resource.Resources = append(als_resource.Resources, resourceStatus)
 				}
 			}
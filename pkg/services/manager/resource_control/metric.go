@@ -13,6 +13,17 @@ import (
 	"kubesphere.io/alert/pkg/util/stringutil"
 )
 
+//Get names of the metrics of the resource type of the policy
+func GetMetricNamesByPolicyId(policyId string) []string {
+	db := global.GetInstance().GetDB()
+	var metricNames []string
+	db.Table(models.TableMetric+" t1").
+		Joins("join "+models.TablePolicy+" t2 on t2.rs_type_id=t1.rs_type_id").
+		Where("t2."+models.PlColId+" = ?", policyId).
+		Pluck("t1."+models.MtColName, &metricNames)
+	return metricNames
+}
+
 func CreateMetric(ctx context.Context, metric *models.Metric) error {
 	db := global.GetInstance().GetDB()
 	tx := db.Begin()
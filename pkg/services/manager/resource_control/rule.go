@@ -34,6 +34,9 @@ func DescribeRules(ctx context.Context, req *pb.DescribeRulesRequest) ([]*models
 	req.ConditionType = stringutil.SimplifyStringList(req.ConditionType)
 	req.Thresholds = stringutil.SimplifyStringList(req.Thresholds)
 	req.Unit = stringutil.SimplifyStringList(req.Unit)
+	req.Aggregation = stringutil.SimplifyStringList(req.Aggregation)
+	req.NoDataBehavior = stringutil.SimplifyStringList(req.NoDataBehavior)
+	req.RecoveryThresholds = stringutil.SimplifyStringList(req.RecoveryThresholds)
 	req.PolicyId = stringutil.SimplifyStringList(req.PolicyId)
 	req.MetricId = stringutil.SimplifyStringList(req.MetricId)
 
@@ -63,9 +66,8 @@ func DescribeRules(ctx context.Context, req *pb.DescribeRulesRequest) ([]*models
 	return rss, count, nil
 }
 
-func ModifyRule(ctx context.Context, req *pb.ModifyRuleRequest) (string, error) {
-	ruleId := req.RuleId
-
+//Attributes of the rule modified by the request, fields of wrappers are modified only if present and can be cleared by empty values
+func getRuleModifyAttributes(req *pb.ModifyRuleRequest) map[string]interface{} {
 	attributes := make(map[string]interface{})
 
 	if req.RuleName != "" {
@@ -90,8 +92,56 @@ func ModifyRule(ctx context.Context, req *pb.ModifyRuleRequest) (string, error)
 	}
 	attributes[models.RlColConsecutiveCount] = req.ConsecutiveCount
 	attributes[models.RlColInhibit] = req.Inhibit
+	if req.Aggregation != "" {
+		attributes[models.RlColAggregation] = req.Aggregation
+	}
+	if req.NoDataBehavior != "" {
+		attributes[models.RlColNoDataBehavior] = req.NoDataBehavior
+	}
+	if req.RecoveryThresholds != nil {
+		attributes[models.RlColRecoveryThresholds] = req.RecoveryThresholds.GetValue()
+	}
+	//Consecutive recovery count 0 turns off hysteresis, so it is only modified when present
+	if req.ConsecutiveRecoveryCount != nil {
+		attributes[models.RlColConsecutiveRecoveryCount] = req.ConsecutiveRecoveryCount.GetValue()
+	}
+	if req.Levels != nil {
+		attributes[models.RlColLevels] = req.Levels.GetValue()
+	}
+	if req.ForecastHorizon != 0 {
+		attributes[models.RlColForecastHorizon] = req.ForecastHorizon
+	}
+	//Evaluation interval 0 evaluates every monitor periods, so it is only modified when present
+	if req.EvaluationInterval != nil {
+		attributes[models.RlColEvaluationInterval] = req.EvaluationInterval.GetValue()
+	}
+	if req.GroupCondition != nil {
+		attributes[models.RlColGroupCondition] = req.GroupCondition.GetValue()
+	}
+	if req.MetricExpression != nil {
+		attributes[models.RlColMetricExpression] = req.MetricExpression.GetValue()
+	}
+	if req.ThresholdSchedule != nil {
+		attributes[models.RlColThresholdSchedule] = req.ThresholdSchedule.GetValue()
+	}
+	if req.OffsetWindow != 0 {
+		attributes[models.RlColOffsetWindow] = req.OffsetWindow
+	} else if change, _ := models.SplitChangeCondition(req.ConditionType); req.ConditionType != "" && !models.IsOffsetChange(change) {
+		//Offset window is only kept for ratio and diff conditions
+		attributes[models.RlColOffsetWindow] = 0
+	}
+	if req.Script != nil {
+		attributes[models.RlColScript] = req.Script.GetValue()
+	}
 
 	attributes[models.RlColUpdateTime] = time.Now()
+	return attributes
+}
+
+func ModifyRule(ctx context.Context, req *pb.ModifyRuleRequest) (string, error) {
+	ruleId := req.RuleId
+
+	attributes := getRuleModifyAttributes(req)
 
 	db := global.GetInstance().GetDB()
 	tx := db.Begin()
@@ -118,6 +168,13 @@ func DeleteRules(ctx context.Context, ruleIds []string) ([]string, error) {
 		logger.Error(ctx, "DeleteRules Delete Rules failed: %+v", err.Error)
 		return nil, err.Error
 	}
+	var ruleOverride models.RuleOverride
+	err = tx.Model(&ruleOverride).Where(models.RoColRuleId+" in (?)", ruleIds).Delete(models.RuleOverride{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "DeleteRules Delete RuleOverrides failed: %+v", err.Error)
+		return nil, err.Error
+	}
 	tx.Commit()
 	return ruleIds, nil
 }
@@ -0,0 +1,127 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func GetRuleByRuleId(ruleId string) models.Rule {
+	db := global.GetInstance().GetDB()
+	var rule models.Rule
+	db.First(&rule, models.RlColId+" = ?", ruleId)
+	return rule
+}
+
+func GetRulesByPolicyId(policyId string) []models.Rule {
+	db := global.GetInstance().GetDB()
+	var rules []models.Rule
+	db.Find(&rules, models.RlColPolicyId+" = ?", policyId)
+	return rules
+}
+
+func GetRuleOverride(overrideId string) models.RuleOverride {
+	db := global.GetInstance().GetDB()
+	var ruleOverride models.RuleOverride
+	db.First(&ruleOverride, models.RoColId+" = ?", overrideId)
+	return ruleOverride
+}
+
+func HasRuleOverrides(ruleId string) bool {
+	db := global.GetInstance().GetDB()
+	var count uint64
+	db.Model(&models.RuleOverride{}).Where(models.RoColRuleId+" = ?", ruleId).Count(&count)
+	return count > 0
+}
+
+func CreateRuleOverride(ctx context.Context, ruleOverride *models.RuleOverride) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	err := tx.Create(&ruleOverride).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Insert RuleOverride failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func DescribeRuleOverrides(ctx context.Context, req *pb.DescribeRuleOverridesRequest) ([]*models.RuleOverride, uint64, error) {
+	req.OverrideId = stringutil.SimplifyStringList(req.OverrideId)
+	req.RuleId = stringutil.SimplifyStringList(req.RuleId)
+	req.ResourceName = stringutil.SimplifyStringList(req.ResourceName)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var ros []*models.RuleOverride
+	var count uint64
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableRuleOverride)).
+		AddQueryOrderDir(req, models.RoColCreateTime).
+		BuildFilterConditions(req, models.TableRuleOverride).
+		Offset(offset).
+		Limit(limit).
+		Find(&ros).Error; err != nil {
+		logger.Error(ctx, "Describe RuleOverrides failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableRuleOverride)).
+		BuildFilterConditions(req, models.TableRuleOverride).
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe RuleOverrides count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return ros, count, nil
+}
+
+func ModifyRuleOverride(ctx context.Context, req *pb.ModifyRuleOverrideRequest) (string, error) {
+	overrideId := req.OverrideId
+
+	attributes := make(map[string]interface{})
+
+	if req.Thresholds != "" {
+		attributes[models.RoColThresholds] = req.Thresholds
+	}
+	attributes[models.RoColRecoveryThresholds] = req.RecoveryThresholds
+
+	attributes[models.RoColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var ruleOverride models.RuleOverride
+	err := tx.Model(&ruleOverride).Where(models.RoColId+" = ?", overrideId).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update RuleOverride [%s] failed: %+v", overrideId, err.Error)
+		return "", err.Error
+	}
+
+	tx.Commit()
+	return overrideId, nil
+}
+
+func DeleteRuleOverrides(ctx context.Context, overrideIds []string) ([]string, error) {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var ruleOverride models.RuleOverride
+	err := tx.Model(&ruleOverride).Where(models.RoColId+" in (?)", overrideIds).Delete(models.RuleOverride{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Delete RuleOverrides failed: %+v", err.Error)
+		return nil, err.Error
+	}
+	tx.Commit()
+	return overrideIds, nil
+}
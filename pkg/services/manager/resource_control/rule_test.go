@@ -0,0 +1,40 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package resource_control
+
+import (
+	"testing"
+
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+func TestGetRuleModifyAttributesClear(t *testing.T) {
+	tests := []struct {
+		column string
+		req    *pb.ModifyRuleRequest
+	}{
+		{models.RlColRecoveryThresholds, &pb.ModifyRuleRequest{RecoveryThresholds: pbutil.ToProtoString("")}},
+		{models.RlColLevels, &pb.ModifyRuleRequest{Levels: pbutil.ToProtoString("")}},
+		{models.RlColGroupCondition, &pb.ModifyRuleRequest{GroupCondition: pbutil.ToProtoString("")}},
+		{models.RlColMetricExpression, &pb.ModifyRuleRequest{MetricExpression: pbutil.ToProtoString("")}},
+		{models.RlColThresholdSchedule, &pb.ModifyRuleRequest{ThresholdSchedule: pbutil.ToProtoString("")}},
+		{models.RlColScript, &pb.ModifyRuleRequest{Script: pbutil.ToProtoString("")}},
+	}
+
+	for _, test := range tests {
+		//Present empty value clears the column
+		value, ok := getRuleModifyAttributes(test.req)[test.column]
+		if !ok || value != "" {
+			t.Errorf("%s: expect cleared, got %v %v", test.column, ok, value)
+		}
+
+		//Absent value keeps the column
+		if value, ok := getRuleModifyAttributes(&pb.ModifyRuleRequest{})[test.column]; ok {
+			t.Errorf("%s: expect not modified, got %v", test.column, value)
+		}
+	}
+}
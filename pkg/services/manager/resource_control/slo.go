@@ -0,0 +1,135 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func GetMetricByMetricId(metricId string) models.Metric {
+	db := global.GetInstance().GetDB()
+	var metric models.Metric
+	db.First(&metric, models.MtColId+" = ?", metricId)
+	return metric
+}
+
+func GetPolicyByPolicyId(policyId string) models.Policy {
+	db := global.GetInstance().GetDB()
+	var policy models.Policy
+	db.First(&policy, models.PlColId+" = ?", policyId)
+	return policy
+}
+
+func GetSlo(sloId string) models.Slo {
+	db := global.GetInstance().GetDB()
+	var slo models.Slo
+	db.First(&slo, models.SlColId+" = ?", sloId)
+	return slo
+}
+
+func CreateSlo(ctx context.Context, slo *models.Slo) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	err := tx.Create(&slo).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Insert Slo failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func DescribeSlos(ctx context.Context, req *pb.DescribeSlosRequest) ([]*models.Slo, uint64, error) {
+	req.SloId = stringutil.SimplifyStringList(req.SloId)
+	req.SloName = stringutil.SimplifyStringList(req.SloName)
+	req.PolicyId = stringutil.SimplifyStringList(req.PolicyId)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var sls []*models.Slo
+	var count uint64
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableSlo)).
+		AddQueryOrderDir(req, models.SlColCreateTime).
+		BuildFilterConditions(req, models.TableSlo).
+		Offset(offset).
+		Limit(limit).
+		Find(&sls).Error; err != nil {
+		logger.Error(ctx, "Describe Slos failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableSlo)).
+		BuildFilterConditions(req, models.TableSlo).
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe Slos count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return sls, count, nil
+}
+
+func ModifySlo(ctx context.Context, req *pb.ModifySloRequest) (string, error) {
+	sloId := req.SloId
+
+	attributes := make(map[string]interface{})
+
+	if req.SloName != "" {
+		attributes[models.SlColName] = req.SloName
+	}
+	if req.Target != "" {
+		attributes[models.SlColTarget] = req.Target
+	}
+	if req.WindowDays != 0 {
+		attributes[models.SlColWindowDays] = req.WindowDays
+	}
+	if req.GoodMetricId != "" {
+		attributes[models.SlColGoodMetricId] = req.GoodMetricId
+	}
+	if req.TotalMetricId != "" {
+		attributes[models.SlColTotalMetricId] = req.TotalMetricId
+	}
+	if req.BurnWindows != "" {
+		attributes[models.SlColBurnWindows] = req.BurnWindows
+	}
+	attributes[models.SlColDisabled] = req.Disabled
+
+	attributes[models.SlColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var slo models.Slo
+	err := tx.Model(&slo).Where(models.SlColId+" = ?", sloId).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update Slo [%s] failed: %+v", sloId, err.Error)
+		return "", err.Error
+	}
+
+	tx.Commit()
+	return sloId, nil
+}
+
+func DeleteSlos(ctx context.Context, sloIds []string) ([]string, error) {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var slo models.Slo
+	err := tx.Model(&slo).Where(models.SlColId+" in (?)", sloIds).Delete(models.Slo{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Delete Slos failed: %+v", err.Error)
+		return nil, err.Error
+	}
+	tx.Commit()
+	return sloIds, nil
+}
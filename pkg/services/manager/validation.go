@@ -6,11 +6,20 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
 	"time"
 
+	"kubesphere.io/alert/pkg/config"
 	"kubesphere.io/alert/pkg/gerr"
 	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
 	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/exprutil"
+	"kubesphere.io/alert/pkg/util/scriptutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
 )
 
 func checkStringLen(ctx context.Context, str string, length int) error {
@@ -33,6 +42,545 @@ func checkTimeFormat(ctx context.Context, timeStr string) error {
 	}
 }
 
+func checkExpression(ctx context.Context, expression string) error {
+	expr, err := exprutil.Parse(expression)
+	if err == nil {
+		err = expr.CheckVariables(models.RuleExpressionValue)
+	}
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalExpression, expression)
+	}
+}
+
+//Thresholds of composite rules are at least two member rule ids
+func checkCompositeMembers(ctx context.Context, thresholds string) error {
+	memberIds := strings.Split(thresholds, models.CompositeRuleSeparator)
+	if len(memberIds) < 2 {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholds, thresholds)
+	}
+
+	for _, memberId := range memberIds {
+		if !strings.HasPrefix(memberId, models.RuleIdPrefix) || len(memberId) > 50 {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholds, thresholds)
+		}
+	}
+
+	return nil
+}
+
+//Members of composite rules are other non-composite rules of the same policy with the same evaluation interval,
+//the rule is checked together with the other rules of its policy for composite rules using it
+func checkCompositeRule(ctx context.Context, rule models.Rule, policyRules []models.Rule) error {
+	rules := map[string]models.Rule{rule.RuleId: rule}
+	for _, policyRule := range policyRules {
+		if policyRule.RuleId != rule.RuleId {
+			rules[policyRule.RuleId] = policyRule
+		}
+	}
+
+	for _, composite := range rules {
+		if !models.IsCompositeCondition(composite.ConditionType) {
+			continue
+		}
+		memberIds := strings.Split(composite.Thresholds, models.CompositeRuleSeparator)
+		if composite.RuleId != rule.RuleId && !stringutil.StringIn(rule.RuleId, memberIds) {
+			continue
+		}
+
+		interval := uint32(0)
+		for i, memberId := range memberIds {
+			member, ok := rules[memberId]
+			if !ok || models.IsCompositeCondition(member.ConditionType) {
+				return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholds, composite.Thresholds)
+			}
+
+			memberInterval := models.GetEvaluationInterval(member.EvaluationInterval, member.MonitorPeriods)
+			if i > 0 && memberInterval != interval {
+				return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColEvaluationInterval, strconv.FormatUint(uint64(memberInterval), 10))
+			}
+			interval = memberInterval
+		}
+	}
+
+	return nil
+}
+
+func checkRuleCondition(ctx context.Context, conditionType string, thresholds string) error {
+	switch conditionType {
+	case models.ConditionTypeGreaterEqual, models.ConditionTypeGreater, models.ConditionTypeLessEqual, models.ConditionTypeLess:
+		_, err := strconv.ParseFloat(thresholds, 64)
+		if err != nil {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholds, thresholds)
+		}
+	case models.ConditionTypeExpression:
+		return checkExpression(ctx, thresholds)
+	case models.ConditionTypeAnd, models.ConditionTypeOr:
+		return checkCompositeMembers(ctx, thresholds)
+	case models.ConditionTypeScript:
+		//Thresholds are passed to the script as they are
+	case models.ConditionTypeZScore, models.ConditionTypeMAD:
+		//Number of deviations from the baseline
+		k, err := strconv.ParseFloat(thresholds, 64)
+		if err != nil || k <= 0 {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholds, thresholds)
+		}
+	default:
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColConditionType, conditionType)
+	}
+
+	return nil
+}
+
+//Recovery thresholds must not be on the alerting side of thresholds
+func checkRecoveryThresholds(ctx context.Context, conditionType string, thresholds string, recoveryThresholds string) error {
+	if recoveryThresholds == "" {
+		return nil
+	}
+
+	//Composite rules recover when their members recover, script rules decide by themselves
+	if models.IsCompositeCondition(conditionType) || conditionType == models.ConditionTypeScript {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColRecoveryThresholds, recoveryThresholds)
+	}
+
+	err := checkRuleCondition(ctx, conditionType, recoveryThresholds)
+	if err != nil {
+		return err
+	}
+
+	threshold, err := strconv.ParseFloat(thresholds, 64)
+	if err != nil {
+		return nil
+	}
+	recoveryThreshold, err := strconv.ParseFloat(recoveryThresholds, 64)
+	if err != nil {
+		return nil
+	}
+
+	switch conditionType {
+	case models.ConditionTypeGreaterEqual, models.ConditionTypeGreater, models.ConditionTypeZScore, models.ConditionTypeMAD:
+		if recoveryThreshold > threshold {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColRecoveryThresholds, recoveryThresholds)
+		}
+	case models.ConditionTypeLessEqual, models.ConditionTypeLess:
+		if recoveryThreshold < threshold {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColRecoveryThresholds, recoveryThresholds)
+		}
+	}
+
+	return nil
+}
+
+//Levels are ordered from low to high severity, each with its own thresholds and a ranked severity above the last one
+func checkRuleLevels(ctx context.Context, conditionType string, thresholds string, severity string, levels string) error {
+	if levels == "" {
+		return nil
+	}
+
+	if models.IsCompositeCondition(conditionType) || conditionType == models.ConditionTypeScript {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+	}
+
+	ruleLevels := []models.RuleLevel{}
+	err := json.Unmarshal([]byte(levels), &ruleLevels)
+	if err != nil || len(ruleLevels) == 0 {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+	}
+
+	lastRank := models.SeverityRank[severity]
+	lastThreshold, err := strconv.ParseFloat(thresholds, 64)
+	ordered := err == nil
+	for _, level := range ruleLevels {
+		rank := models.SeverityRank[level.Severity]
+		if rank <= lastRank {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+		}
+		lastRank = rank
+
+		err = checkRuleCondition(ctx, conditionType, level.Thresholds)
+		if err != nil {
+			return err
+		}
+
+		threshold, err := strconv.ParseFloat(level.Thresholds, 64)
+		if err != nil {
+			ordered = false
+			continue
+		}
+		if ordered {
+			switch conditionType {
+			case models.ConditionTypeGreaterEqual, models.ConditionTypeGreater, models.ConditionTypeZScore, models.ConditionTypeMAD:
+				if threshold <= lastThreshold {
+					return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+				}
+			case models.ConditionTypeLessEqual, models.ConditionTypeLess:
+				if threshold >= lastThreshold {
+					return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+				}
+			}
+		}
+		lastThreshold = threshold
+		ordered = true
+	}
+
+	return nil
+}
+
+//Each window of the threshold schedule has valid thresholds and recovery thresholds of the condition type,
+//windows without recovery thresholds keep the recovery thresholds of the rule, rules with levels have no schedule
+func checkThresholdSchedule(ctx context.Context, conditionType string, recoveryThresholds string, levels string, thresholdSchedule string) error {
+	schedule, _, err := models.ParseThresholdSchedule(thresholdSchedule)
+	if err != nil || (schedule != nil && (models.IsCompositeCondition(conditionType) || levels != "")) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColThresholdSchedule, thresholdSchedule)
+	}
+	if schedule == nil || conditionType == "" {
+		return nil
+	}
+
+	for _, window := range schedule.Windows {
+		err = checkRuleCondition(ctx, conditionType, window.Thresholds)
+		if err != nil {
+			return err
+		}
+
+		windowRecoveryThresholds := window.RecoveryThresholds
+		if windowRecoveryThresholds == "" {
+			windowRecoveryThresholds = recoveryThresholds
+		}
+		err = checkRecoveryThresholds(ctx, conditionType, window.Thresholds, windowRecoveryThresholds)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Forecast horizon is at most 30 days, 0 means the default horizon
+func checkForecastHorizon(ctx context.Context, forecastHorizon uint32) error {
+	if forecastHorizon > 720 {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColForecastHorizon, strconv.FormatUint(uint64(forecastHorizon), 10))
+	}
+
+	return nil
+}
+
+//Script rules have a script defining the check function, which is compiled and run once within the limits
+func checkScript(ctx context.Context, conditionType string, script string) error {
+	if script == "" {
+		if conditionType == models.ConditionTypeScript {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, models.RlColScript)
+		}
+		return nil
+	}
+
+	if conditionType != "" && conditionType != models.ConditionTypeScript {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColConditionType, conditionType)
+	}
+
+	cfg := config.GetInstance()
+	limits := scriptutil.Limits{
+		MaxSteps: uint64(cfg.App.ScriptMaxSteps),
+		Timeout:  time.Duration(cfg.App.ScriptTimeoutMilliseconds) * time.Millisecond,
+	}
+	_, err := scriptutil.Compile(script, limits)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalScript, models.RlColScript)
+	}
+
+	return nil
+}
+
+//Offset window is between 1 minute and 30 days, 0 means no offset window
+func checkOffsetWindow(ctx context.Context, offsetWindow uint32) error {
+	if offsetWindow != 0 && (offsetWindow < 60 || offsetWindow > 2592000) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColOffsetWindow, strconv.FormatUint(uint64(offsetWindow), 10))
+	}
+
+	return nil
+}
+
+//Ratio and diff conditions need an offset window to compare with, other conditions have no offset window
+func checkOffsetChange(ctx context.Context, change string, offsetWindow uint32) error {
+	if models.IsOffsetChange(change) != (offsetWindow != 0) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColOffsetWindow, strconv.FormatUint(uint64(offsetWindow), 10))
+	}
+
+	return nil
+}
+
+//Slo target is a percentage of good events between 0 and 100 exclusive
+func checkSloTarget(ctx context.Context, target string) error {
+	_, err := models.ParseSloTarget(target)
+	if err != nil {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.SlColTarget, target)
+	}
+
+	return nil
+}
+
+//Slo window is at most 90 days, 0 means the default window
+func checkSloWindowDays(ctx context.Context, windowDays uint32) error {
+	if windowDays > 90 {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.SlColWindowDays, strconv.FormatUint(uint64(windowDays), 10))
+	}
+
+	return nil
+}
+
+//Each burn window has a short window less than its long window, a positive burn rate and a severity
+func checkBurnWindows(ctx context.Context, burnWindows string) error {
+	_, err := models.ParseBurnWindows(burnWindows)
+	if err != nil {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.SlColBurnWindows, burnWindows)
+	}
+
+	return nil
+}
+
+//Override thresholds are checked against the condition type of the rule,
+//rules with levels can not be overridden because overrides carry no thresholds of the levels
+func checkRuleOverride(ctx context.Context, rule models.Rule, thresholds string, recoveryThresholds string) error {
+	_, conditionType := models.SplitChangeCondition(rule.ConditionType)
+	if models.IsCompositeCondition(conditionType) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColConditionType, conditionType)
+	}
+	if rule.Levels != "" {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, rule.Levels)
+	}
+
+	if thresholds == "" {
+		thresholds = rule.Thresholds
+	}
+
+	err := checkRuleCondition(ctx, conditionType, thresholds)
+	if err != nil {
+		return err
+	}
+
+	return checkRecoveryThresholds(ctx, conditionType, thresholds, recoveryThresholds)
+}
+
+//Modified thresholds are checked against the condition type of the rule if the condition type is not modified, and the other way round,
+//levels can not be added to a rule with overrides
+func checkRuleModification(ctx context.Context, rule models.Rule, policyRules []models.Rule, hasOverrides bool, req *pb.ModifyRuleRequest) error {
+	modifiedRule := rule
+	if req.GetConditionType() != "" {
+		modifiedRule.ConditionType = req.GetConditionType()
+	}
+	if req.GetThresholds() != "" {
+		modifiedRule.Thresholds = req.GetThresholds()
+	}
+	modifiedRule.MonitorPeriods = req.GetMonitorPeriods()
+	if req.GetEvaluationInterval() != nil {
+		modifiedRule.EvaluationInterval = req.GetEvaluationInterval().GetValue()
+	}
+
+	err := checkCompositeRule(ctx, modifiedRule, policyRules)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate composite Rule[%s] [%s %s]: %+v", rule.RuleId, modifiedRule.ConditionType, modifiedRule.Thresholds, err)
+		return err
+	}
+
+	change, conditionType := models.SplitChangeCondition(modifiedRule.ConditionType)
+
+	//Offset window of the rule is kept only for ratio and diff conditions
+	offsetWindow := req.GetOffsetWindow()
+	if offsetWindow == 0 && models.IsOffsetChange(change) {
+		offsetWindow = rule.OffsetWindow
+	}
+	err = checkOffsetChange(ctx, change, offsetWindow)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate OffsetWindow [%d] of condition [%s]: %+v", offsetWindow, modifiedRule.ConditionType, err)
+		return err
+	}
+
+	thresholds := modifiedRule.Thresholds
+	recoveryThresholds := rule.RecoveryThresholds
+	if req.GetRecoveryThresholds() != nil {
+		recoveryThresholds = req.GetRecoveryThresholds().GetValue()
+	}
+	severity := req.GetSeverity()
+	if severity == "" {
+		severity = rule.Severity
+	}
+	levels := rule.Levels
+	if req.GetLevels() != nil {
+		levels = req.GetLevels().GetValue()
+	}
+	thresholdSchedule := rule.ThresholdSchedule
+	if req.GetThresholdSchedule() != nil {
+		thresholdSchedule = req.GetThresholdSchedule().GetValue()
+	}
+
+	err = checkRuleCondition(ctx, conditionType, thresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Condition [%s %s]: %+v", conditionType, thresholds, err)
+		return err
+	}
+
+	err = checkRecoveryThresholds(ctx, conditionType, thresholds, recoveryThresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s %s]: %+v", conditionType, recoveryThresholds, err)
+		return err
+	}
+
+	err = checkRuleLevels(ctx, conditionType, thresholds, severity, levels)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+	if levels != "" && hasOverrides {
+		logger.Error(ctx, "Failed to validate Levels [%s]: Rule[%s] has overrides", levels, rule.RuleId)
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColLevels, levels)
+	}
+
+	err = checkThresholdSchedule(ctx, conditionType, recoveryThresholds, levels, thresholdSchedule)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ThresholdSchedule [%s]: %+v", thresholdSchedule, err)
+		return err
+	}
+
+	groupCondition := rule.GroupCondition
+	if req.GetGroupCondition() != nil {
+		groupCondition = req.GetGroupCondition().GetValue()
+	}
+	err = checkGroupCondition(ctx, conditionType, groupCondition)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate GroupCondition [%s]: %+v", groupCondition, err)
+		return err
+	}
+
+	metricExpression := rule.MetricExpression
+	if req.GetMetricExpression() != nil {
+		metricExpression = req.GetMetricExpression().GetValue()
+	}
+	err = checkMetricExpression(ctx, conditionType, metricExpression)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricExpression [%s]: %+v", metricExpression, err)
+		return err
+	}
+
+	script := rule.Script
+	if req.GetScript() != nil {
+		script = req.GetScript().GetValue()
+	}
+	err = checkScript(ctx, conditionType, script)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Script [%s]: %+v", script, err)
+		return err
+	}
+
+	return nil
+}
+
+//Flap detection of each severity of the policy config is disabled by 0 for both fields,
+//or looks back at most one day for more than one transition
+func checkPolicyConfig(ctx context.Context, policyConfig string) error {
+	if policyConfig == "" {
+		return nil
+	}
+
+	severityConfigs := make(map[string]struct {
+		FlapWindowMinutes   uint32 `json:"flap_window_minutes"`
+		FlapTransitionLimit uint32 `json:"flap_transition_limit"`
+	})
+	err := json.Unmarshal([]byte(policyConfig), &severityConfigs)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, models.PlColConfig, policyConfig)
+	}
+
+	for _, severityConfig := range severityConfigs {
+		if (severityConfig.FlapWindowMinutes == 0) != (severityConfig.FlapTransitionLimit == 0) ||
+			severityConfig.FlapWindowMinutes > 1440 || severityConfig.FlapTransitionLimit == 1 {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.PlColConfig, policyConfig)
+		}
+	}
+
+	return nil
+}
+
+//Group condition compares the count or ratio of violating resources, composite rules have no resources of their own
+func checkGroupCondition(ctx context.Context, conditionType string, groupCondition string) error {
+	if groupCondition == "" {
+		return nil
+	}
+
+	_, err := models.ParseGroupCondition(groupCondition)
+	if err != nil || models.IsCompositeCondition(conditionType) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColGroupCondition, groupCondition)
+	}
+
+	return nil
+}
+
+//Metric expression is an arithmetic expression of at least two metric names, composite rules have no metric
+func checkMetricExpression(ctx context.Context, conditionType string, metricExpression string) error {
+	if metricExpression == "" {
+		return nil
+	}
+
+	expr, err := exprutil.Parse(metricExpression)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalExpression, metricExpression)
+	}
+
+	if len(expr.Variables()) < 2 || models.IsCompositeCondition(conditionType) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColMetricExpression, metricExpression)
+	}
+
+	return nil
+}
+
+//Variables of the metric expression are names of metrics of the resource type of the rule
+func checkMetricExpressionVariables(ctx context.Context, metricExpression string, metricNames []string) error {
+	if metricExpression == "" {
+		return nil
+	}
+
+	expr, err := exprutil.Parse(metricExpression)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalExpression, metricExpression)
+	}
+
+	for _, variable := range expr.Variables() {
+		if !stringutil.StringIn(variable, metricNames) {
+			return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColMetricExpression, variable)
+		}
+	}
+
+	return nil
+}
+
+//Evaluation interval is between 10 seconds and 1 day, 0 means monitor periods minutes
+func checkEvaluationInterval(ctx context.Context, evaluationInterval uint32) error {
+	if evaluationInterval != 0 && (evaluationInterval < 10 || evaluationInterval > 86400) {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColEvaluationInterval, strconv.FormatUint(uint64(evaluationInterval), 10))
+	}
+
+	return nil
+}
+
+func checkAggregation(ctx context.Context, aggregation string) error {
+	_, err := metric.ParseAggregation(aggregation)
+	if err != nil {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, models.RlColAggregation, aggregation)
+	}
+
+	return nil
+}
+
+func checkNoDataBehavior(ctx context.Context, noDataBehavior string) error {
+	switch noDataBehavior {
+	case "", models.NoDataBehaviorKeep, models.NoDataBehaviorAlert, models.NoDataBehaviorResolve:
+		return nil
+	}
+
+	return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, models.RlColNoDataBehavior, noDataBehavior)
+}
+
 func ValidateCreateResourceTypeParams(ctx context.Context, req *pb.CreateResourceTypeRequest) error {
 	rsTypeName := req.GetRsTypeName()
 	err := checkStringLen(ctx, rsTypeName, 50)
@@ -149,6 +697,13 @@ func ValidateCreatePolicyParams(ctx context.Context, req *pb.CreatePolicyRequest
 		return err
 	}
 
+	policyConfig := req.GetPolicyConfig()
+	err = checkPolicyConfig(ctx, policyConfig)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate PolicyConfig [%s]: %+v", policyConfig, err)
+		return err
+	}
+
 	creator := req.GetCreator()
 	err = checkStringLen(ctx, creator, 50)
 	if err != nil {
@@ -209,6 +764,13 @@ func ValidateModifyPolicyParams(ctx context.Context, req *pb.ModifyPolicyRequest
 		return err
 	}
 
+	policyConfig := req.GetPolicyConfig()
+	err = checkPolicyConfig(ctx, policyConfig)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate PolicyConfig [%s]: %+v", policyConfig, err)
+		return err
+	}
+
 	creator := req.GetCreator()
 	err = checkStringLen(ctx, creator, 50)
 	if err != nil {
@@ -276,13 +838,109 @@ func ValidateCreateRuleParams(ctx context.Context, req *pb.CreateRuleRequest) er
 		return err
 	}
 
+	//Change conditions are checked like their comparison
+	change, conditionType := models.SplitChangeCondition(conditionType)
+
 	thresholds := req.GetThresholds()
-	err = checkStringLen(ctx, thresholds, 50)
+	err = checkStringLen(ctx, thresholds, 255)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate Thresholds [%s]: %+v", thresholds, err)
 		return err
 	}
 
+	err = checkRuleCondition(ctx, conditionType, thresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Condition [%s %s]: %+v", conditionType, thresholds, err)
+		return err
+	}
+
+	recoveryThresholds := req.GetRecoveryThresholds()
+	err = checkStringLen(ctx, recoveryThresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	err = checkRecoveryThresholds(ctx, conditionType, thresholds, recoveryThresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s %s]: %+v", conditionType, recoveryThresholds, err)
+		return err
+	}
+
+	levels := req.GetLevels()
+	err = checkStringLen(ctx, levels, 1024)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
+	err = checkRuleLevels(ctx, conditionType, thresholds, severity, levels)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
+	forecastHorizon := req.GetForecastHorizon()
+	err = checkForecastHorizon(ctx, forecastHorizon)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ForecastHorizon [%d]: %+v", forecastHorizon, err)
+		return err
+	}
+
+	evaluationInterval := req.GetEvaluationInterval()
+	err = checkEvaluationInterval(ctx, evaluationInterval)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate EvaluationInterval [%d]: %+v", evaluationInterval, err)
+		return err
+	}
+
+	offsetWindow := req.GetOffsetWindow()
+	err = checkOffsetWindow(ctx, offsetWindow)
+	if err == nil {
+		err = checkOffsetChange(ctx, change, offsetWindow)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate OffsetWindow [%d]: %+v", offsetWindow, err)
+		return err
+	}
+
+	groupCondition := req.GetGroupCondition()
+	err = checkGroupCondition(ctx, conditionType, groupCondition)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate GroupCondition [%s]: %+v", groupCondition, err)
+		return err
+	}
+
+	metricExpression := req.GetMetricExpression()
+	err = checkStringLen(ctx, metricExpression, 255)
+	if err == nil {
+		err = checkMetricExpression(ctx, conditionType, metricExpression)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricExpression [%s]: %+v", metricExpression, err)
+		return err
+	}
+
+	thresholdSchedule := req.GetThresholdSchedule()
+	err = checkStringLen(ctx, thresholdSchedule, 2048)
+	if err == nil {
+		err = checkThresholdSchedule(ctx, conditionType, recoveryThresholds, levels, thresholdSchedule)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ThresholdSchedule [%s]: %+v", thresholdSchedule, err)
+		return err
+	}
+
+	script := req.GetScript()
+	err = checkStringLen(ctx, script, 4096)
+	if err == nil {
+		err = checkScript(ctx, conditionType, script)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Script [%s]: %+v", script, err)
+		return err
+	}
+
 	unit := req.GetUnit()
 	err = checkStringLen(ctx, unit, 50)
 	if err != nil {
@@ -290,6 +948,26 @@ func ValidateCreateRuleParams(ctx context.Context, req *pb.CreateRuleRequest) er
 		return err
 	}
 
+	aggregation := req.GetAggregation()
+	err = checkStringLen(ctx, aggregation, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Aggregation [%s]: %+v", aggregation, err)
+		return err
+	}
+
+	err = checkAggregation(ctx, aggregation)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Aggregation [%s]: %+v", aggregation, err)
+		return err
+	}
+
+	noDataBehavior := req.GetNoDataBehavior()
+	err = checkNoDataBehavior(ctx, noDataBehavior)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate NoDataBehavior [%s]: %+v", noDataBehavior, err)
+		return err
+	}
+
 	policyId := req.GetPolicyId()
 	err = checkStringLen(ctx, policyId, 50)
 	if err != nil {
@@ -343,13 +1021,76 @@ func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest) er
 		return err
 	}
 
+	//Change conditions are checked like their comparison
+	_, conditionType = models.SplitChangeCondition(conditionType)
+
 	thresholds := req.GetThresholds()
-	err = checkStringLen(ctx, thresholds, 50)
+	err = checkStringLen(ctx, thresholds, 255)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate Thresholds [%s]: %+v", thresholds, err)
 		return err
 	}
 
+	recoveryThresholds := req.GetRecoveryThresholds().GetValue()
+	err = checkStringLen(ctx, recoveryThresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	levels := req.GetLevels().GetValue()
+	err = checkStringLen(ctx, levels, 1024)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
+	forecastHorizon := req.GetForecastHorizon()
+	err = checkForecastHorizon(ctx, forecastHorizon)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ForecastHorizon [%d]: %+v", forecastHorizon, err)
+		return err
+	}
+
+	evaluationInterval := req.GetEvaluationInterval().GetValue()
+	err = checkEvaluationInterval(ctx, evaluationInterval)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate EvaluationInterval [%d]: %+v", evaluationInterval, err)
+		return err
+	}
+
+	offsetWindow := req.GetOffsetWindow()
+	err = checkOffsetWindow(ctx, offsetWindow)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate OffsetWindow [%d]: %+v", offsetWindow, err)
+		return err
+	}
+
+	//Group condition, metric expression and script are checked against the condition type of the modified rule
+	metricExpression := req.GetMetricExpression().GetValue()
+	err = checkStringLen(ctx, metricExpression, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricExpression [%s]: %+v", metricExpression, err)
+		return err
+	}
+
+	thresholdSchedule := req.GetThresholdSchedule().GetValue()
+	err = checkStringLen(ctx, thresholdSchedule, 2048)
+	if err == nil {
+		err = checkThresholdSchedule(ctx, conditionType, recoveryThresholds, levels, thresholdSchedule)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ThresholdSchedule [%s]: %+v", thresholdSchedule, err)
+		return err
+	}
+
+	script := req.GetScript().GetValue()
+	err = checkStringLen(ctx, script, 4096)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Script [%s]: %+v", script, err)
+		return err
+	}
+
 	unit := req.GetUnit()
 	err = checkStringLen(ctx, unit, 50)
 	if err != nil {
@@ -357,6 +1098,26 @@ func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest) er
 		return err
 	}
 
+	aggregation := req.GetAggregation()
+	err = checkStringLen(ctx, aggregation, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Aggregation [%s]: %+v", aggregation, err)
+		return err
+	}
+
+	err = checkAggregation(ctx, aggregation)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Aggregation [%s]: %+v", aggregation, err)
+		return err
+	}
+
+	noDataBehavior := req.GetNoDataBehavior()
+	err = checkNoDataBehavior(ctx, noDataBehavior)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate NoDataBehavior [%s]: %+v", noDataBehavior, err)
+		return err
+	}
+
 	return nil
 }
 
@@ -526,3 +1287,141 @@ func ValidateModifyActionParams(ctx context.Context, req *pb.ModifyActionRequest
 
 	return nil
 }
+
+func ValidateCreateRuleOverrideParams(ctx context.Context, req *pb.CreateRuleOverrideRequest) error {
+	ruleId := req.GetRuleId()
+	err := checkStringLen(ctx, ruleId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RuleId [%s]: %+v", ruleId, err)
+		return err
+	}
+
+	resourceName := req.GetResourceName()
+	err = checkStringLen(ctx, resourceName, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ResourceName [%s]: %+v", resourceName, err)
+		return err
+	}
+
+	thresholds := req.GetThresholds()
+	err = checkStringLen(ctx, thresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Thresholds [%s]: %+v", thresholds, err)
+		return err
+	}
+
+	recoveryThresholds := req.GetRecoveryThresholds()
+	err = checkStringLen(ctx, recoveryThresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateModifyRuleOverrideParams(ctx context.Context, req *pb.ModifyRuleOverrideRequest) error {
+	overrideId := req.GetOverrideId()
+	err := checkStringLen(ctx, overrideId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate OverrideId [%s]: %+v", overrideId, err)
+		return err
+	}
+
+	thresholds := req.GetThresholds()
+	err = checkStringLen(ctx, thresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Thresholds [%s]: %+v", thresholds, err)
+		return err
+	}
+
+	recoveryThresholds := req.GetRecoveryThresholds()
+	err = checkStringLen(ctx, recoveryThresholds, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateCreateSloParams(ctx context.Context, req *pb.CreateSloRequest) error {
+	sloName := req.GetSloName()
+	err := checkStringLen(ctx, sloName, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SloName [%s]: %+v", sloName, err)
+		return err
+	}
+
+	target := req.GetTarget()
+	err = checkSloTarget(ctx, target)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Target [%s]: %+v", target, err)
+		return err
+	}
+
+	windowDays := req.GetWindowDays()
+	err = checkSloWindowDays(ctx, windowDays)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate WindowDays [%d]: %+v", windowDays, err)
+		return err
+	}
+
+	burnWindows := req.GetBurnWindows()
+	err = checkStringLen(ctx, burnWindows, 1024)
+	if err == nil {
+		err = checkBurnWindows(ctx, burnWindows)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to validate BurnWindows [%s]: %+v", burnWindows, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateModifySloParams(ctx context.Context, req *pb.ModifySloRequest) error {
+	sloId := req.GetSloId()
+	err := checkStringLen(ctx, sloId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SloId [%s]: %+v", sloId, err)
+		return err
+	}
+
+	sloName := req.GetSloName()
+	err = checkStringLen(ctx, sloName, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SloName [%s]: %+v", sloName, err)
+		return err
+	}
+
+	target := req.GetTarget()
+	if target != "" {
+		err = checkSloTarget(ctx, target)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate Target [%s]: %+v", target, err)
+			return err
+		}
+	}
+
+	windowDays := req.GetWindowDays()
+	err = checkSloWindowDays(ctx, windowDays)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate WindowDays [%d]: %+v", windowDays, err)
+		return err
+	}
+
+	burnWindows := req.GetBurnWindows()
+	if burnWindows != "" {
+		err = checkStringLen(ctx, burnWindows, 1024)
+		if err == nil {
+			err = checkBurnWindows(ctx, burnWindows)
+		}
+		if err != nil {
+			logger.Error(ctx, "Failed to validate BurnWindows [%s]: %+v", burnWindows, err)
+			return err
+		}
+	}
+
+	return nil
+}
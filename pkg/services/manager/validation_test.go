@@ -0,0 +1,200 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+func TestCheckCompositeRule(t *testing.T) {
+	policyRules := []models.Rule{
+		{RuleId: "rl-cpu", ConditionType: models.ConditionTypeGreater, EvaluationInterval: 60},
+		{RuleId: "rl-mem", ConditionType: models.ConditionTypeGreater, MonitorPeriods: 1},
+		{RuleId: "rl-disk", ConditionType: models.ConditionTypeGreater, EvaluationInterval: 300},
+		{RuleId: "rl-and", ConditionType: models.ConditionTypeAnd, Thresholds: "rl-cpu|rl-mem"},
+	}
+
+	tests := []struct {
+		name      string
+		rule      models.Rule
+		expectErr bool
+	}{
+		{"valid members", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-mem"}, false},
+		{"missing member", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-gone"}, true},
+		{"member of other policy", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-other"}, true},
+		{"composite member", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-and"}, true},
+		{"self member", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-or"}, true},
+		{"members with different intervals", models.Rule{RuleId: "rl-or", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-disk"}, true},
+		{"member modified to other interval", models.Rule{RuleId: "rl-mem", ConditionType: models.ConditionTypeGreater, EvaluationInterval: 120}, true},
+		{"member modified to composite", models.Rule{RuleId: "rl-mem", ConditionType: models.ConditionTypeOr, Thresholds: "rl-cpu|rl-disk"}, true},
+		{"rule not used by composite modified", models.Rule{RuleId: "rl-disk", ConditionType: models.ConditionTypeGreater, EvaluationInterval: 120}, false},
+	}
+
+	for _, test := range tests {
+		err := checkCompositeRule(context.Background(), test.rule, policyRules)
+		if (err != nil) != test.expectErr {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckMetricExpressionVariables(t *testing.T) {
+	metricNames := []string{"node_memory_used", "node_memory_total", "node_cpu_utilisation"}
+
+	tests := []struct {
+		metricExpression string
+		expectErr        bool
+	}{
+		{"", false},
+		{"node_memory_used / node_memory_total", false},
+		{"node_memory_used / node_memory_available", true},
+		{"pod_memory_usage / node_memory_total", true},
+		{"node_memory_used /", true},
+	}
+
+	for _, test := range tests {
+		err := checkMetricExpressionVariables(context.Background(), test.metricExpression, metricNames)
+		if (err != nil) != test.expectErr {
+			t.Errorf("checkMetricExpressionVariables [%s] expect error %v, got %v", test.metricExpression, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckOffsetChange(t *testing.T) {
+	tests := []struct {
+		change       string
+		offsetWindow uint32
+		expectErr    bool
+	}{
+		{models.ConditionChangeRatio, 3600, false},
+		{models.ConditionChangeDiff, 3600, false},
+		{models.ConditionChangeRatio, 0, true},
+		{"", 0, false},
+		{"", 3600, true},
+		{models.ConditionChangeDelta, 3600, true},
+		{models.ConditionChangeForecast, 3600, true},
+	}
+
+	for _, test := range tests {
+		err := checkOffsetChange(context.Background(), test.change, test.offsetWindow)
+		if (err != nil) != test.expectErr {
+			t.Errorf("checkOffsetChange [%s %d] expect error %v, got %v", test.change, test.offsetWindow, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckRuleOverrideLevels(t *testing.T) {
+	levels := `[{"thresholds":"0.95","severity":"critical"}]`
+	tests := []struct {
+		name      string
+		rule      models.Rule
+		expectErr bool
+	}{
+		{"rule without levels", models.Rule{RuleId: "rl-cpu", ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Severity: models.SeverityMinor}, false},
+		{"rule with levels", models.Rule{RuleId: "rl-cpu", ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Severity: models.SeverityMinor, Levels: levels}, true},
+	}
+
+	for _, test := range tests {
+		err := checkRuleOverride(context.Background(), test.rule, "0.5", "")
+		if (err != nil) != test.expectErr {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckRuleModificationLevels(t *testing.T) {
+	levels := `[{"thresholds":"0.95","severity":"critical"}]`
+	rule := models.Rule{RuleId: "rl-cpu", ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Severity: models.SeverityMinor}
+	leveledRule := rule
+	leveledRule.Levels = levels
+
+	tests := []struct {
+		name         string
+		rule         models.Rule
+		hasOverrides bool
+		req          *pb.ModifyRuleRequest
+		expectErr    bool
+	}{
+		{"levels added without overrides", rule, false, &pb.ModifyRuleRequest{Levels: pbutil.ToProtoString(levels)}, false},
+		{"levels added with overrides", rule, true, &pb.ModifyRuleRequest{Levels: pbutil.ToProtoString(levels)}, true},
+		{"rule with overrides modified", rule, true, &pb.ModifyRuleRequest{Thresholds: "0.8"}, false},
+		{"rule with levels modified", leveledRule, false, &pb.ModifyRuleRequest{Thresholds: "0.8"}, false},
+	}
+
+	for _, test := range tests {
+		err := checkRuleModification(context.Background(), test.rule, []models.Rule{test.rule}, test.hasOverrides, test.req)
+		if (err != nil) != test.expectErr {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckThresholdSchedule(t *testing.T) {
+	levels := `[{"thresholds":"0.95","severity":"critical"}]`
+	scheduleWithRecovery := `{"timezone":"UTC","windows":[{"start":"22:00","end":"06:00","thresholds":"0.7","recovery_thresholds":"0.6"}]}`
+	scheduleWithoutRecovery := `{"timezone":"UTC","windows":[{"start":"22:00","end":"06:00","thresholds":"0.7"}]}`
+
+	tests := []struct {
+		name               string
+		recoveryThresholds string
+		levels             string
+		thresholdSchedule  string
+		expectErr          bool
+	}{
+		{"window with recovery", "0.8", "", scheduleWithRecovery, false},
+		{"window without recovery", "", "", scheduleWithoutRecovery, false},
+		{"window keeps valid rule recovery", "0.65", "", scheduleWithoutRecovery, false},
+		{"window keeps invalid rule recovery", "0.8", "", scheduleWithoutRecovery, true},
+		{"rule with levels", "", levels, scheduleWithRecovery, true},
+		{"rule with levels without schedule", "", levels, "", false},
+	}
+
+	for _, test := range tests {
+		err := checkThresholdSchedule(context.Background(), models.ConditionTypeGreater, test.recoveryThresholds, test.levels, test.thresholdSchedule)
+		if (err != nil) != test.expectErr {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
+
+func TestCheckRuleModificationConditionType(t *testing.T) {
+	script := "def check(samples, status, thresholds):\n    return True\n"
+	cpuRule := models.Rule{RuleId: "rl-cpu", ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Severity: models.SeverityMinor}
+	memRule := models.Rule{RuleId: "rl-mem", ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Severity: models.SeverityMinor}
+	andRule := models.Rule{RuleId: "rl-and", ConditionType: models.ConditionTypeAnd, Thresholds: "rl-cpu|rl-mem", Severity: models.SeverityMinor}
+	scriptRule := models.Rule{RuleId: "rl-script", ConditionType: models.ConditionTypeScript, Severity: models.SeverityMinor, Script: script}
+	policyRules := []models.Rule{cpuRule, memRule, andRule, scriptRule}
+
+	tests := []struct {
+		name      string
+		rule      models.Rule
+		req       *pb.ModifyRuleRequest
+		expectErr bool
+	}{
+		{"group condition of rule", cpuRule, &pb.ModifyRuleRequest{GroupCondition: pbutil.ToProtoString(">3")}, false},
+		{"group condition of composite rule", andRule, &pb.ModifyRuleRequest{GroupCondition: pbutil.ToProtoString(">3")}, true},
+		{"metric expression of rule", cpuRule, &pb.ModifyRuleRequest{MetricExpression: pbutil.ToProtoString("cpu_used / cpu_total")}, false},
+		{"metric expression of composite rule", andRule, &pb.ModifyRuleRequest{MetricExpression: pbutil.ToProtoString("cpu_used / cpu_total")}, true},
+		{"script of script rule", scriptRule, &pb.ModifyRuleRequest{Script: pbutil.ToProtoString(script)}, false},
+		{"script of other rule", cpuRule, &pb.ModifyRuleRequest{Script: pbutil.ToProtoString(script)}, true},
+		{"script of script rule cleared", scriptRule, &pb.ModifyRuleRequest{Script: pbutil.ToProtoString("")}, true},
+		{"script rule modified to other condition", scriptRule, &pb.ModifyRuleRequest{ConditionType: models.ConditionTypeGreater, Thresholds: "0.9"}, true},
+		{"script rule modified to other condition with script cleared", scriptRule, &pb.ModifyRuleRequest{ConditionType: models.ConditionTypeGreater, Thresholds: "0.9", Script: pbutil.ToProtoString("")}, false},
+		{"rule modified to script condition without script", cpuRule, &pb.ModifyRuleRequest{ConditionType: models.ConditionTypeScript}, true},
+		{"rule modified to script condition with script", cpuRule, &pb.ModifyRuleRequest{ConditionType: models.ConditionTypeScript, Script: pbutil.ToProtoString(script)}, false},
+	}
+
+	for _, test := range tests {
+		err := checkRuleModification(context.Background(), test.rule, policyRules, false, test.req)
+		if (err != nil) != test.expectErr {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
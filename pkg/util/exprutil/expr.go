@@ -0,0 +1,437 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package exprutil
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed arithmetic/boolean expression such as
+// `value > 80 && value < 95` or `abs(value - 50) > 10`.
+// Boolean results are represented as 1 (true) and 0 (false).
+type Expr struct {
+	source string
+	root   node
+	vars   map[string]bool
+}
+
+type node interface {
+	eval(vars map[string]float64) (float64, error)
+}
+
+type numberNode float64
+
+type varNode string
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+type function struct {
+	minArgs int
+	maxArgs int
+	call    func(args []float64) float64
+}
+
+var functions = map[string]function{
+	"abs":   {1, 1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"sqrt":  {1, 1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"log":   {1, 1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"ceil":  {1, 1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"floor": {1, 1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"round": {1, 1, func(a []float64) float64 { return math.Round(a[0]) }},
+	"pow":   {2, 2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+	"min": {1, -1, func(a []float64) float64 {
+		m := a[0]
+		for _, v := range a[1:] {
+			m = math.Min(m, v)
+		}
+		return m
+	}},
+	"max": {1, -1, func(a []float64) float64 {
+		m := a[0]
+		for _, v := range a[1:] {
+			m = math.Max(m, v)
+		}
+		return m
+	}},
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (n numberNode) eval(vars map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+func (n varNode) eval(vars map[string]float64) (float64, error) {
+	v, ok := vars[string(n)]
+	if !ok {
+		return 0, fmt.Errorf("undefined variable [%s]", string(n))
+	}
+	return v, nil
+}
+
+func (n *unaryNode) eval(vars map[string]float64) (float64, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case "-":
+		return -v, nil
+	case "!":
+		return boolToFloat(v == 0), nil
+	}
+	return v, nil
+}
+
+func (n *binaryNode) eval(vars map[string]float64) (float64, error) {
+	l, err := n.left.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	//Short-circuit logical operators
+	switch n.op {
+	case "&&":
+		if l == 0 {
+			return 0, nil
+		}
+	case "||":
+		if l != 0 {
+			return 1, nil
+		}
+	}
+
+	r, err := n.right.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return boolToFloat(r != 0), nil
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		return l / r, nil
+	case "%":
+		return math.Mod(l, r), nil
+	case ">":
+		return boolToFloat(l > r), nil
+	case ">=":
+		return boolToFloat(l >= r), nil
+	case "<":
+		return boolToFloat(l < r), nil
+	case "<=":
+		return boolToFloat(l <= r), nil
+	case "==":
+		return boolToFloat(l == r), nil
+	case "!=":
+		return boolToFloat(l != r), nil
+	}
+	return 0, fmt.Errorf("unknown operator [%s]", n.op)
+}
+
+func (n *callNode) eval(vars map[string]float64) (float64, error) {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+	return functions[n.name].call(args), nil
+}
+
+type token struct {
+	kind string //num, ident, op, eof
+	text string
+	pos  int
+}
+
+func tokenize(s string) ([]token, error) {
+	tokens := []token{}
+	i := 0
+	for i < len(s) {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(s) && (unicode.IsDigit(rune(s[i])) || s[i] == '.') {
+				i++
+			}
+			//Exponent, e.g. 1e9 or 2.5E-3
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				j := i + 1
+				if j < len(s) && (s[j] == '+' || s[j] == '-') {
+					j++
+				}
+				if j < len(s) && unicode.IsDigit(rune(s[j])) {
+					i = j
+					for i < len(s) && unicode.IsDigit(rune(s[i])) {
+						i++
+					}
+				}
+			}
+			tokens = append(tokens, token{"num", s[start:i], start})
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(s) && (unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i])) || s[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{"ident", s[start:i], start})
+		default:
+			two := ""
+			if i+1 < len(s) {
+				two = s[i : i+2]
+			}
+			switch two {
+			case ">=", "<=", "==", "!=", "&&", "||":
+				tokens = append(tokens, token{"op", two, i})
+				i += 2
+				continue
+			}
+			switch c {
+			case '+', '-', '*', '/', '%', '>', '<', '!', '(', ')', ',':
+				tokens = append(tokens, token{"op", string(c), i})
+				i++
+			default:
+				return nil, fmt.Errorf("unexpected character [%c] at %d", c, i)
+			}
+		}
+	}
+	tokens = append(tokens, token{"eof", "", len(s)})
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	vars   map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != "eof" {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != "op" {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) parseBinary(level int) (node, error) {
+	levels := [][]string{
+		{"||"},
+		{"&&"},
+		{"==", "!="},
+		{">", ">=", "<", "<="},
+		{"+", "-"},
+		{"*", "/", "%"},
+	}
+	if level == len(levels) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(levels[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op, left, right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if op, ok := p.accept("-", "!", "+"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op, operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case "num":
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("illegal number [%s] at %d", t.text, t.pos)
+		}
+		return numberNode(v), nil
+	case "ident":
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(t)
+		}
+		p.vars[t.text] = true
+		return varNode(t.text), nil
+	case "op":
+		if t.text == "(" {
+			n, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.accept(")"); !ok {
+				return nil, fmt.Errorf("missing ) at %d", p.peek().pos)
+			}
+			return n, nil
+		}
+	case "eof":
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected [%s] at %d", t.text, t.pos)
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function [%s] at %d", name.text, name.pos)
+	}
+
+	args := []node{}
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.accept(","); ok {
+				continue
+			}
+			if _, ok := p.accept(")"); ok {
+				break
+			}
+			return nil, fmt.Errorf("missing ) at %d", p.peek().pos)
+		}
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, fmt.Errorf("wrong number of arguments for [%s]", name.text)
+	}
+	return &callNode{name.text, args}, nil
+}
+
+// Parse parses the expression string s.
+func Parse(s string) (*Expr, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, vars: make(map[string]bool)}
+	root, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != "eof" {
+		return nil, fmt.Errorf("unexpected [%s] at %d", t.text, t.pos)
+	}
+
+	return &Expr{source: s, root: root, vars: p.vars}, nil
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Variables returns the sorted names of the variables referenced by the expression.
+func (e *Expr) Variables() []string {
+	names := []string{}
+	for name := range e.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckVariables returns an error if the expression references a variable not in allowed.
+func (e *Expr) CheckVariables(allowed ...string) error {
+	for _, name := range e.Variables() {
+		found := false
+		for _, a := range allowed {
+			if a == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("undefined variable [%s]", name)
+		}
+	}
+	return nil
+}
+
+func (e *Expr) Eval(vars map[string]float64) (float64, error) {
+	return e.root.eval(vars)
+}
+
+func (e *Expr) EvalBool(vars map[string]float64) (bool, error) {
+	v, err := e.root.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	return v != 0 && !math.IsNaN(v), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package exprutil
+
+import "testing"
+
+func TestEvalBool(t *testing.T) {
+	testCases := []struct {
+		expr   string
+		value  float64
+		expect bool
+	}{
+		{"value > 80 && value < 95", 90, true},
+		{"value > 80 && value < 95", 96, false},
+		{"abs(value - 50) > 10", 35, true},
+		{"abs(value - 50) > 10", 55, false},
+		{"value != 0", 0, false},
+		{"value != 0", 0.1, true},
+		{"!(value >= 1e2) || value == 200", 150, false},
+		{"max(value, 10) * 2 >= 20 % 7 + 14", 1, true},
+	}
+
+	for _, tc := range testCases {
+		e, err := Parse(tc.expr)
+		if err != nil {
+			t.Fatalf("Parse [%s] failed: %v", tc.expr, err)
+		}
+		result, err := e.EvalBool(map[string]float64{"value": tc.value})
+		if err != nil {
+			t.Fatalf("Eval [%s] failed: %v", tc.expr, err)
+		}
+		if result != tc.expect {
+			t.Fatalf("Eval [%s] with value=%v, expect %v, got %v", tc.expr, tc.value, tc.expect, result)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	testCases := []string{
+		"",
+		"value >",
+		"(value > 1",
+		"value > 1)",
+		"unknown(value)",
+		"abs(value, 1)",
+		"value # 1",
+	}
+
+	for _, tc := range testCases {
+		if _, err := Parse(tc); err == nil {
+			t.Fatalf("Parse [%s] should fail", tc)
+		}
+	}
+}
+
+func TestCheckVariables(t *testing.T) {
+	e, err := Parse("cpu / limit > 0.9")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if err := e.CheckVariables("cpu", "limit"); err != nil {
+		t.Fatalf("CheckVariables failed: %v", err)
+	}
+	if err := e.CheckVariables("value"); err == nil {
+		t.Fatalf("CheckVariables should fail")
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package scriptutil
+
+import (
+	"fmt"
+	"time"
+
+	"go.starlark.net/resolve"
+	"go.starlark.net/starlark"
+)
+
+// CheckFunction is the function every script defines, it is called as
+// check(samples, status, thresholds) and returns fire or (fire, message).
+const CheckFunction = "check"
+
+func init() {
+	resolve.AllowFloat = true
+}
+
+// DefaultMaxSteps bounds scripts whose limits set no steps, scripts always run with a step limit.
+const DefaultMaxSteps = 100000
+
+// Limits bound the execution of a script, zero steps means DefaultMaxSteps and zero timeout means no timeout.
+type Limits struct {
+	MaxSteps uint64
+	Timeout  time.Duration
+}
+
+// Sample is one time value of the series passed to the script.
+type Sample struct {
+	T int64
+	V float64
+}
+
+// Result is the decision returned by the script.
+type Result struct {
+	Fire    bool
+	Message string
+}
+
+// Script is a compiled Starlark script. Scripts run without load, I/O or
+// recursion, so they can only compute a result from their arguments.
+type Script struct {
+	source  string
+	program *starlark.Program
+}
+
+// Compile compiles the script and checks it defines the check function within the limits.
+func Compile(source string, limits Limits) (*Script, error) {
+	_, program, err := starlark.SourceProgram("rule.star", source, func(string) bool { return false })
+	if err != nil {
+		return nil, err
+	}
+	if program.NumLoads() > 0 {
+		return nil, fmt.Errorf("load is not allowed")
+	}
+
+	// Top level code of the script runs here, so it is bounded like the check function
+	thread, stop := newThread(limits)
+	defer stop()
+
+	s := &Script{source: source, program: program}
+	_, err = s.checkFunction(thread)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Script) String() string {
+	return s.source
+}
+
+// newThread returns the thread bounded by the limits and the function to stop its timer.
+func newThread(limits Limits) (*starlark.Thread, func()) {
+	thread := &starlark.Thread{
+		Name:  "script",
+		Print: func(*starlark.Thread, string) {},
+	}
+
+	maxSteps := limits.MaxSteps
+	if maxSteps == 0 {
+		maxSteps = DefaultMaxSteps
+	}
+	thread.SetMaxExecutionSteps(maxSteps)
+
+	if limits.Timeout <= 0 {
+		return thread, func() {}
+	}
+	timer := time.AfterFunc(limits.Timeout, func() { thread.Cancel("timeout") })
+	return thread, func() { timer.Stop() }
+}
+
+func (s *Script) checkFunction(thread *starlark.Thread) (*starlark.Function, error) {
+	globals, err := s.program.Init(thread, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fn, ok := globals[CheckFunction].(*starlark.Function)
+	if !ok || fn.NumParams() != 3 {
+		return nil, fmt.Errorf("script must define %s(samples, status, thresholds)", CheckFunction)
+	}
+	return fn, nil
+}
+
+// Check runs the check function of the script with the samples, the status and the thresholds.
+func (s *Script) Check(samples []Sample, status map[string]interface{}, thresholds string, limits Limits) (Result, error) {
+	thread, stop := newThread(limits)
+	defer stop()
+
+	fn, err := s.checkFunction(thread)
+	if err != nil {
+		return Result{}, err
+	}
+
+	samplesValue := make([]starlark.Value, len(samples))
+	for i, sample := range samples {
+		samplesValue[i] = starlark.Tuple{starlark.MakeInt64(sample.T), starlark.Float(sample.V)}
+	}
+
+	statusValue := starlark.NewDict(len(status))
+	for k, v := range status {
+		value, err := toValue(v)
+		if err != nil {
+			return Result{}, err
+		}
+		statusValue.SetKey(starlark.String(k), value)
+	}
+
+	v, err := starlark.Call(thread, fn, starlark.Tuple{starlark.NewList(samplesValue), statusValue, starlark.String(thresholds)}, nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return toResult(v)
+}
+
+func toValue(v interface{}) (starlark.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(v), nil
+	case string:
+		return starlark.String(v), nil
+	case int:
+		return starlark.MakeInt(v), nil
+	case int64:
+		return starlark.MakeInt64(v), nil
+	case uint32:
+		return starlark.MakeUint64(uint64(v)), nil
+	case float64:
+		return starlark.Float(v), nil
+	}
+	return nil, fmt.Errorf("unsupported value [%v] of type %T", v, v)
+}
+
+func toResult(v starlark.Value) (Result, error) {
+	tuple, ok := v.(starlark.Tuple)
+	if !ok {
+		return Result{Fire: bool(v.Truth())}, nil
+	}
+
+	if len(tuple) != 2 {
+		return Result{}, fmt.Errorf("%s returned %d values, expect (fire, message)", CheckFunction, len(tuple))
+	}
+	message, ok := starlark.AsString(tuple[1])
+	if !ok {
+		message = tuple[1].String()
+	}
+	return Result{Fire: bool(tuple[0].Truth()), Message: message}, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package scriptutil
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var testLimits = Limits{MaxSteps: 100000, Timeout: time.Second}
+
+func TestCheck(t *testing.T) {
+	source := `
+def check(samples, status, thresholds):
+    rising = [s for i, s in enumerate(samples[1:]) if s[1] > samples[i][1]]
+    if len(rising) >= int(thresholds):
+        return True, "rising %d times, level %s" % (len(rising), status["current_level"])
+    return False
+`
+	s, err := Compile(source, testLimits)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	samples := []Sample{{0, 1}, {60, 2}, {120, 3}, {180, 2.5}}
+	status := map[string]interface{}{"current_level": "cleared", "positive_count": uint32(0)}
+
+	result, err := s.Check(samples, status, "2", testLimits)
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if !result.Fire || result.Message != "rising 2 times, level cleared" {
+		t.Errorf("Check got %+v, expect fire with message", result)
+	}
+
+	result, err = s.Check(samples, status, "3", testLimits)
+	if err != nil || result.Fire {
+		t.Errorf("Check got %+v %v, expect clear", result, err)
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	testCases := []string{
+		"def check(samples, status):\n    return True\n",
+		"load('x.star', 'y')\ndef check(samples, status, thresholds):\n    return True\n",
+		"check = 1\n",
+		"def check(samples, status, thresholds)\n",
+	}
+
+	for _, tc := range testCases {
+		if _, err := Compile(tc, testLimits); err == nil {
+			t.Errorf("Compile [%s] expect error", tc)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	source := `
+def check(samples, status, thresholds):
+    n = 0
+    for i in range(100000000):
+        n += i
+    return n > 0
+`
+	s, err := Compile(source, testLimits)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	_, err = s.Check(nil, nil, "", Limits{MaxSteps: 10000})
+	if err == nil {
+		t.Errorf("Check expect too many steps error")
+	}
+
+	_, err = s.Check(nil, nil, "", Limits{MaxSteps: math.MaxUint64, Timeout: 10 * time.Millisecond})
+	if err == nil {
+		t.Errorf("Check expect timeout error")
+	}
+
+	// Scripts without a step limit still have the default one
+	_, err = s.Check(nil, nil, "", Limits{})
+	if err == nil {
+		t.Errorf("Check without limits expect too many steps error")
+	}
+}
+
+func TestCompileLimits(t *testing.T) {
+	// Top level code runs when the script is compiled
+	source := `
+total = len([i for i in range(100000000)])
+
+def check(samples, status, thresholds):
+    return total > 0
+`
+	if _, err := Compile(source, Limits{}); err == nil {
+		t.Errorf("Compile without limits expect too many steps error")
+	}
+
+	start := time.Now()
+	if _, err := Compile(source, Limits{MaxSteps: math.MaxUint64, Timeout: 10 * time.Millisecond}); err == nil {
+		t.Errorf("Compile expect timeout error")
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Compile took %v with timeout 10ms", time.Since(start))
+	}
+}